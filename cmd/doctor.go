@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/version"
+	"github.com/spf13/cobra"
+)
+
+var doctorTimeoutFlag time.Duration
+
+// doctorLeagueID is the league fetched to check FotMob is reachable (Premier League).
+const doctorLeagueID = 47
+
+// checkStatus is the outcome of a doctor check.
+type checkStatus string
+
+const (
+	checkOK   checkStatus = "ok"
+	checkWarn checkStatus = "warn"
+	checkFail checkStatus = "FAIL"
+)
+
+// doctorCheck is one line of the doctor report. A failing core check makes doctor exit non-zero.
+type doctorCheck struct {
+	name string
+	core bool
+	run  func(ctx context.Context) (checkStatus, string)
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check connectivity and setup, for bug reports",
+	Long: `Check that golazo can reach FotMob and Reddit, that its config and cache
+directories are writable, and whether a newer version is available.
+
+Exits non-zero when FotMob can't be reached, as golazo can't show matches without it.`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true, // Execute prints the error
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checks := []doctorCheck{
+			{name: "FotMob", core: true, run: checkFotMob},
+			{name: "Reddit", run: checkReddit},
+			{name: "Config dir", run: checkDir(data.ConfigDir)},
+			{name: "Cache dir", run: checkDir(data.CacheDir)},
+			{name: "Version", run: checkVersion},
+		}
+		if failed := runDoctorChecks(cmd.Context(), checks, os.Stdout); len(failed) > 0 {
+			return fmt.Errorf("%s check failed", strings.Join(failed, ", "))
+		}
+		return nil
+	},
+}
+
+// runDoctorChecks runs the checks in order, printing a line each to w, and returns the
+// names of the core checks that failed.
+func runDoctorChecks(ctx context.Context, checks []doctorCheck, w io.Writer) (failedCore []string) {
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, doctorTimeoutFlag)
+		status, detail := runDoctorCheck(checkCtx, check)
+		cancel()
+
+		_, _ = fmt.Fprintf(w, "  %-4s  %-10s  %s\n", status, check.name, detail)
+		if status == checkFail && check.core {
+			failedCore = append(failedCore, check.name)
+		}
+	}
+	return failedCore
+}
+
+// runDoctorCheck runs check, giving up when ctx is done even if the check doesn't watch it
+// (the Reddit and version lookups take no context). A check given up on fails if it is a
+// core check, and warns otherwise.
+func runDoctorCheck(ctx context.Context, check doctorCheck) (checkStatus, string) {
+	type result struct {
+		status checkStatus
+		detail string
+	}
+	done := make(chan result, 1)
+	go func() {
+		status, detail := check.run(ctx)
+		done <- result{status, detail}
+	}()
+
+	select {
+	case r := <-done:
+		return r.status, r.detail
+	case <-ctx.Done():
+		status := checkWarn
+		if check.core {
+			status = checkFail
+		}
+		return status, fmt.Sprintf("no answer within %s", doctorTimeoutFlag)
+	}
+}
+
+// checkFotMob fetches one league's fixtures, timing the request.
+func checkFotMob(ctx context.Context) (checkStatus, string) {
+	start := time.Now()
+	if _, err := fotmob.NewClient().MatchesForLeagueAndDate(ctx, doctorLeagueID, time.Now(), "fixtures"); err != nil {
+		return checkFail, err.Error()
+	}
+	return checkOK, fmt.Sprintf("reachable in %s", time.Since(start).Round(time.Millisecond))
+}
+
+// checkReddit runs one replay search, telling a CAPTCHA page apart from rate limiting.
+// Reddit only powers the goal replay links, so failures are warnings.
+func checkReddit(ctx context.Context) (checkStatus, string) {
+	start := time.Now()
+	_, err := reddit.NewPublicJSONFetcher().Search("goal", 1, time.Now(), "new")
+	switch {
+	case err == nil:
+		return checkOK, fmt.Sprintf("reachable in %s", time.Since(start).Round(time.Millisecond))
+	case errors.Is(err, reddit.ErrCaptcha):
+		return checkWarn, "serving a CAPTCHA, goal replay links are unavailable for now"
+	case errors.Is(err, reddit.ErrBlocked):
+		return checkWarn, "rate limiting, goal replay links are unavailable for now"
+	}
+	return checkWarn, err.Error()
+}
+
+// checkDir returns a check that the directory from dirFunc exists and takes new files.
+func checkDir(dirFunc func() (string, error)) func(context.Context) (checkStatus, string) {
+	return func(context.Context) (checkStatus, string) {
+		dir, err := dirFunc()
+		if err != nil {
+			return checkFail, err.Error()
+		}
+		file, err := os.CreateTemp(dir, ".doctor-*")
+		if err != nil {
+			return checkFail, fmt.Sprintf("%s is not writable: %v", dir, err)
+		}
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		if data.IsFallbackDir(dir) {
+			return checkWarn, fmt.Sprintf("%s (temporary, the usual directory is unavailable)", dir)
+		}
+		return checkOK, dir
+	}
+}
+
+// checkVersion compares this build with the latest release.
+func checkVersion(context.Context) (checkStatus, string) {
+	latest, err := data.CheckLatestVersion()
+	if err != nil {
+		return checkWarn, fmt.Sprintf("%s (latest unknown: %v)", Version, err)
+	}
+	if Version != "dev" && version.IsOlder(Version, latest) {
+		return checkWarn, fmt.Sprintf("%s, %s is available (golazo --update)", Version, latest)
+	}
+	return checkOK, fmt.Sprintf("%s (latest %s)", Version, latest)
+}
+
+func init() {
+	doctorCmd.Flags().DurationVar(&doctorTimeoutFlag, "timeout", 15*time.Second, "Give up on each check after this long")
+
+	rootCmd.AddCommand(doctorCmd)
+}
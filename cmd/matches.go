@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var (
+	matchesDateFlag    string
+	matchesLeagueFlag  int
+	matchesLiveFlag    bool
+	matchesDetailsFlag int
+	matchesTimeoutFlag time.Duration
+)
+
+var matchesCmd = &cobra.Command{
+	Use:   "matches",
+	Short: "Print matches as JSON (no TUI)",
+	Long: `Fetch matches from FotMob and print them as JSON to stdout, for scripting.
+
+By default prints all matches of the tracked leagues for --date (today if omitted).
+Use --league to limit to one league, --live for matches in progress right now,
+or --details to print the full details of a single match.`,
+	Example: `  golazo matches --date 2026-02-14
+  golazo matches --league 47 --live
+  golazo matches --details 4506123 --timeout 10s`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true, // Execute prints the error
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), matchesTimeoutFlag)
+		defer cancel()
+
+		client := fotmob.NewClient()
+
+		if matchesDetailsFlag > 0 {
+			details, err := client.MatchDetails(ctx, matchesDetailsFlag)
+			if errors.Is(err, api.ErrPartialDetails) {
+				// Print what could be decoded; the warning names the missing sections
+				fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+			} else if err != nil {
+				return fmt.Errorf("fetch match %d: %w", matchesDetailsFlag, err)
+			}
+			return printJSON(details)
+		}
+
+		if matchesLiveFlag && cmd.Flags().Changed("date") {
+			return errors.New("--live always uses today and cannot be combined with --date")
+		}
+
+		date := time.Now()
+		if matchesDateFlag != "" {
+			parsed, err := time.Parse("2006-01-02", matchesDateFlag)
+			if err != nil {
+				return fmt.Errorf("invalid --date %q (want YYYY-MM-DD)", matchesDateFlag)
+			}
+			date = parsed
+		}
+
+		// Live matches only appear under fixtures
+		tabs := []string{"fixtures", "results"}
+		if matchesLiveFlag {
+			tabs = []string{"fixtures"}
+		}
+
+		matches, err := fetchMatches(ctx, client, date, matchesLeagueFlag, tabs)
+		if err != nil {
+			return err
+		}
+
+		if matchesLiveFlag {
+			matches = liveOnly(matches)
+		}
+		return printJSON(matches)
+	},
+}
+
+// fetchMatches fetches the matches for a date across the tracked leagues,
+// or for a single league when leagueID is set.
+func fetchMatches(ctx context.Context, client *fotmob.Client, date time.Time, leagueID int, tabs []string) ([]api.Match, error) {
+	if leagueID <= 0 {
+		matches, err := client.MatchesByDateWithTabs(ctx, date, tabs)
+		if err != nil {
+			return nil, fmt.Errorf("fetch matches for %s: %w", date.Format("2006-01-02"), err)
+		}
+		return matches, nil
+	}
+
+	var matches []api.Match
+	seen := make(map[int]bool)
+	for _, tab := range tabs {
+		tabMatches, err := client.MatchesForLeagueAndDate(ctx, leagueID, date, tab)
+		if err != nil {
+			return nil, fmt.Errorf("fetch league %d for %s: %w", leagueID, date.Format("2006-01-02"), err)
+		}
+		for _, match := range tabMatches {
+			if !seen[match.ID] {
+				seen[match.ID] = true
+				matches = append(matches, match)
+			}
+		}
+	}
+	return matches, nil
+}
+
+// liveOnly returns the matches currently in progress.
+func liveOnly(matches []api.Match) []api.Match {
+	var live []api.Match
+	for _, match := range matches {
+		if match.Status == api.MatchStatusLive {
+			live = append(live, match)
+		}
+	}
+	return live
+}
+
+// printJSON writes v to stdout as indented JSON. Empty match lists print as [].
+func printJSON(v any) error {
+	if matches, ok := v.([]api.Match); ok && matches == nil {
+		v = []api.Match{}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func init() {
+	matchesCmd.Flags().StringVar(&matchesDateFlag, "date", "", "Match date as YYYY-MM-DD (default today)")
+	matchesCmd.Flags().IntVar(&matchesLeagueFlag, "league", 0, "Only print matches of this FotMob league ID")
+	matchesCmd.Flags().BoolVar(&matchesLiveFlag, "live", false, "Only print matches in progress")
+	matchesCmd.Flags().IntVar(&matchesDetailsFlag, "details", 0, "Print the details of this FotMob match ID instead of a list")
+	matchesCmd.Flags().DurationVar(&matchesTimeoutFlag, "timeout", 30*time.Second, "Give up after this long")
+
+	rootCmd.AddCommand(matchesCmd)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/app"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/0xjuanma/golazo/internal/version"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -38,6 +39,16 @@ var rootCmd = &cobra.Command{
 			return
 		}
 
+		// Without a usable home directory, files go to a temp directory; say so before the TUI starts
+		data.SetStorageWarningHandler(func(message string) {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+		})
+
+		// Upgrade config files written by older versions before anything reads them
+		if err := data.MigrateConfig(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not migrate config directory: %v\n", err)
+		}
+
 		// Determine banner conditions
 		isDevBuild := Version == "dev"
 		newVersionAvailable := false
@@ -65,6 +76,9 @@ var rootCmd = &cobra.Command{
 			}
 		}()
 
+		// NO_COLOR (https://no-color.org) renders the UI as plain text
+		ui.SetColorProfile(os.Getenv("NO_COLOR") != "")
+
 		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version), tea.WithAltScreen())
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
@@ -172,7 +186,7 @@ func Execute() {
 
 func init() {
 	rootCmd.Flags().BoolVar(&mockFlag, "mock", false, "Use mock data for all views instead of real API data")
-	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to ~/.golazo/golazo_debug.log")
+	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to golazo.log in the cache directory (or set GOLAZO_DEBUG)")
 	rootCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Update golazo to the latest version")
 	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display version information")
 }
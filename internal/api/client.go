@@ -2,9 +2,18 @@ package api
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrPartialDetails is wrapped by the error MatchDetails returns together with details that
+// could only be decoded in part, e.g. after a provider schema change. The details are usable;
+// the sections that failed are left empty and named in the error.
+var ErrPartialDetails = errors.New("match details decoded in part")
+
+// ErrNoMatchDay is wrapped by the error NearestMatchDay returns when no day in its window had matches.
+var ErrNoMatchDay = errors.New("no finished matches found")
+
 // Client defines the interface for a football API client.
 // This abstraction allows us to swap implementations (FotMob, other APIs, mock, etc.)
 type Client interface {
@@ -24,3 +33,57 @@ type Client interface {
 	// leagueName is used to detect parent leagues for knockout competitions.
 	LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]LeagueTableEntry, error)
 }
+
+// MatchService is the data source behind the TUI. It is implemented by the FotMob client
+// and by data.MockClient, which serves the bundled mock data so handlers can be tested offline.
+type MatchService interface {
+	// MatchesByDate retrieves all matches of the tracked leagues for a specific date.
+	MatchesByDate(ctx context.Context, date time.Time) ([]Match, error)
+
+	// MatchesByDateWithTabs is MatchesByDate limited to the given tabs ("fixtures", "results").
+	MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs []string) ([]Match, error)
+
+	// MatchDetails retrieves detailed information about a specific match.
+	// Details decoded only in part come with an error wrapping ErrPartialDetails.
+	MatchDetails(ctx context.Context, matchID int) (*MatchDetails, error)
+
+	// MatchDetailsForceRefresh is MatchDetails bypassing any cache.
+	MatchDetailsForceRefresh(ctx context.Context, matchID int) (*MatchDetails, error)
+
+	// BatchMatchDetails retrieves details for several matches; failed fetches map to nil.
+	BatchMatchDetails(ctx context.Context, matchIDs []int) map[int]*MatchDetails
+
+	// LeagueTable retrieves the league table/standings for a specific league.
+	LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]LeagueTableEntry, error)
+
+	// LeagueTableWithParent is LeagueTable using parentLeagueID for sub-season leagues.
+	LeagueTableWithParent(ctx context.Context, leagueID int, leagueName string, parentLeagueID int) ([]LeagueTableEntry, error)
+
+	// TeamStats retrieves a team's season record in its league. Teams without a league
+	// table (national teams, friendlies) come with a nil Record and no error.
+	TeamStats(ctx context.Context, teamID int) (*TeamSeasonStats, error)
+
+	// LiveMatches retrieves the matches in progress across the tracked leagues.
+	LiveMatches(ctx context.Context) ([]Match, error)
+
+	// LiveMatchesForceRefresh is LiveMatches bypassing any cache.
+	LiveMatchesForceRefresh(ctx context.Context) ([]Match, error)
+
+	// LiveMatchesForLeague retrieves the matches in progress in one league.
+	LiveMatchesForLeague(ctx context.Context, leagueID int) ([]Match, error)
+
+	// StatsData retrieves the finished matches of the last days (today included) and
+	// today's upcoming matches for the stats view.
+	StatsData(ctx context.Context, days int) (*StatsData, error)
+
+	// TodaySummary counts today's live, finished and upcoming matches.
+	TodaySummary(ctx context.Context) (live, finished, upcoming int, err error)
+
+	// Commentary retrieves a match's text commentary, newest first.
+	// Matches without commentary return no entries and no error.
+	Commentary(ctx context.Context, matchID int) ([]CommentaryEntry, error)
+
+	// NearestMatchDay walks back from from, at most maxBack days, to the first day with finished
+	// matches in the tracked leagues. The error wraps ErrNoMatchDay when there is none.
+	NearestMatchDay(ctx context.Context, from time.Time, maxBack int) (time.Time, error)
+}
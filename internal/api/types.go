@@ -1,6 +1,13 @@
 package api
 
-import "time"
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // League represents a football league
 type League struct {
@@ -28,9 +35,45 @@ const (
 	MatchStatusLive       MatchStatus = "live"
 	MatchStatusFinished   MatchStatus = "finished"
 	MatchStatusPostponed  MatchStatus = "postponed"
+	MatchStatusAbandoned  MatchStatus = "abandoned"
 	MatchStatusCancelled  MatchStatus = "cancelled"
 )
 
+// CountMatchStates counts live, finished and upcoming (not started) matches.
+// Postponed, abandoned and cancelled matches are not counted.
+func CountMatchStates(matches []Match) (live, finished, upcoming int) {
+	for _, match := range matches {
+		switch match.Status {
+		case MatchStatusLive:
+			live++
+		case MatchStatusFinished:
+			finished++
+		case MatchStatusNotStarted:
+			upcoming++
+		}
+	}
+	return live, finished, upcoming
+}
+
+// SortByKickoff sorts matches by kickoff time, earliest first, then by ID so the order
+// doesn't depend on how they were fetched. Matches without a kickoff time sort last.
+func SortByKickoff(matches []Match) {
+	slices.SortFunc(matches, func(a, b Match) int {
+		switch {
+		case a.MatchTime == nil && b.MatchTime == nil:
+		case a.MatchTime == nil:
+			return 1
+		case b.MatchTime == nil:
+			return -1
+		default:
+			if c := a.MatchTime.Compare(*b.MatchTime); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
 // Match represents a football match
 type Match struct {
 	ID        int         `json:"id"`
@@ -53,12 +96,128 @@ type MatchEvent struct {
 	Type          string    `json:"type"`                     // "goal", "card", "substitution", etc.
 	Team          Team      `json:"team"`
 	Player        *string   `json:"player,omitempty"`
+	PlayerID      int       `json:"player_id,omitempty"` // Provider player ID; 0 if unknown
 	Assist        *string   `json:"assist,omitempty"`
 	EventType     *string   `json:"event_type,omitempty"` // "yellow", "red", "in", "out", etc.
 	OwnGoal       *bool     `json:"own_goal,omitempty"`   // Indicates if this is an own goal
+	GoalType      string    `json:"goal_type,omitempty"`  // GoalTypePenalty or GoalTypeOwnGoal; empty for open play
+	Reason        string    `json:"reason,omitempty"`     // VAR events: why the decision was made, e.g. "offside"
 	Timestamp     time.Time `json:"timestamp"`
 }
 
+// CommentaryEntry is one line of a match's text commentary.
+type CommentaryEntry struct {
+	ID        string `json:"id"`                   // Stable across fetches; used to skip lines already shown
+	Minute    int    `json:"minute"`               // Base minute; 0 before kickoff
+	AddedTime int    `json:"added_time,omitempty"` // Stoppage-time minutes on top of Minute
+	Text      string `json:"text"`
+}
+
+// MinuteLabel returns the entry's minute as "45+2", or "" before kickoff.
+func (e CommentaryEntry) MinuteLabel() string {
+	switch {
+	case e.Minute <= 0:
+		return ""
+	case e.AddedTime > 0:
+		return fmt.Sprintf("%d+%d", e.Minute, e.AddedTime)
+	}
+	return strconv.Itoa(e.Minute)
+}
+
+// Goal types for MatchEvent.GoalType
+const (
+	GoalTypePenalty = "penalty"
+	GoalTypeOwnGoal = "owngoal"
+)
+
+// IsOwnGoal reports whether the event is an own goal.
+// The event team is the side credited with the goal, while Player is the conceding player.
+func (e MatchEvent) IsOwnGoal() bool {
+	return e.GoalType == GoalTypeOwnGoal || (e.OwnGoal != nil && *e.OwnGoal)
+}
+
+// IsPenalty reports whether the event is a goal scored from the penalty spot.
+func (e MatchEvent) IsPenalty() bool {
+	return e.GoalType == GoalTypePenalty
+}
+
+// Card types for MatchEvent.EventType on card events
+const (
+	CardYellow       = "yellow"
+	CardRed          = "red"
+	CardSecondYellow = "secondyellow" // Second booking; the player is sent off
+)
+
+// IsSecondYellow reports whether the event is a red card shown for a second booking.
+func (e MatchEvent) IsSecondYellow() bool {
+	return e.EventType != nil && *e.EventType == CardSecondYellow
+}
+
+// IsRedCard reports whether the event sends a player off, either straight or by a second yellow.
+func (e MatchEvent) IsRedCard() bool {
+	if e.EventType == nil {
+		return false
+	}
+	switch *e.EventType {
+	case CardRed, "redcard", CardSecondYellow:
+		return true
+	}
+	return false
+}
+
+// VAR decisions for MatchEvent.EventType on "var" events. Decisions FotMob adds later
+// keep its own key, with underscores, e.g. "goal_awarded".
+const (
+	VARGoalDisallowed   = "goal_disallowed"
+	VARPenaltyAwarded   = "penalty_awarded"
+	VARPenaltyCancelled = "penalty_cancelled"
+	VARRedCard          = "red_card"
+	VARCardCancelled    = "card_cancelled"
+)
+
+// VARDecision describes a VAR event's decision, e.g. "goal disallowed (offside)",
+// or returns "" for other events.
+func (e MatchEvent) VARDecision() string {
+	if e.Type != "var" {
+		return ""
+	}
+	decision := "decision"
+	if e.EventType != nil && *e.EventType != "" {
+		decision = strings.ReplaceAll(*e.EventType, "_", " ")
+	}
+	if e.Reason != "" {
+		decision += " (" + e.Reason + ")"
+	}
+	return decision
+}
+
+// SortKey orders events by minute, stoppage time included: "90+4'" is 9004, after
+// "90+1'" (9001) and before "91'" (9100). Falls back to Minute when DisplayMinute can't be read.
+func (e MatchEvent) SortKey() int {
+	base, added := parseDisplayMinute(e.DisplayMinute)
+	if base == 0 {
+		base, added = e.Minute, 0
+	}
+	return base*100 + added
+}
+
+// parseDisplayMinute splits a display minute like "45+2'" into its base and added minutes.
+// It returns zeros when s isn't a minute.
+func parseDisplayMinute(s string) (base, added int) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "'")
+	baseStr, addedStr, hasAdded := strings.Cut(s, "+")
+	base, err := strconv.Atoi(strings.TrimSpace(baseStr))
+	if err != nil {
+		return 0, 0
+	}
+	if hasAdded {
+		if added, err = strconv.Atoi(strings.TrimSpace(addedStr)); err != nil {
+			return base, 0
+		}
+	}
+	return base, added
+}
+
 // MatchStatistic represents a single match statistic (possession, shots, etc.)
 type MatchStatistic struct {
 	Key       string `json:"key"`        // e.g., "possession", "shots_total"
@@ -96,9 +255,13 @@ type MatchDetails struct {
 		Home *int `json:"home,omitempty"`
 		Away *int `json:"away,omitempty"`
 	} `json:"penalties,omitempty"`
+	Aggregate *AggregateScore `json:"aggregate,omitempty"` // Two-legged tie aggregate (cup knockouts)
+	Advanced  *int            `json:"advanced,omitempty"`  // ID of the team through, once this match settled the tie
 
 	// Extended statistics
-	Statistics []MatchStatistic `json:"statistics,omitempty"` // Match statistics (possession, shots, etc.)
+	Statistics     []MatchStatistic `json:"statistics,omitempty"`      // Match statistics (possession, shots, etc.)
+	MomentumSeries []float64        `json:"momentum_series,omitempty"` // Per-minute home share of momentum (0-100, 50 = even)
+	LiveMomentum   *float64         `json:"live_momentum,omitempty"`   // Side on top right now, -1 (away) to 1 (home); live matches only
 
 	// Match context
 	Referee    string `json:"referee,omitempty"`    // Referee name
@@ -122,6 +285,37 @@ type MatchDetails struct {
 
 	// Highlight video (if available)
 	Highlight *MatchHighlight `json:"highlight,omitempty"` // Official highlight video link
+
+	// PagePath is the canonical path of the match page on the FotMob website,
+	// e.g. "/matches/arsenal-vs-chelsea/2tn8c9#4506123"; empty if unknown.
+	PagePath string `json:"page_path,omitempty"`
+}
+
+// fotmobWebURL is the FotMob website, for links to match pages.
+const fotmobWebURL = "https://www.fotmob.com"
+
+// MatchURL returns the FotMob web page of a match: its canonical page when known,
+// otherwise the page for its numeric ID.
+func MatchURL(details *MatchDetails) string {
+	if strings.HasPrefix(details.PagePath, "/matches/") {
+		return fotmobWebURL + details.PagePath
+	}
+	return fmt.Sprintf("%s/matches/%d", fotmobWebURL, details.ID)
+}
+
+// AggregateScore represents the combined score of a two-legged tie.
+// All scores are from the perspective of the current match's home team.
+type AggregateScore struct {
+	Home     int       `json:"home"`
+	Away     int       `json:"away"`
+	FirstLeg *LegScore `json:"first_leg,omitempty"` // Derived as aggregate minus this match's score
+	Note     string    `json:"note,omitempty"`      // Display-only tiebreak text, e.g. "Roma advance" on a level aggregate
+}
+
+// LegScore is the score of a single leg of a tie.
+type LegScore struct {
+	Home int `json:"home"`
+	Away int `json:"away"`
 }
 
 // MatchHighlight represents an official highlight video for a match
@@ -145,3 +339,25 @@ type LeagueTableEntry struct {
 	GoalDifference int  `json:"goal_difference"`
 	Points         int  `json:"points"`
 }
+
+// TeamSeasonStats is a team's record in its league this season.
+type TeamSeasonStats struct {
+	Team       Team              `json:"team"`
+	LeagueName string            `json:"league_name,omitempty"`
+	Season     string            `json:"season,omitempty"` // e.g. "2025/2026"
+	Record     *LeagueTableEntry `json:"record,omitempty"` // nil when the team isn't in a league table, e.g. national teams
+	Form       []string          `json:"form,omitempty"`   // Latest results, oldest first: "W", "D" or "L"
+}
+
+// StatsData holds all matches data for the stats view.
+// This is returned by MatchService.StatsData and contains both finished and upcoming matches.
+type StatsData struct {
+	// AllFinished contains finished matches for all fetched days (5 days by default, configurable)
+	AllFinished []Match
+	// TodayFinished contains only today's finished matches (filtered from AllFinished)
+	TodayFinished []Match
+	// TodayUpcoming contains today's upcoming matches
+	TodayUpcoming []Match
+	// TodayLive contains today's matches in progress
+	TodayLive []Match
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCountMatchStates(t *testing.T) {
+	matches := []Match{
+		{Status: MatchStatusLive},
+		{Status: MatchStatusFinished},
+		{Status: MatchStatusFinished},
+		{Status: MatchStatusNotStarted},
+		{Status: MatchStatusPostponed},
+	}
+
+	live, finished, upcoming := CountMatchStates(matches)
+	if live != 1 || finished != 2 || upcoming != 1 {
+		t.Errorf("CountMatchStates() = %d live, %d finished, %d upcoming; want 1, 2, 1", live, finished, upcoming)
+	}
+}
+
+func TestSortByKickoff(t *testing.T) {
+	early := time.Date(2026, 3, 14, 13, 0, 0, 0, time.UTC)
+	late := early.Add(2 * time.Hour)
+	matches := []Match{
+		{ID: 4},
+		{ID: 3, MatchTime: &late},
+		{ID: 2, MatchTime: &early},
+		{ID: 1, MatchTime: &late},
+	}
+
+	SortByKickoff(matches)
+	var ids []int
+	for _, match := range matches {
+		ids = append(ids, match.ID)
+	}
+	if want := []int{2, 1, 3, 4}; !slices.Equal(ids, want) {
+		t.Errorf("SortByKickoff() order = %v, want %v", ids, want)
+	}
+}
+
+func TestMatchURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		details MatchDetails
+		want    string
+	}{
+		{"canonical page", MatchDetails{Match: Match{ID: 4506123}, PagePath: "/matches/arsenal-vs-chelsea/2tn8c9#4506123"}, "https://www.fotmob.com/matches/arsenal-vs-chelsea/2tn8c9#4506123"},
+		{"only the ID", MatchDetails{Match: Match{ID: 4506123}}, "https://www.fotmob.com/matches/4506123"},
+		{"unexpected path", MatchDetails{Match: Match{ID: 4506123}, PagePath: "https://evil.example"}, "https://www.fotmob.com/matches/4506123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchURL(&tt.details); got != tt.want {
+				t.Errorf("MatchURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDisplayMinute(t *testing.T) {
+	tests := []struct {
+		in          string
+		base, added int
+	}{
+		{"45+2", 45, 2},
+		{"90+7'", 90, 7},
+		{"23", 23, 0},
+		{"23'", 23, 0},
+		{"", 0, 0},
+		{"HT", 0, 0},
+	}
+	for _, tt := range tests {
+		if base, added := parseDisplayMinute(tt.in); base != tt.base || added != tt.added {
+			t.Errorf("parseDisplayMinute(%q) = %d, %d; want %d, %d", tt.in, base, added, tt.base, tt.added)
+		}
+	}
+
+	late := MatchEvent{Minute: 90, DisplayMinute: "90+4'"}
+	early := MatchEvent{Minute: 90, DisplayMinute: "90+1'"}
+	if late.SortKey() <= early.SortKey() {
+		t.Errorf("SortKey() 90+4 = %d, not after 90+1 = %d", late.SortKey(), early.SortKey())
+	}
+	if got := (MatchEvent{Minute: 12}).SortKey(); got != 1200 {
+		t.Errorf("SortKey() without a display minute = %d, want 1200", got)
+	}
+}
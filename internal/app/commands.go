@@ -2,30 +2,38 @@ package app
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/clipboard"
+	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/ui/images"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // LiveRefreshInterval is the interval between automatic live matches list refreshes.
 const LiveRefreshInterval = 5 * time.Minute
 
-// LiveBatchSize is the number of leagues to fetch concurrently in each batch.
-const LiveBatchSize = 4
+// LiveListPageSize is the number of live matches pushed into the list at a time.
+// More are loaded as the selection approaches the end of the loaded window.
+const LiveListPageSize = 25
 
 // fetchLiveBatchData fetches live matches for a batch of leagues concurrently.
-// batchIndex: 0, 1, 2, ... (each batch fetches LiveBatchSize leagues in parallel)
+// batchIndex: 0, 1, 2, ... (each batch fetches batchSize leagues in parallel)
 // Results appear after each batch completes, giving progressive updates while being fast.
-func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int) tea.Cmd {
+// Batches after the first wait delay before fetching, to go easy on FotMob's rate limits.
+// ctx cancels the batch's requests; gen tags the result so stale batches can be dropped.
+func fetchLiveBatchData(ctx context.Context, client api.MatchService, useMockData bool, gen int, batchIndex, batchSize int, delay time.Duration) tea.Cmd {
 	return func() tea.Msg {
 		totalLeagues := fotmob.TotalLeagues()
-		startIdx := batchIndex * LiveBatchSize
-		endIdx := startIdx + LiveBatchSize
+		startIdx := batchIndex * batchSize
+		endIdx := startIdx + batchSize
 		endIdx = min(endIdx, totalLeagues)
 		isLast := endIdx >= totalLeagues
 
@@ -33,20 +41,30 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 			// Return mock data only on first batch
 			if batchIndex == 0 {
 				return liveBatchDataMsg{
+					gen:        gen,
 					batchIndex: batchIndex,
 					isLast:     isLast,
 					matches:    data.MockLiveMatches(),
 				}
 			}
 			return liveBatchDataMsg{
+				gen:        gen,
 				batchIndex: batchIndex,
 				isLast:     isLast,
 				matches:    nil,
 			}
 		}
 
-		if client == nil {
+		if batchIndex > 0 && delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+			}
+		}
+
+		if client == nil || ctx.Err() != nil {
 			return liveBatchDataMsg{
+				gen:        gen,
 				batchIndex: batchIndex,
 				isLast:     isLast,
 				matches:    nil,
@@ -57,6 +75,7 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 		var wg sync.WaitGroup
 		var mu sync.Mutex
 		var allMatches []api.Match
+		var errs []error
 
 		for i := startIdx; i < endIdx; i++ {
 			wg.Add(1)
@@ -64,33 +83,94 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 				defer wg.Done()
 
 				leagueID := fotmob.LeagueIDAtIndex(leagueIdx)
-				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				leagueCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 				defer cancel()
 
-				matches, err := client.LiveMatchesForLeague(ctx, leagueID)
-				if err != nil || len(matches) == 0 {
-					return
-				}
+				matches, err := client.LiveMatchesForLeague(leagueCtx, leagueID)
 
 				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					errs = append(errs, err)
+					return
+				}
 				allMatches = append(allMatches, matches...)
-				mu.Unlock()
 			}(i)
 		}
 
 		wg.Wait()
 
+		// A few failing leagues are skipped; only a batch where every league failed is an error
+		var batchErr error
+		if len(errs) == endIdx-startIdx {
+			batchErr = errors.Join(errs...)
+		}
+
 		return liveBatchDataMsg{
+			gen:        gen,
 			batchIndex: batchIndex,
 			isLast:     isLast,
 			matches:    allMatches,
+			err:        batchErr,
+		}
+	}
+}
+
+// fetchTodaySummary counts today's live, finished and upcoming matches for the main menu.
+func fetchTodaySummary(client api.MatchService, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			live, finished, upcoming := api.CountMatchStates(append(data.MockLiveMatches(), data.MockFinishedMatches()...))
+			return todaySummaryMsg{summary: ui.TodaySummary{Live: live, Finished: finished, Upcoming: upcoming}}
+		}
+
+		if client == nil {
+			return todaySummaryMsg{err: errors.New("no FotMob client")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		live, finished, upcoming, err := client.TodaySummary(ctx)
+		return todaySummaryMsg{
+			summary: ui.TodaySummary{Live: live, Finished: finished, Upcoming: upcoming},
+			err:     err,
+		}
+	}
+}
+
+// fetchTickerResults fetches today's finished matches for the live view's results ticker.
+func fetchTickerResults(client api.MatchService, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return tickerResultsMsg{matches: data.MockFinishedMatches()}
 		}
+
+		if client == nil {
+			return tickerResultsMsg{err: errors.New("no FotMob client")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		matches, err := client.MatchesByDateWithTabs(ctx, time.Now().UTC(), []string{"results"})
+		if err != nil {
+			return tickerResultsMsg{err: err}
+		}
+
+		var finished []api.Match
+		for _, match := range matches {
+			if match.Status == api.MatchStatusFinished {
+				finished = append(finished, match)
+			}
+		}
+		return tickerResultsMsg{matches: finished}
 	}
 }
 
 // scheduleLiveRefresh schedules the next live matches refresh after 5 minutes.
 // This is used to keep the live matches list current while the user is in the view.
-func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
+func scheduleLiveRefresh(client api.MatchService, useMockData bool) tea.Cmd {
 	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
 		if useMockData {
 			return liveRefreshMsg{matches: data.MockLiveMatches()}
@@ -106,7 +186,7 @@ func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
 		// Force refresh to bypass cache
 		matches, err := client.LiveMatchesForceRefresh(ctx)
 		if err != nil {
-			return liveRefreshMsg{matches: nil}
+			return liveRefreshMsg{err: err}
 		}
 
 		return liveRefreshMsg{matches: matches}
@@ -115,7 +195,7 @@ func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
 
 // fetchMatchDetails fetches match details from the API.
 // Returns mock data if useMockData is true, otherwise uses real API.
-func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchMatchDetails(client api.MatchService, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
@@ -125,18 +205,40 @@ func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		details, err := client.MatchDetails(ctx, matchID)
-		if err != nil {
-			return matchDetailsMsg{details: nil}
+		return matchDetailsResult(client.MatchDetails(ctx, matchID))
+	}
+}
+
+// fetchCommentary fetches the text commentary of a live match.
+func fetchCommentary(client api.MatchService, matchID int, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			client = data.MockClient{}
+		}
+		if client == nil {
+			return commentaryMsg{matchID: matchID}
 		}
 
-		return matchDetailsMsg{details: details}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		entries, err := client.Commentary(ctx, matchID)
+		return commentaryMsg{matchID: matchID, entries: entries, err: err}
+	}
+}
+
+// matchDetailsResult builds the message for a match details fetch. Details decoded only in
+// part are still delivered, with the error for the debug log.
+func matchDetailsResult(details *api.MatchDetails, err error) matchDetailsMsg {
+	if err != nil && (details == nil || !errors.Is(err, api.ErrPartialDetails)) {
+		return matchDetailsMsg{details: nil}
 	}
+	return matchDetailsMsg{details: details, err: err}
 }
 
 // fetchMatchDetailsForceRefresh fetches match details with cache bypass.
 // Forces fresh data from the API, ignoring any cached data.
-func fetchMatchDetailsForceRefresh(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchMatchDetailsForceRefresh(client api.MatchService, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
@@ -146,12 +248,7 @@ func fetchMatchDetailsForceRefresh(client *fotmob.Client, matchID int, useMockDa
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
-		if err != nil {
-			return matchDetailsMsg{details: nil}
-		}
-
-		return matchDetailsMsg{details: details}
+		return matchDetailsResult(client.MatchDetailsForceRefresh(ctx, matchID))
 	}
 }
 
@@ -163,6 +260,33 @@ func schedulePollTick(matchID int) tea.Cmd {
 	})
 }
 
+// liveClockTickInterval is how often the estimated live minute is re-rendered between polls.
+const liveClockTickInterval = 15 * time.Second
+
+// scheduleLiveClockTick schedules the next live clock re-render for the fetch made at since.
+func scheduleLiveClockTick(since time.Time) tea.Cmd {
+	return tea.Tick(liveClockTickInterval, func(time.Time) tea.Msg {
+		return liveClockTickMsg{since: since}
+	})
+}
+
+// CountdownRefreshInterval is how often the view re-renders so kickoff countdowns stay current.
+const CountdownRefreshInterval = 30 * time.Second
+
+// scheduleCountdownTick schedules the next kickoff countdown re-render.
+func scheduleCountdownTick() tea.Cmd {
+	return tea.Tick(CountdownRefreshInterval, func(time.Time) tea.Msg {
+		return countdownTickMsg{}
+	})
+}
+
+// scheduleWatchTick schedules the next watch mode rotation.
+func scheduleWatchTick(interval time.Duration, gen int) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return watchTickMsg{gen: gen}
+	})
+}
+
 // PollSpinnerDuration is how long to show the "Updating..." spinner.
 const PollSpinnerDuration = 1 * time.Second
 
@@ -176,7 +300,7 @@ func schedulePollSpinnerHide() tea.Cmd {
 // fetchPollMatchDetails fetches match details for a poll refresh.
 // This is called when pollTickMsg is received, with loading state visible.
 // Uses force refresh to bypass cache and ensure fresh data for live matches.
-func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchPollMatchDetails(client api.MatchService, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
@@ -187,35 +311,35 @@ func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool)
 		defer cancel()
 
 		// Force refresh to bypass cache - live matches need fresh data
-		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
-		if err != nil {
-			return matchDetailsMsg{details: nil}
-		}
-
-		return matchDetailsMsg{details: details}
+		return matchDetailsResult(client.MatchDetailsForceRefresh(ctx, matchID))
 	}
 }
 
 // fetchStatsDayData fetches stats data for a single day (progressive loading).
-// dayIndex: 0 = today, 1 = yesterday, etc.
+// dayIndex: 0 = anchor day, 1 = the day before, etc.
 // totalDays: total number of days to fetch (for isLast calculation)
+// anchor: last day of the window; zero means today, the only day with upcoming matches.
 // This enables showing results immediately as each day's data arrives.
-func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, totalDays int) tea.Cmd {
+// ctx cancels the day's requests; gen tags the result so stale days can be dropped.
+func fetchStatsDayData(ctx context.Context, client api.MatchService, useMockData bool, gen int, dayIndex int, totalDays int, anchor time.Time) tea.Cmd {
 	return func() tea.Msg {
-		isToday := dayIndex == 0
+		isToday := dayIndex == 0 && anchor.IsZero()
 		isLast := dayIndex == totalDays-1
 
 		if useMockData {
 			if isToday {
 				return statsDayDataMsg{
+					gen:      gen,
 					dayIndex: dayIndex,
 					isToday:  true,
 					isLast:   isLast,
 					finished: data.MockFinishedMatches(),
 					upcoming: nil,
+					live:     data.MockLiveMatches(),
 				}
 			}
 			return statsDayDataMsg{
+				gen:      gen,
 				dayIndex: dayIndex,
 				isToday:  false,
 				isLast:   isLast,
@@ -224,8 +348,9 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 			}
 		}
 
-		if client == nil {
+		if client == nil || ctx.Err() != nil {
 			return statsDayDataMsg{
+				gen:      gen,
 				dayIndex: dayIndex,
 				isToday:  isToday,
 				isLast:   isLast,
@@ -234,56 +359,109 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 			}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		dayCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 		defer cancel()
 
 		// Calculate the date for this day
-		today := time.Now().UTC()
-		date := today.AddDate(0, 0, -dayIndex)
+		base := anchor
+		if base.IsZero() {
+			base = time.Now()
+		}
+		date := base.UTC().AddDate(0, 0, -dayIndex)
 
 		var matches []api.Match
 		var err error
 
 		if isToday {
 			// Today: need both fixtures (upcoming) and results (finished)
-			matches, err = client.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
+			matches, err = client.MatchesByDateWithTabs(dayCtx, date, []string{"fixtures", "results"})
 		} else {
 			// Past days: only need results (finished matches)
-			matches, err = client.MatchesByDateWithTabs(ctx, date, []string{"results"})
+			matches, err = client.MatchesByDateWithTabs(dayCtx, date, []string{"results"})
 		}
 
 		if err != nil {
 			return statsDayDataMsg{
+				gen:      gen,
 				dayIndex: dayIndex,
 				isToday:  isToday,
 				isLast:   isLast,
 				finished: nil,
 				upcoming: nil,
+				err:      err,
 			}
 		}
 
-		// Split matches into finished and upcoming
-		var finished, upcoming []api.Match
+		// Split matches into finished, upcoming and live
+		var finished, upcoming, live []api.Match
 		for _, match := range matches {
-			if match.Status == api.MatchStatusFinished {
+			// Abandoned matches belong with results, postponed ones with fixtures
+			if match.Status == api.MatchStatusFinished || match.Status == api.MatchStatusAbandoned {
 				finished = append(finished, match)
-			} else if match.Status == api.MatchStatusNotStarted && isToday {
+			} else if (match.Status == api.MatchStatusNotStarted || match.Status == api.MatchStatusPostponed) && isToday {
 				upcoming = append(upcoming, match)
+			} else if match.Status == api.MatchStatusLive && isToday {
+				live = append(live, match)
 			}
 		}
 
 		return statsDayDataMsg{
+			gen:      gen,
 			dayIndex: dayIndex,
 			isToday:  isToday,
 			isLast:   isLast,
 			finished: finished,
 			upcoming: upcoming,
+			live:     live,
+		}
+	}
+}
+
+// fetchPlayerPhotos downloads the photos of the goal scorers in details that aren't cached yet.
+// It delivers a playerPhotosMsg if any arrived, so the thumbnails appear; failures are skipped.
+func fetchPlayerPhotos(cache *images.Cache, details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		fetched := 0
+		for _, event := range details.Events {
+			if event.Type != "goal" || event.PlayerID == 0 {
+				continue
+			}
+			if ok, _ := cache.Fetch(ctx, fotmob.PlayerImageURL(event.PlayerID)); ok {
+				fetched++
+			}
+		}
+		if fetched == 0 {
+			return nil
+		}
+		return playerPhotosMsg{matchID: details.ID}
+	}
+}
+
+// nearestMatchDaySearchDays is how far back the stats view looks for a day with matches
+// when its whole window is empty; three weeks covers international breaks.
+const nearestMatchDaySearchDays = 21
+
+// findNearestMatchDay probes for the nearest day before from with finished matches.
+// gen tags the result so a probe outlived by its fetch can be dropped.
+func findNearestMatchDay(ctx context.Context, client api.MatchService, gen int, from time.Time) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return nearestMatchDayMsg{gen: gen, err: api.ErrNoMatchDay}
 		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		day, err := client.NearestMatchDay(probeCtx, from, nearestMatchDaySearchDays)
+		return nearestMatchDayMsg{gen: gen, day: day, err: err}
 	}
 }
 
 // fetchStatsMatchDetailsFotmob fetches match details from FotMob API for stats view.
-func fetchStatsMatchDetailsFotmob(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchStatsMatchDetailsFotmob(client api.MatchService, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockFinishedMatchDetails(matchID)
@@ -297,81 +475,142 @@ func fetchStatsMatchDetailsFotmob(client *fotmob.Client, matchID int, useMockDat
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		details, err := client.MatchDetails(ctx, matchID)
-		if err != nil {
-			return matchDetailsMsg{details: nil}
+		return matchDetailsResult(client.MatchDetails(ctx, matchID))
+	}
+}
+
+// fetchScorerDetails fetches match details for a chunk of finished matches for the top scorers view.
+func fetchScorerDetails(client api.MatchService, matchIDs []int, useMockData bool, gen int) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details := make(map[int]*api.MatchDetails, len(matchIDs))
+			for _, id := range matchIDs {
+				details[id], _ = data.MockFinishedMatchDetails(id)
+			}
+			return scorerDetailsMsg{gen: gen, details: details}
+		}
+
+		if client == nil {
+			return scorerDetailsMsg{gen: gen, details: make(map[int]*api.MatchDetails)}
 		}
 
-		return matchDetailsMsg{details: details}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		return scorerDetailsMsg{gen: gen, details: client.BatchMatchDetails(ctx, matchIDs)}
 	}
 }
 
 // fetchGoalLinks fetches goal replay links from Reddit for all goals in a match.
 // This is called on-demand when match details are loaded/displayed.
 // Links are cached persistently to avoid redundant API calls.
-func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
+// The search runs in the background until ctx is cancelled; links arrive one goalLinksMsg at a time.
+// The command never touches the model's goalLinks; handleGoalLinks merges them on the update loop.
+func fetchGoalLinks(ctx context.Context, redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
 	return func() tea.Msg {
 		if redditClient == nil || details == nil {
 			return goalLinksMsg{matchID: 0, links: nil}
 		}
 
-		// Extract goal events from match details
-		var goals []reddit.GoalInfo
-		for _, event := range details.Events {
-			if event.Type != "goal" {
-				continue
-			}
+		goals := goalInfos(details)
+		if len(goals) == 0 {
+			return goalLinksMsg{matchID: details.ID, links: nil}
+		}
 
-			// Debug log goal extraction (will be logged when redditClient.GoalLinks is called)
+		// Resolve links in the background (uses cache internally) and report them one by one
+		updates := redditClient.PrefetchAsync(ctx, goals)
+		return waitForGoalLink(redditClient, details.ID, updates, false)()
+	}
+}
 
-			scorer := ""
-			if event.Player != nil {
-				scorer = *event.Player
-			}
+// refetchGoalLinks searches Reddit again for the goals of a match that have no replay link,
+// clearing their "not found" markers first. Messages are marked refresh, see refreshReplays.
+func refetchGoalLinks(ctx context.Context, redditClient *reddit.Client, details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		if redditClient == nil || details == nil {
+			return goalLinksMsg{matchID: 0, links: nil, refresh: true}
+		}
 
-			// Determine if goal is for home team
-			isHome := event.Team.ID == details.HomeTeam.ID
+		goals := goalInfos(details)
+		if len(goals) == 0 {
+			return goalLinksMsg{matchID: details.ID, links: nil, refresh: true}
+		}
 
-			// Get scores at the time of goal (approximate)
-			homeScore := 0
-			awayScore := 0
-			if details.HomeScore != nil {
-				homeScore = *details.HomeScore
-			}
-			if details.AwayScore != nil {
-				awayScore = *details.AwayScore
-			}
+		// The markers are cleared here rather than on the update loop, as it writes the cache file
+		_, _ = redditClient.Cache().ClearNotFound(details.ID)
+		updates := redditClient.PrefetchAsync(ctx, goals)
+		return waitForGoalLink(redditClient, details.ID, updates, true)()
+	}
+}
 
-			// Get match time for date-based Reddit search
-			matchTime := time.Now() // Default to now for live matches
-			if details.MatchTime != nil {
-				matchTime = *details.MatchTime
-			}
+// goalInfos extracts the goals of a match to search Reddit for.
+func goalInfos(details *api.MatchDetails) []reddit.GoalInfo {
+	var goals []reddit.GoalInfo
+	for _, event := range details.Events {
+		if event.Type != "goal" {
+			continue
+		}
 
-			goals = append(goals, reddit.GoalInfo{
-				MatchID:       details.ID,
-				HomeTeam:      details.HomeTeam.Name,
-				AwayTeam:      details.AwayTeam.Name,
-				HomeTeamShort: details.HomeTeam.ShortName,
-				AwayTeamShort: details.AwayTeam.ShortName,
-				ScorerName:    scorer,
-				Minute:        event.Minute,
-				DisplayMinute: event.DisplayMinute,
-				HomeScore:     homeScore,
-				AwayScore:     awayScore,
-				IsHomeTeam:    isHome,
-				MatchTime:     matchTime,
-			})
+		scorer := ""
+		if event.Player != nil {
+			scorer = *event.Player
 		}
 
-		if len(goals) == 0 {
-			return goalLinksMsg{matchID: details.ID, links: nil}
+		// Determine if goal is for home team
+		isHome := event.Team.ID == details.HomeTeam.ID
+
+		// Get scores at the time of goal (approximate)
+		homeScore := 0
+		awayScore := 0
+		if details.HomeScore != nil {
+			homeScore = *details.HomeScore
+		}
+		if details.AwayScore != nil {
+			awayScore = *details.AwayScore
 		}
 
-		// Fetch links for all goals (uses cache internally)
-		links := redditClient.GoalLinks(goals)
+		// Get match time for date-based Reddit search
+		matchTime := time.Now() // Default to now for live matches
+		if details.MatchTime != nil {
+			matchTime = *details.MatchTime
+		}
 
-		return goalLinksMsg{matchID: details.ID, links: links}
+		goals = append(goals, reddit.GoalInfo{
+			MatchID:       details.ID,
+			HomeTeam:      details.HomeTeam.Name,
+			AwayTeam:      details.AwayTeam.Name,
+			HomeTeamShort: details.HomeTeam.ShortName,
+			AwayTeamShort: details.AwayTeam.ShortName,
+			ScorerName:    scorer,
+			Minute:        event.Minute,
+			DisplayMinute: event.DisplayMinute,
+			HomeScore:     homeScore,
+			AwayScore:     awayScore,
+			IsHomeTeam:    isHome,
+			MatchTime:     matchTime,
+		})
+	}
+	return goals
+}
+
+// waitForGoalLink waits for the next goal link resolved by a background prefetch.
+// Each message carries a command to wait for the following link until the prefetch is done.
+func waitForGoalLink(redditClient *reddit.Client, matchID int, updates <-chan reddit.GoalLinkKey, refresh bool) tea.Cmd {
+	return func() tea.Msg {
+		key, ok := <-updates
+		if !ok {
+			return goalLinksMsg{matchID: matchID, links: nil, refresh: refresh}
+		}
+
+		links := map[reddit.GoalLinkKey]*reddit.GoalLink{
+			key: redditClient.Cache().Get(key),
+		}
+		return goalLinksMsg{
+			matchID: matchID,
+			links:   links,
+			next:    waitForGoalLink(redditClient, matchID, updates, refresh),
+			refresh: refresh,
+		}
 	}
 }
 
@@ -379,7 +618,7 @@ func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.
 // Used to populate the standings dialog.
 // parentLeagueID is used for multi-season leagues (e.g., Liga MX Clausura -> Liga MX)
 // where the sub-league ID has no standings but the parent league does.
-func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, parentLeagueID int, homeTeamID, awayTeamID int) tea.Cmd {
+func fetchStandings(client api.MatchService, leagueID int, leagueName string, parentLeagueID int, homeTeamID, awayTeamID int) tea.Cmd {
 	return func() tea.Msg {
 		if client == nil {
 			return standingsMsg{leagueID: leagueID, standings: nil}
@@ -402,3 +641,82 @@ func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, pare
 		}
 	}
 }
+
+// fetchTeamStats fetches a team's season record for the team stats dialog.
+func fetchTeamStats(client api.MatchService, team api.Team, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			client = data.MockClient{}
+		}
+		if client == nil {
+			return teamStatsMsg{team: team}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		stats, err := client.TeamStats(ctx, team.ID)
+		return teamStatsMsg{team: team, stats: stats, err: err}
+	}
+}
+
+// fetchLeagueTable fetches the full table for a tracked league.
+// Used by the standalone league table view.
+func fetchLeagueTable(client api.MatchService, leagueID int, leagueName string) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return leagueTableMsg{leagueID: leagueID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		standings, err := client.LeagueTable(ctx, leagueID, leagueName)
+		if err != nil {
+			return leagueTableMsg{leagueID: leagueID}
+		}
+
+		return leagueTableMsg{leagueID: leagueID, standings: standings}
+	}
+}
+
+// copyMatchSummary copies a plain-text summary of the match to the system clipboard.
+// A missing clipboard tool is reported via the message, never as a fatal error.
+func copyMatchSummary(details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		return clipboardMsg{err: clipboard.Write(ui.MatchSummary(details))}
+	}
+}
+
+// openMatchPage opens the match's FotMob page in the default browser.
+// Failing to start a browser is reported via the message, never as a fatal error.
+func openMatchPage(details *api.MatchDetails) tea.Cmd {
+	return func() tea.Msg {
+		return matchPageMsg{err: ui.OpenURL(api.MatchURL(details))}
+	}
+}
+
+// saveSnapshot stores the last known good data of a view in the background.
+// Best effort: a failed write only costs the offline fallback.
+func saveSnapshot(kind string, snap data.Snapshot) tea.Cmd {
+	return func() tea.Msg {
+		_ = data.SaveSnapshot(kind, snap)
+		return nil
+	}
+}
+
+// loadSnapshot loads the last known good data of a view after its fetch failed.
+// gen tags the result so a snapshot arriving after a newer fetch started is dropped.
+func loadSnapshot(kind string, gen int) tea.Cmd {
+	return func() tea.Msg {
+		snap, err := data.LoadSnapshot(kind)
+		return snapshotMsg{gen: gen, kind: kind, snapshot: snap, err: err}
+	}
+}
+
+// expireTransientBanner hides the given transient banner after TransientBannerDuration.
+func expireTransientBanner(banner constants.StatusBannerType) tea.Cmd {
+	return tea.Tick(constants.TransientBannerDuration, func(time.Time) tea.Msg {
+		return transientBannerExpiredMsg{banner: banner}
+	})
+}
@@ -0,0 +1,56 @@
+package app
+
+import "sync"
+
+// DebugBufferSize is the number of debug lines kept for the in-app debug log viewer.
+const DebugBufferSize = 500
+
+// debugRingBuffer keeps the most recent debug lines in memory.
+// Shared by pointer across model copies so value-receiver handlers can append to it.
+type debugRingBuffer struct {
+	mu    sync.Mutex
+	lines []string
+	start int // Index of the oldest line once the buffer is full
+	size  int
+}
+
+// newDebugRingBuffer creates a ring buffer holding up to size lines.
+func newDebugRingBuffer(size int) *debugRingBuffer {
+	return &debugRingBuffer{
+		lines: make([]string, 0, size),
+		size:  size,
+	}
+}
+
+// Add appends a line, dropping the oldest once the buffer is full.
+func (b *debugRingBuffer) Add(line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.lines) < b.size {
+		b.lines = append(b.lines, line)
+		return
+	}
+	b.lines[b.start] = line
+	b.start = (b.start + 1) % b.size
+}
+
+// Lines returns the buffered lines, oldest first.
+func (b *debugRingBuffer) Lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	result := make([]string, 0, len(b.lines))
+	result = append(result, b.lines[b.start:]...)
+	result = append(result, b.lines[:b.start]...)
+	return result
+}
+
+// Clear removes all buffered lines.
+func (b *debugRingBuffer) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lines = b.lines[:0]
+	b.start = 0
+}
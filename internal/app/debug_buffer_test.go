@@ -0,0 +1,23 @@
+package app
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDebugRingBuffer(t *testing.T) {
+	b := newDebugRingBuffer(3)
+	for _, line := range []string{"a", "b", "c", "d", "e"} {
+		b.Add(line)
+	}
+
+	if got, want := b.Lines(), []string{"c", "d", "e"}; !slices.Equal(got, want) {
+		t.Errorf("Lines() = %v, want %v", got, want)
+	}
+
+	b.Clear()
+	b.Add("f")
+	if got, want := b.Lines(), []string{"f"}; !slices.Equal(got, want) {
+		t.Errorf("Lines() after Clear = %v, want %v", got, want)
+	}
+}
@@ -1,11 +1,18 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -14,23 +21,32 @@ import (
 // Handles navigation (up/down) and selection (enter) to switch between views.
 // On selection, immediately starts API preloading while showing spinner for 2 seconds.
 func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "j", "down":
-		if m.selected < 2 && !m.mainViewLoading { // 3 menu items: 0, 1, 2
+	switch {
+	case key.Matches(msg, m.keys.Down):
+		if m.selected < ui.MainMenuItemCount()-1 && !m.mainViewLoading {
 			m.selected++
 		}
-	case "k", "up":
+	case key.Matches(msg, m.keys.Up):
 		if m.selected > 0 && !m.mainViewLoading {
 			m.selected--
 		}
-	case "enter":
+	case key.Matches(msg, m.keys.Select):
 		if m.mainViewLoading {
 			return m, nil
 		}
 
-		// Handle Settings view separately (no API calls needed)
+		// Handle League Tables view separately (tables are fetched on selection)
 		if m.selected == 2 {
+			m.standingsState = ui.NewStandingsViewState()
+			m.keys.applyToList(&m.standingsState.List)
+			m.currentView = viewStandings
+			return m, nil
+		}
+
+		// Handle Settings view separately (no API calls needed)
+		if m.selected == 3 {
 			m.settingsState = ui.NewSettingsState()
+			m.keys.applyToList(&m.settingsState.List)
 			m.currentView = viewSettings
 			return m, nil
 		}
@@ -42,8 +58,12 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.matches = nil
 		m.upcomingMatches = nil
 		m.matchDetails = nil
+		m.loadingMatchID = 0
 		m.liveUpdates = nil
-		m.lastEvents = nil
+		m.commentary = nil
+		m.commentarySeen = nil
+		m.seenEventIDs = nil
+		m.matchStage = fotmob.StageUnknown
 		m.lastHomeScore = 0
 		m.lastAwayScore = 0
 		m.polling = false
@@ -62,22 +82,32 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.loading = true
 			m.statsData = nil                          // Clear cached data to force fresh fetch
 			m.statsDaysLoaded = 0                      // Reset progress
-			m.statsTotalDays = fotmob.StatsDataDays    // Set total days to load
 			m.statsMatchesList.SetItems([]list.Item{}) // Clear list
+			m.clearStatsAnchor()
+			m.lastFetchErr = nil
+			// Re-read the fetch depth and grouping so changes apply without restarting
+			settings, _ := data.LoadSettings()
+			m.statsDays = settings.StatsFetchDays()
+			m.statsGrouped = settings.GroupStatsMatches
+			m.statsTotalDays = m.statsDays // Set total days to load
+			if !slices.Contains(ui.StatsDateRanges(m.statsDays), m.statsDateRange) {
+				m.statsDateRange = 1
+			}
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching day 0 (today) first - results shown immediately when it completes
-			cmds = append(cmds, fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+			m.startFetch()
+			cmds = append(cmds, fetchStatsDayData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, 0, m.statsTotalDays, m.statsAnchor))
 		case 1: // Live Matches view - preload live matches progressively (parallel batches)
-			m.liveViewLoading = true
-			m.loading = true
-			m.liveBatchesLoaded = 0
-			totalLeagues := fotmob.TotalLeagues()
-			m.liveTotalBatches = (totalLeagues + LiveBatchSize - 1) / LiveBatchSize // Ceiling division
-			m.liveMatchesBuffer = nil                                               // Clear buffer
-			m.liveMatchesList.SetItems([]list.Item{})
+			// Re-read batching settings so changes apply without restarting
+			settings, _ := data.LoadSettings()
+			m.liveBatchSize, m.liveBatchDelay = settings.LiveBatching()
+			m.startLiveFetch()
 			cmds = append(cmds, ui.SpinnerTick())
-			// Start fetching batch 0 (4 leagues in parallel) - results shown when batch completes
-			cmds = append(cmds, fetchLiveBatchData(m.fotmobClient, m.useMockData, 0))
+			// Start fetching batch 0 (liveBatchSize leagues in parallel) - results shown when batch completes
+			cmds = append(cmds, fetchLiveBatchData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, 0, m.liveBatchSize, m.liveBatchDelay))
+			if m.showTicker {
+				cmds = append(cmds, fetchTickerResults(m.fotmobClient, m.useMockData))
+			}
 		}
 
 		return m, tea.Batch(cmds...)
@@ -89,33 +119,33 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // Handles date range navigation (left/right) to change the time period.
 // Uses client-side filtering from cached data - no new API calls needed!
 func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "l", "right":
-		// Cycle date range forward: 1 -> 3 -> 5 -> 1
-		switch m.statsDateRange {
-		case 1:
-			m.statsDateRange = 3
-		case 3:
-			m.statsDateRange = 5
-		default:
-			m.statsDateRange = 1
-		}
-	case "h", "left":
-		// Cycle date range backward: 1 -> 5 -> 3 -> 1
-		switch m.statsDateRange {
-		case 1:
-			m.statsDateRange = 5
-		case 5:
-			m.statsDateRange = 3
-		default:
-			m.statsDateRange = 1
-		}
-	case "tab":
-		// Tab = toggle focus between left and right panels
+	switch {
+	case key.Matches(msg, m.keys.DateForward):
+		// Cycle date range forward: Today -> 3d -> whole window -> Today
+		m.statsDateRange = m.cycleStatsDateRange(1)
+	case key.Matches(msg, m.keys.DateBack):
+		// Cycle date range backward: Today -> whole window -> 3d -> Today
+		m.statsDateRange = m.cycleStatsDateRange(-1)
+	case key.Matches(msg, m.keys.ToggleFocus):
+		// Toggle focus between left and right panels
 		m.statsRightPanelFocused = !m.statsRightPanelFocused
-		// Reset scroll position when changing focus (both ways for consistency)
+		// Keep the scroll position across focus changes unless the shown match changed
+		m.syncStatsScrollOffset()
+		if m.statsRightPanelFocused && m.statsShowEvents {
+			m.refreshStatsEventsList()
+		}
+		return m, nil
+	case msg.String() == "t":
+		// Toggle between per-type event sections and the merged timeline
+		m.statsShowTimeline = !m.statsShowTimeline
 		m.statsScrollOffset = 0
 		return m, nil
+	case msg.String() == "L":
+		// Live only = list just today's matches in progress
+		m.statsLiveOnly = !m.statsLiveOnly
+	case msg.String() == "R":
+		// Refresh all = drop cached data and re-fetch the full dataset
+		return m.refreshAllStatsData()
 	default:
 		return m, nil
 	}
@@ -129,7 +159,7 @@ func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		// Load details for first match if available
 		if len(m.matches) > 0 {
-			m.statsMatchesList.Select(0)
+			m.selectStatsMatch(m.matches[0].ID)
 			return m.loadStatsMatchDetails(m.matches[0].ID)
 		}
 		return m, nil
@@ -139,8 +169,177 @@ func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.statsViewLoading = true
 	m.loading = true
 	m.statsDaysLoaded = 0
-	m.statsTotalDays = fotmob.StatsDataDays
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+	m.statsTotalDays = m.statsDays
+	m.startFetch()
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, 0, m.statsTotalDays, m.statsAnchor))
+}
+
+// cycleStatsDateRange returns the date range delta steps from the current one,
+// wrapping around the ranges offered for the fetch depth.
+func (m model) cycleStatsDateRange(delta int) int {
+	ranges := ui.StatsDateRanges(m.statsDays)
+	i := max(slices.Index(ranges, m.statsDateRange), 0)
+	return ranges[(i+delta+len(ranges))%len(ranges)]
+}
+
+// refreshAllStatsData discards the cached stats dataset and starts a fresh progressive fetch.
+// Match list and match details caches are invalidated so every day is re-queried from the API.
+// Ignored while a day-by-day fetch is already in flight to avoid overlapping chains.
+func (m model) refreshAllStatsData() (tea.Model, tea.Cmd) {
+	if m.statsFetchInFlight() {
+		m.debugLog("Refresh all ignored - stats fetch already in progress")
+		return m, nil
+	}
+
+	if cache := m.responseCache(); cache != nil {
+		cache.ClearMatches()
+		if m.statsData != nil {
+			for _, match := range m.statsData.AllFinished {
+				cache.ClearMatchDetails(match.ID)
+			}
+		}
+	}
+
+	m.infoLog("Refreshing all stats data")
+	return m.restartStatsFetch()
+}
+
+// jumpToStatsDay re-fetches the stats window so it ends on day; a zero day returns to today.
+// Used to leave an empty window for the nearest day with matches, and to come back.
+func (m model) jumpToStatsDay(day time.Time) (tea.Model, tea.Cmd) {
+	if m.statsFetchInFlight() {
+		return m, nil
+	}
+
+	m.infoLog(fmt.Sprintf("Jumping stats window to %v", day))
+	m.statsAnchor = day
+	return m.restartStatsFetch()
+}
+
+// shiftStatsDay moves the end of the stats window by step days and re-fetches it.
+// Moving forward onto today (or later) returns the window to today.
+func (m model) shiftStatsDay(step int) (tea.Model, tea.Cmd) {
+	day := m.statsReferenceDay().AddDate(0, 0, step)
+	if day.Format("2006-01-02") >= time.Now().Format("2006-01-02") {
+		if m.statsAnchor.IsZero() {
+			return m, nil // Already showing today
+		}
+		day = time.Time{}
+	}
+	return m.jumpToStatsDay(day)
+}
+
+// clearStatsAnchor returns the stats window to today and drops any match day suggestion.
+func (m *model) clearStatsAnchor() {
+	m.statsAnchor = time.Time{}
+	m.statsProbing = false
+	m.statsSuggestedDay = time.Time{}
+}
+
+// restartStatsFetch drops the loaded stats dataset and fetches the window ending on statsAnchor.
+func (m model) restartStatsFetch() (tea.Model, tea.Cmd) {
+	m.statsData = nil
+	m.matches = nil
+	m.matchDetails = nil
+	m.matchDetailsCache = make(map[int]*api.MatchDetails)
+	m.selected = 0
+	m.statsScrollOffset = 0
+	m.statsScrollMatchID = 0
+	m.statsMatchesList.SetItems([]list.Item{})
+
+	m.statsViewLoading = true
+	m.loading = true
+	m.statsDaysLoaded = 0
+	m.statsTotalDays = m.statsDays
+	m.statsProbing = false
+	m.statsSuggestedDay = time.Time{}
+	m.lastFetchErr = nil
+	m.startFetch()
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, 0, m.statsTotalDays, m.statsAnchor))
+}
+
+// startLiveFetch resets the live view's progressive loading state and starts a new fetch
+// generation; the caller schedules the first batch.
+func (m *model) startLiveFetch() {
+	m.liveViewLoading = true
+	m.loading = true
+	m.liveBatchesLoaded = 0
+	totalLeagues := fotmob.TotalLeagues()
+	m.liveTotalBatches = (totalLeagues + m.liveBatchSize - 1) / m.liveBatchSize // Ceiling division
+	m.liveMatchesBuffer = nil                                                   // Clear buffer
+	m.liveLoadedCount = 0
+	m.liveMatchesList.SetItems([]list.Item{})
+	m.lastFetchErr = nil
+	m.startFetch()
+}
+
+// retryFetch restarts the failed fetch of the current view after an error panel was shown.
+func (m model) retryFetch() (tea.Model, tea.Cmd) {
+	m.infoLog(fmt.Sprintf("Retrying fetch after error: %v", m.lastFetchErr))
+	if m.currentView == viewStats {
+		return m.restartStatsFetch()
+	}
+
+	m.matches = nil
+	m.matchDetails = nil
+	m.selected = 0
+	m.startLiveFetch()
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchLiveBatchData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, 0, m.liveBatchSize, m.liveBatchDelay))
+}
+
+// refreshStatsEventsList repopulates the events list from the current match details and filter.
+func (m *model) refreshStatsEventsList() {
+	if m.matchDetails == nil {
+		m.statsEventsList.SetItems([]list.Item{})
+		return
+	}
+	m.statsEventsList.SetItems(ui.ToEventListItems(ui.VisibleEvents(m.matchDetails, m.detailsScoreHidden()), m.statsEventsFilter, m.statsMinuteRange))
+	m.statsEventsList.Select(0)
+}
+
+// responseCache returns the FotMob response cache, or nil when the match service
+// doesn't cache responses (e.g. the mock used in tests).
+func (m model) responseCache() *fotmob.ResponseCache {
+	if client, ok := m.fotmobClient.(*fotmob.Client); ok {
+		return client.Cache()
+	}
+	return nil
+}
+
+// statsFetchInFlight reports whether the progressive day-by-day stats fetch is still running.
+func (m model) statsFetchInFlight() bool {
+	return m.statsTotalDays > 0 && m.statsDaysLoaded < m.statsTotalDays
+}
+
+// startFetch begins a new progressive fetch generation, cancelling any fetch still in flight.
+func (m *model) startFetch() {
+	m.cancelFetch()
+	m.fetchCtx, m.fetchCancel = context.WithCancel(context.Background())
+}
+
+// cancelFetch aborts the in-flight progressive fetch. The generation is bumped so
+// responses that were already on their way are dropped when they arrive.
+// Cached data shown for the fetch goes with it.
+func (m *model) cancelFetch() {
+	if m.fetchCancel != nil {
+		m.fetchCancel()
+		m.fetchCancel = nil
+	}
+	m.fetchGen++
+	m.offlineSince = time.Time{}
+}
+
+// syncStatsScrollOffset resets the details scroll position if it belongs to a match
+// other than the one currently shown.
+func (m *model) syncStatsScrollOffset() {
+	currentID := 0
+	if m.matchDetails != nil {
+		currentID = m.matchDetails.ID
+	}
+	if currentID != m.statsScrollMatchID {
+		m.statsScrollOffset = 0
+		m.statsScrollMatchID = currentID
+	}
 }
 
 // loadMatchDetails loads match details for the live matches view.
@@ -152,11 +351,18 @@ func (m model) loadMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 // loadMatchDetailsWithRefresh loads match details for the live matches view with optional cache bypass.
 func (m model) loadMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.Model, tea.Cmd) {
 	m.liveUpdates = nil
-	m.lastEvents = nil
+	if m.matchDetails == nil || m.matchDetails.ID != matchID {
+		m.commentary = nil
+		m.commentarySeen = nil
+	}
+	m.seenEventIDs = nil
+	m.matchStage = fotmob.StageUnknown
 	m.lastHomeScore = 0
 	m.lastAwayScore = 0
+	m.liveClockSince = time.Time{}
 	m.loading = true
 	m.liveViewLoading = true
+	m.loadingMatchID = matchID
 	m.polling = false // Reset polling state - this is a new match load, not a poll refresh
 
 	var cmd tea.Cmd
@@ -176,9 +382,15 @@ func (m model) loadStatsMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 }
 
 // loadStatsMatchDetailsWithRefresh loads match details with optional cache bypass.
+// Selecting a different match resets the details scroll position; refreshing the same match keeps it.
 func (m model) loadStatsMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("Loading match details for ID: %d (forceRefresh: %v)", matchID, forceRefresh))
 
+	if matchID != m.statsScrollMatchID {
+		m.statsScrollOffset = 0
+		m.statsScrollMatchID = matchID
+	}
+
 	// Check cache unless force refresh is requested
 	if !forceRefresh {
 		if cached, ok := m.matchDetailsCache[matchID]; ok {
@@ -195,6 +407,7 @@ func (m model) loadStatsMatchDetailsWithRefresh(matchID int, forceRefresh bool)
 	// Fetch from API
 	m.loading = true
 	m.statsViewLoading = true
+	m.loadingMatchID = matchID
 	m.debugLog(fmt.Sprintf("Fetching match details from API for ID: %d", matchID))
 	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetailsFotmob(m.fotmobClient, matchID, m.useMockData))
 }
@@ -215,15 +428,24 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case " ": // Space to toggle selection
 			m.settingsState.Toggle()
 			return m, nil
-		case "right", "l": // Right arrow or 'l' to next tab
+		case "a": // Toggle the main menu logo animation
+			m.settingsState.ToggleAnimateLogo()
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.DateForward): // Next tab
 			m.settingsState.NextRegion()
 			return m, nil
-		case "left", "h": // Left arrow or 'h' to previous tab
+		case key.Matches(msg, m.keys.DateBack): // Previous tab
 			m.settingsState.PreviousRegion()
 			return m, nil
-		case "enter":
+		}
+		if key.Matches(msg, m.keys.Select) {
 			// Save settings and return to main menu
 			_ = m.settingsState.Save() // Best-effort save
+			if !m.settingsState.AnimateLogo {
+				m.animatedLogo = nil // Re-enabling takes effect on next launch
+			}
 			m.settingsState = nil
 			m.currentView = viewMain
 			m.selected = 0
@@ -236,3 +458,440 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.settingsState.List, listCmd = m.settingsState.List.Update(msg)
 	return m, listCmd
 }
+
+// handleStandingsViewKeys processes keyboard input for the league table view.
+// In the picker, Enter opens the highlighted league; in a table, j/k scroll and h/l switch league.
+func (m model) handleStandingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	state := m.standingsState
+	if state == nil {
+		return m, nil
+	}
+
+	if state.ShowingTable() {
+		switch {
+		case key.Matches(msg, m.keys.Down):
+			state.Scroll(1, len(m.currentLeagueTable()), m.height)
+		case key.Matches(msg, m.keys.Up):
+			state.Scroll(-1, len(m.currentLeagueTable()), m.height)
+		case key.Matches(msg, m.keys.DateForward):
+			state.CycleLeague(1)
+			return m.loadLeagueTable()
+		case key.Matches(msg, m.keys.DateBack):
+			state.CycleLeague(-1)
+			return m.loadLeagueTable()
+		}
+		return m, nil
+	}
+
+	// Picker: let the list handle everything while filtering
+	if state.List.FilterState() != list.Filtering && key.Matches(msg, m.keys.Select) {
+		if state.SelectHighlighted() {
+			return m.loadLeagueTable()
+		}
+		return m, nil
+	}
+
+	var listCmd tea.Cmd
+	state.List, listCmd = state.List.Update(msg)
+	return m, listCmd
+}
+
+// loadLeagueTable shows the current league's table, fetching it unless already cached this session.
+func (m model) loadLeagueTable() (tea.Model, tea.Cmd) {
+	league, ok := m.standingsState.CurrentLeague()
+	if !ok {
+		return m, nil
+	}
+
+	if _, cached := m.leagueTables[league.ID]; cached {
+		m.standingsState.Loading = false
+		return m, nil
+	}
+
+	m.standingsState.Loading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchLeagueTable(m.fotmobClient, league.ID, league.Name))
+}
+
+// currentLeagueTable returns the cached table for the league shown in the league table view.
+func (m model) currentLeagueTable() []api.LeagueTableEntry {
+	if m.standingsState == nil {
+		return nil
+	}
+	league, ok := m.standingsState.CurrentLeague()
+	if !ok {
+		return nil
+	}
+	return m.leagueTables[league.ID]
+}
+
+// scorerDetailsChunkSize is how many match details the top scorers view fetches at once.
+const scorerDetailsChunkSize = 5
+
+// openScorersView shows the top scorers across the finished matches in the stats date range.
+// Cached match details are used right away; the rest are fetched in chunks, re-aggregating as each arrives.
+func (m model) openScorersView() (tea.Model, tea.Cmd) {
+	m.currentView = viewScorers
+	m.scorersScroll = 0
+	m.scorersGen++
+
+	m.scorersPending = nil
+	for _, match := range m.matches {
+		if _, cached := m.matchDetailsCache[match.ID]; !cached {
+			m.scorersPending = append(m.scorersPending, match.ID)
+		}
+	}
+	m.scorersTotal = len(m.matches)
+	m.scorersLoaded = m.scorersTotal - len(m.scorersPending)
+	m.scorers = ui.AggregateScorers(m.scorerMatchDetails())
+
+	if len(m.scorersPending) == 0 {
+		m.scorersLoading = false
+		return m, nil
+	}
+
+	m.scorersLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), m.fetchNextScorerDetails())
+}
+
+// closeScorersView returns to the stats view, dropping any detail chunks still in flight.
+func (m model) closeScorersView() (tea.Model, tea.Cmd) {
+	m.currentView = viewStats
+	m.scorersLoading = false
+	m.scorersPending = nil
+	m.scorersGen++
+	return m, nil
+}
+
+// fetchNextScorerDetails takes the next chunk of pending match IDs and returns the command fetching it.
+func (m *model) fetchNextScorerDetails() tea.Cmd {
+	n := min(scorerDetailsChunkSize, len(m.scorersPending))
+	chunk := m.scorersPending[:n]
+	m.scorersPending = m.scorersPending[n:]
+	return fetchScorerDetails(m.fotmobClient, chunk, m.useMockData, m.scorersGen)
+}
+
+// scorerMatchDetails returns the cached details of the matches in the stats date range.
+func (m model) scorerMatchDetails() []*api.MatchDetails {
+	var details []*api.MatchDetails
+	for _, match := range m.matches {
+		if cached, ok := m.matchDetailsCache[match.ID]; ok {
+			details = append(details, cached)
+		}
+	}
+	return details
+}
+
+// handleScorersViewKeys processes keyboard input for the top scorers view.
+func (m model) handleScorersViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	maxOffset := max(len(m.scorers)-ui.ScorersVisibleRows(m.height), 0)
+	switch {
+	case key.Matches(msg, m.keys.Down):
+		m.scorersScroll = min(m.scorersScroll+1, maxOffset)
+	case key.Matches(msg, m.keys.Up):
+		m.scorersScroll = max(m.scorersScroll-1, 0)
+	}
+	return m, nil
+}
+
+// handleDebugViewKeys processes keyboard input for the debug log viewer.
+// Scrolling keys are delegated to the viewport; c clears the buffer.
+func (m model) handleDebugViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.syncDebugViewport()
+
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Back) || msg.String() == "ctrl+l":
+		m.debugViewOpen = false
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "c":
+		m.debugBuffer.Clear()
+		m.syncDebugViewport()
+		return m, nil
+	case "g", "home":
+		m.debugViewport.GotoTop()
+		return m, nil
+	case "G", "end":
+		m.debugViewport.GotoBottom()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.debugViewport, cmd = m.debugViewport.Update(msg)
+	return m, cmd
+}
+
+// syncDebugViewport sizes the debug viewport and loads the buffered lines into it.
+func (m *model) syncDebugViewport() {
+	width, height := ui.DebugViewportSize(m.width, m.height)
+	m.debugViewport.Width = width
+	m.debugViewport.Height = height
+	m.debugViewport.SetContent(ui.DebugLogContent(m.debugBuffer.Lines()))
+}
+
+// matchSortBanners announces the new order after the match lists are re-sorted.
+var matchSortBanners = map[ui.MatchSort]constants.StatusBannerType{
+	ui.SortByKickoff:  constants.StatusBannerSortKickoff,
+	ui.SortByLeague:   constants.StatusBannerSortLeague,
+	ui.SortByHomeTeam: constants.StatusBannerSortHomeTeam,
+}
+
+// cycleMatchSort switches the match lists to the next sort order and re-sorts the
+// current view's list, keeping the selected match selected.
+func (m model) cycleMatchSort() (tea.Model, tea.Cmd) {
+	m.matchSort = m.matchSort.Next()
+
+	switch m.currentView {
+	case viewLiveMatches:
+		selectedID := selectedMatchID(m.liveMatchesList)
+		m.sortLiveMatches(m.matches)
+		m.setLiveListWindow(m.liveLoadedCount)
+		m.selectLiveMatch(selectedID)
+	case viewStats:
+		// Re-sorts and restores the selection
+		m.applyStatsDateFilter()
+	}
+
+	m.transientBanner = matchSortBanners[m.matchSort]
+	return m, expireTransientBanner(m.transientBanner)
+}
+
+// toggleFavoritesFirst lists the favorite teams' matches first, or back in the plain
+// sort order, re-sorting the current view's list as cycleMatchSort does.
+func (m model) toggleFavoritesFirst() (tea.Model, tea.Cmd) {
+	if len(m.favoriteTeams) == 0 {
+		m.transientBanner = constants.StatusBannerNoFavorites
+		return m, expireTransientBanner(m.transientBanner)
+	}
+	m.favoritesFirst = !m.favoritesFirst
+
+	switch m.currentView {
+	case viewLiveMatches:
+		selectedID := selectedMatchID(m.liveMatchesList)
+		m.resortLiveMatches()
+		m.setLiveListWindow(m.liveLoadedCount)
+		m.selectLiveMatch(selectedID)
+	case viewStats:
+		m.applyStatsDateFilter()
+	}
+
+	m.transientBanner = constants.StatusBannerFavoritesOff
+	if m.favoritesFirst {
+		m.transientBanner = constants.StatusBannerFavoritesOn
+	}
+	return m, expireTransientBanner(m.transientBanner)
+}
+
+// refreshReplays searches Reddit again for the shown match's goals without a replay link,
+// instead of waiting out their "not found" markers. The polling spinner and a banner show
+// the search running; handleGoalLinks reports how many links it found.
+func (m model) refreshReplays() (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil || m.redditClient == nil || m.replaysSearching {
+		return m, nil
+	}
+
+	for key, link := range m.goalLinks {
+		if key.MatchID == m.matchDetails.ID && reddit.IsNotFound(link) {
+			delete(m.goalLinks, key)
+		}
+	}
+
+	// Replace any prefetch still running for the match
+	if m.goalLinksCancel != nil {
+		m.goalLinksCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.goalLinksCancel = cancel
+	wasActive := m.spinnersActive()
+	m.replaysSearching = true
+	m.replaysFound = 0
+
+	cmds := []tea.Cmd{refetchGoalLinks(ctx, m.redditClient, m.matchDetails)}
+	if !wasActive {
+		cmds = append(cmds, ui.SpinnerTick())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// toggleResultsTicker shows or hides the results ticker at the bottom of the live view,
+// fetching today's results the first time it is shown.
+func (m model) toggleResultsTicker() (tea.Model, tea.Cmd) {
+	wasActive := m.spinnersActive()
+	m.showTicker = !m.showTicker
+	m.ensureLiveListSize()
+
+	var cmds []tea.Cmd
+	if m.showTicker && m.tickerResults == nil {
+		cmds = append(cmds, fetchTickerResults(m.fotmobClient, m.useMockData))
+	}
+	if !wasActive && m.spinnersActive() {
+		cmds = append(cmds, ui.SpinnerTick())
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// adjustListPanel widens (step > 0) or narrows the match list by ListPanelPercentStep,
+// within MinListPanelPercent-MaxListPanelPercent, and saves the new split to settings.yaml.
+func (m model) adjustListPanel(step int) (tea.Model, tea.Cmd) {
+	percent := m.listPanelPercent + step*data.ListPanelPercentStep
+	percent = min(max(percent, data.MinListPanelPercent), data.MaxListPanelPercent)
+	if percent == m.listPanelPercent {
+		return m, nil
+	}
+	m.listPanelPercent = percent
+	m.ensureLiveListSize()
+	m.ensureStatsListSize()
+
+	// Preserve settings not changed here
+	settings, _ := data.LoadSettings()
+	settings.ListPanelPercent = percent
+	if err := data.SaveSettings(settings); err != nil {
+		m.warnLog(fmt.Sprintf("Could not save the panel widths: %v", err))
+	}
+	return m, nil
+}
+
+// toggleSpoilerMode hides or shows the scores of the matches not revealed yet.
+func (m model) toggleSpoilerMode() (tea.Model, tea.Cmd) {
+	m.spoilerMode = !m.spoilerMode
+	m.refreshHiddenScores()
+
+	m.transientBanner = constants.StatusBannerSpoilersOff
+	if m.spoilerMode {
+		m.transientBanner = constants.StatusBannerSpoilersOn
+	}
+	return m, expireTransientBanner(m.transientBanner)
+}
+
+// revealMatch shows the score, goals and cards of a match hidden by spoiler mode:
+// the one in the details panel, or else the one selected in the list.
+func (m model) revealMatch(l list.Model) (tea.Model, tea.Cmd) {
+	if !m.spoilerMode {
+		return m, nil
+	}
+	matchID := selectedMatchID(l)
+	if m.matchDetails != nil {
+		matchID = m.matchDetails.ID
+	}
+	if matchID == 0 {
+		return m, nil
+	}
+	m.revealedMatches[matchID] = true
+	m.refreshHiddenScores()
+	return m, nil
+}
+
+// spoilers returns the spoiler mode state the views render with.
+func (m model) spoilers() ui.Spoilers {
+	return ui.Spoilers{On: m.spoilerMode, Revealed: m.revealedMatches}
+}
+
+// detailsScoreHidden reports whether spoiler mode hides the result of the shown match.
+func (m model) detailsScoreHidden() bool {
+	return m.matchDetails != nil && m.spoilers().ScoreHidden(m.matchDetails.Match)
+}
+
+// markDisplay marks how matches are listed: with the league flag if enabled and, in
+// spoiler mode, with their score hidden.
+func (m model) markDisplay(matches []ui.MatchDisplay) {
+	ui.ShowLeagueFlags(matches, m.leagueFlags)
+	ui.HideScores(matches, m.spoilers())
+}
+
+// refreshHiddenScores lists the matches again after spoiler mode was toggled or a match
+// revealed, so their scores are masked or shown.
+func (m *model) refreshHiddenScores() {
+	switch m.currentView {
+	case viewLiveMatches:
+		m.setLiveListWindow(m.liveLoadedCount)
+	case viewStats:
+		m.applyStatsDateFilter()
+	}
+	m.refreshStatsEventsList()
+}
+
+// sortMatches orders matches by the current sort order, after the favorite teams'
+// matches while the favorites sort is on.
+func (m model) sortMatches(matches []ui.MatchDisplay) {
+	ui.MarkFavorites(matches, m.favoriteTeams)
+	m.markDisplay(matches)
+	ui.SortMatches(matches, m.matchSort)
+	if m.favoritesFirst {
+		ui.FavoritesFirst(matches)
+	}
+}
+
+// sortLiveMatches orders live view matches as sortMatches does, pinned matches first.
+func (m model) sortLiveMatches(matches []ui.MatchDisplay) {
+	m.sortMatches(matches)
+	ui.PinMatches(matches, m.pinnedMatches)
+}
+
+// togglePin pins the selected live match to the top of the list, or unpins it back
+// into its place in the current sort order. Pins last for the session.
+func (m model) togglePin() (tea.Model, tea.Cmd) {
+	matchID := selectedMatchID(m.liveMatchesList)
+	if matchID == 0 {
+		return m, nil
+	}
+	if m.pinnedMatches[matchID] {
+		delete(m.pinnedMatches, matchID)
+	} else {
+		m.pinnedMatches[matchID] = true
+	}
+
+	m.resortLiveMatches()
+	m.setLiveListWindow(m.liveLoadedCount)
+	m.selectLiveMatch(matchID)
+	return m, nil
+}
+
+// resortLiveMatches sorts the live matches again from the fetch order, so a match
+// unpinned (or no longer floated as a favorite) goes back among matches with the same
+// kickoff exactly where it was.
+func (m *model) resortLiveMatches() {
+	if len(m.liveMatchesBuffer) == len(m.matches) {
+		for i, match := range m.liveMatchesBuffer {
+			m.matches[i] = ui.MatchDisplay{Match: match}
+		}
+	}
+	m.sortLiveMatches(m.matches)
+}
+
+// selectedMatchID returns the ID of the match selected in a match list, or 0.
+func selectedMatchID(l list.Model) int {
+	if item, ok := l.SelectedItem().(ui.MatchListItem); ok {
+		return item.Match.ID
+	}
+	return 0
+}
+
+// selectStatsMatch selects the match with matchID in the stats list, whose indexes
+// differ from m.matches when group headers are shown. Does nothing if it is not listed.
+func (m *model) selectStatsMatch(matchID int) {
+	for i, item := range m.statsMatchesList.Items() {
+		if match, ok := item.(ui.MatchListItem); ok && match.Match.ID == matchID {
+			m.statsMatchesList.Select(i)
+			return
+		}
+	}
+}
+
+// selectLiveMatch selects the match with matchID in the live list, growing the loaded
+// window if it lies beyond it. Does nothing if the match is not listed.
+func (m *model) selectLiveMatch(matchID int) {
+	for i, match := range m.matches {
+		if match.ID != matchID {
+			continue
+		}
+		if i >= m.liveLoadedCount {
+			m.setLiveListWindow(i + 1)
+		}
+		m.selected = i
+		m.liveMatchesList.Select(i)
+		return
+	}
+}
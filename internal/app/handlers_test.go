@@ -0,0 +1,1272 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/clipboard"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestModel returns a model backed by the mock match service, with settings
+// and caches kept in a temporary home directory and no Reddit client.
+func newTestModel(t *testing.T) model {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CACHE_HOME", "")
+
+	m := New(false, false, false, false, "test")
+	m.fotmobClient = data.MockClient{}
+	m.redditClient = nil
+	m.width, m.height = 120, 40
+	return m
+}
+
+// runCmd executes cmd, expanding batches, and returns the messages it produced.
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, runCmd(c)...)
+	}
+	return msgs
+}
+
+// detailsFrom returns the match details delivered by cmd, or nil if it delivered none.
+func detailsFrom(t *testing.T, cmd tea.Cmd) *api.MatchDetails {
+	t.Helper()
+	for _, msg := range runCmd(cmd) {
+		if details, ok := msg.(matchDetailsMsg); ok {
+			return details.details
+		}
+	}
+	return nil
+}
+
+func TestLoadStatsMatchDetailsUsesCache(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+
+	cached := &api.MatchDetails{Match: api.Match{ID: 42}}
+	m.matchDetailsCache[42] = cached
+
+	updated, cmd := m.loadStatsMatchDetails(42)
+	if cmd != nil {
+		t.Error("loadStatsMatchDetails() fetched a cached match")
+	}
+	if got := updated.(model).matchDetails; got != cached {
+		t.Errorf("matchDetails = %v, want the cached details", got)
+	}
+}
+
+func TestLoadStatsMatchDetailsFetchesAndCaches(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	matchID := data.MockFinishedMatches()[0].ID
+
+	updated, cmd := m.loadStatsMatchDetails(matchID)
+	if !updated.(model).statsViewLoading {
+		t.Error("statsViewLoading = false while fetching details")
+	}
+
+	details := detailsFrom(t, cmd)
+	if details == nil || details.ID != matchID {
+		t.Fatalf("fetched details = %v, want match %d", details, matchID)
+	}
+
+	updated, _ = updated.(model).Update(matchDetailsMsg{details: details})
+	m = updated.(model)
+	if m.statsViewLoading {
+		t.Error("statsViewLoading = true after details arrived")
+	}
+	if m.matchDetailsCache[matchID] != details {
+		t.Errorf("details for match %d were not cached", matchID)
+	}
+}
+
+func TestStatsViewKeysChangeDateRange(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	finished := data.MockFinishedMatches()
+	m.statsData = &api.StatsData{AllFinished: finished, TodayFinished: finished}
+
+	updated, cmd := m.handleStatsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	m = updated.(model)
+
+	if m.statsDateRange != 3 {
+		t.Errorf("statsDateRange = %d, want 3", m.statsDateRange)
+	}
+	if want := len(filterMatchesByDays(finished, 3)); len(m.matches) != want || want == 0 {
+		t.Fatalf("len(matches) = %d, want %d", len(m.matches), want)
+	}
+
+	// The first match of the new range is loaded through the match service
+	details := detailsFrom(t, cmd)
+	if details == nil || details.ID != m.matches[0].ID {
+		t.Errorf("fetched details = %v, want match %d", details, m.matches[0].ID)
+	}
+}
+
+func TestStatsLiveOnlyToggle(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	finished := data.MockFinishedMatches()
+	live := data.MockLiveMatches()
+	m.statsData = &api.StatsData{AllFinished: finished, TodayFinished: finished, TodayLive: live}
+
+	liveOnly := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")}
+	updated, _ := m.handleStatsSelection(liveOnly)
+	m = updated.(model)
+	wantLive := 0
+	for _, match := range live {
+		if match.Status == api.MatchStatusLive {
+			wantLive++
+		}
+	}
+	if !m.statsLiveOnly || len(m.matches) != wantLive || wantLive == 0 {
+		t.Fatalf("live only: %d matches, want the %d in progress", len(m.matches), wantLive)
+	}
+	for _, match := range m.matches {
+		if match.Status != api.MatchStatusLive {
+			t.Errorf("match %d has status %q in the live only list", match.ID, match.Status)
+		}
+	}
+
+	// Live matches are today's, so an earlier match day has none
+	m.statsAnchor = time.Now().AddDate(0, 0, -3)
+	m.applyStatsDateFilter()
+	if len(m.matches) != 0 {
+		t.Errorf("live only on an earlier day: %d matches, want none", len(m.matches))
+	}
+	m.statsAnchor = time.Time{}
+
+	updated, _ = m.handleStatsSelection(liveOnly)
+	m = updated.(model)
+	if m.statsLiveOnly || len(m.matches) != len(filterMatchesByDays(finished, 1)) {
+		t.Errorf("toggling off should list today's finished matches again, got %d", len(m.matches))
+	}
+}
+
+func TestPollingStopsWhenMatchFinishes(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	live := data.MockLiveMatches()[0]
+	details, err := data.MockMatchDetails(live.ID)
+	if err != nil || details == nil {
+		t.Fatalf("MockMatchDetails(%d) = %v, %v", live.ID, details, err)
+	}
+	m.matchDetails = details
+	m.polling = true
+
+	finished := *details
+	finished.Status = api.MatchStatusFinished
+	updated, cmd := m.Update(matchDetailsMsg{details: &finished})
+	m = updated.(model)
+
+	if m.polling {
+		t.Error("polling = true after the match finished")
+	}
+	for _, msg := range runCmd(cmd) {
+		if _, ok := msg.(pollTickMsg); ok {
+			t.Error("another poll was scheduled after the match finished")
+		}
+	}
+	if len(m.liveUpdates) == 0 || !strings.Contains(m.liveUpdates[0], "Full Time") {
+		t.Errorf("liveUpdates = %q, want a Full Time update first", m.liveUpdates)
+	}
+
+	// Manual refresh still works
+	_, cmd = m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if refreshed := detailsFrom(t, cmd); refreshed == nil || refreshed.ID != live.ID {
+		t.Errorf("refreshed details = %v, want match %d", refreshed, live.ID)
+	}
+}
+
+func TestCycleMatchSortKeepsSelection(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	for _, match := range data.MockLiveMatches() {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.setLiveListWindow(len(m.matches))
+
+	last := len(m.matches) - 1
+	m.liveMatchesList.Select(last)
+	selectedID := m.matches[last].ID
+
+	for range 3 {
+		updated, _ := m.cycleMatchSort()
+		m = updated.(model)
+		if got := selectedMatchID(m.liveMatchesList); got != selectedID {
+			t.Fatalf("sort %d: selected match = %d, want %d", m.matchSort, got, selectedID)
+		}
+	}
+	if m.matchSort != ui.SortByKickoff {
+		t.Errorf("matchSort = %d after a full cycle, want SortByKickoff", m.matchSort)
+	}
+}
+
+func TestTogglePin(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.liveMatchesBuffer = data.MockLiveMatches()
+	for _, match := range m.liveMatchesBuffer {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.sortLiveMatches(m.matches)
+	m.setLiveListWindow(len(m.matches))
+	ids := func() []int {
+		var ids []int
+		for _, match := range m.matches {
+			ids = append(ids, match.ID)
+		}
+		return ids
+	}
+	sorted := ids()
+
+	last := len(m.matches) - 1
+	m.liveMatchesList.Select(last)
+	pinnedID := m.matches[last].ID
+
+	updated, _ := m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	m = updated.(model)
+	if m.matches[0].ID != pinnedID || !m.matches[0].Pinned {
+		t.Fatalf("first match = %d (pinned %v), want pinned match %d", m.matches[0].ID, m.matches[0].Pinned, pinnedID)
+	}
+	if got := selectedMatchID(m.liveMatchesList); got != pinnedID {
+		t.Errorf("selected match = %d after pinning, want %d", got, pinnedID)
+	}
+	if title := m.matches[0].Title(); !strings.HasPrefix(title, ui.PinGlyph+" ") {
+		t.Errorf("pinned title = %q, want the pin glyph", title)
+	}
+
+	updated, _ = m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	m = updated.(model)
+	if got := ids(); !slices.Equal(got, sorted) {
+		t.Errorf("order after unpinning = %v, want %v", got, sorted)
+	}
+	if got := selectedMatchID(m.liveMatchesList); got != pinnedID {
+		t.Errorf("selected match = %d after unpinning, want %d", got, pinnedID)
+	}
+}
+
+func TestWatchModeRotatesLiveMatches(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	for _, match := range data.MockLiveMatches() {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.setLiveListWindow(len(m.matches))
+	m.watchInterval = time.Millisecond
+	if len(m.matches) < 2 {
+		t.Fatalf("need at least two mock live matches, got %d", len(m.matches))
+	}
+
+	updated, cmd := m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	m = updated.(model)
+	if !m.watchMode || cmd == nil {
+		t.Fatal("w did not start watch mode")
+	}
+	tick := watchTickMsg{gen: m.watchGen}
+
+	updated, cmd = m.Update(tick)
+	m = updated.(model)
+	if got, want := selectedMatchID(m.liveMatchesList), m.matches[1].ID; got != want {
+		t.Errorf("selected match after a watch tick = %d, want %d", got, want)
+	}
+	if details := detailsFrom(t, cmd); details == nil || details.ID != m.matches[1].ID {
+		t.Errorf("watch tick loaded %v, want match %d", details, m.matches[1].ID)
+	}
+
+	// Manual navigation stops watch mode and drops the pending tick
+	updated, _ = m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(model)
+	if m.watchMode {
+		t.Error("watchMode = true after a navigation key")
+	}
+	selected := selectedMatchID(m.liveMatchesList)
+	updated, cmd = m.Update(tick)
+	if cmd != nil || selectedMatchID(updated.(model).liveMatchesList) != selected {
+		t.Error("a stale watch tick moved the selection")
+	}
+}
+
+func TestEmptyStatsWindowOffersNearestMatchDay(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	m.statsData = &api.StatsData{}
+	m.statsTotalDays = 5
+	m.statsDaysLoaded = 4
+	m.startFetch()
+
+	// The last day of an empty window starts a probe; the mock has nothing before today
+	updated, cmd := m.Update(statsDayDataMsg{gen: m.fetchGen, dayIndex: 4, isLast: true})
+	m = updated.(model)
+	if !m.statsProbing {
+		t.Fatal("statsProbing = false after an empty window")
+	}
+	var probed bool
+	for _, msg := range runCmd(cmd) {
+		if result, ok := msg.(nearestMatchDayMsg); ok {
+			probed = true
+			if !errors.Is(result.err, api.ErrNoMatchDay) {
+				t.Errorf("probe error = %v, want ErrNoMatchDay", result.err)
+			}
+		}
+	}
+	if !probed {
+		t.Fatal("no nearest match day probe was started")
+	}
+
+	// A found day is offered, and g re-fetches the window ending on it
+	today := time.Now()
+	updated, _ = m.Update(nearestMatchDayMsg{gen: m.fetchGen, day: today})
+	m = updated.(model)
+	if m.statsProbing || !m.statsSuggestedDay.Equal(today) {
+		t.Fatalf("statsProbing = %v, statsSuggestedDay = %v, want the found day", m.statsProbing, m.statsSuggestedDay)
+	}
+
+	updated, cmd = m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+	m = updated.(model)
+	if !m.statsAnchor.Equal(today) || !m.statsSuggestedDay.IsZero() {
+		t.Errorf("statsAnchor = %v, statsSuggestedDay = %v after g", m.statsAnchor, m.statsSuggestedDay)
+	}
+	var finished int
+	for _, msg := range runCmd(cmd) {
+		if day, ok := msg.(statsDayDataMsg); ok {
+			finished += len(day.finished)
+		}
+	}
+	if finished == 0 {
+		t.Error("the re-fetched window has no finished matches")
+	}
+}
+
+func TestStatsDateRangeFollowsFetchDepth(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	m.statsDays = 7
+
+	var got []int
+	for range 4 {
+		m.statsDateRange = m.cycleStatsDateRange(1)
+		got = append(got, m.statsDateRange)
+	}
+	if want := []int{3, 7, 1, 3}; !slices.Equal(got, want) {
+		t.Errorf("date ranges cycling forward = %v, want %v", got, want)
+	}
+
+	m.statsDays = 3
+	m.statsDateRange = 1
+	if back := m.cycleStatsDateRange(-1); back != 3 {
+		t.Errorf("date range before Today with a 3 day window = %d, want 3", back)
+	}
+}
+
+func TestFailedStatsFetchOffersRetry(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	m.statsViewLoading = true
+	m.statsTotalDays = 1
+	m.startFetch()
+
+	fetchErr := &url.Error{Op: "Get", URL: "https://www.fotmob.com/api/data/matches", Err: errors.New("connection refused")}
+	updated, cmd := m.Update(statsDayDataMsg{gen: m.fetchGen, dayIndex: 0, isToday: true, isLast: true, err: fetchErr})
+	m = updated.(model)
+	if !m.showingFetchError() {
+		t.Fatal("showingFetchError() = false after the only day failed")
+	}
+	for _, msg := range runCmd(cmd) {
+		if _, ok := msg.(nearestMatchDayMsg); ok {
+			t.Error("a nearest match day probe was started after a failed fetch")
+		}
+	}
+	if view := m.View(); !strings.Contains(view, constants.FetchErrorNetwork) {
+		t.Errorf("view does not explain the network error:\n%s", view)
+	}
+
+	// r retries, and a successful retry clears the error
+	updated, cmd = m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	m = updated.(model)
+	if !m.statsViewLoading || m.showingFetchError() {
+		t.Fatalf("statsViewLoading = %v, showingFetchError() = %v after r", m.statsViewLoading, m.showingFetchError())
+	}
+	for _, msg := range runCmd(cmd) {
+		if day, ok := msg.(statsDayDataMsg); ok {
+			updated, _ = m.Update(day)
+			m = updated.(model)
+		}
+	}
+	if m.lastFetchErr != nil || len(m.matches) == 0 {
+		t.Errorf("lastFetchErr = %v with %d matches after a successful retry", m.lastFetchErr, len(m.matches))
+	}
+}
+
+func TestFailedStatsFetchShowsSnapshot(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	m.statsTotalDays = 1
+
+	// A successful fetch saves the snapshot
+	m.startFetch()
+	updated, cmd := m.Update(statsDayDataMsg{gen: m.fetchGen, dayIndex: 0, isToday: true, isLast: true, finished: data.MockFinishedMatches()})
+	m = updated.(model)
+	runCmd(cmd)
+
+	// A failed fetch falls back to it
+	m.startFetch()
+	m.statsData = nil
+	m.statsDaysLoaded = 0
+	fetchErr := &url.Error{Op: "Get", URL: "https://www.fotmob.com/api/data/matches", Err: errors.New("connection refused")}
+	updated, cmd = m.Update(statsDayDataMsg{gen: m.fetchGen, dayIndex: 0, isToday: true, isLast: true, err: fetchErr})
+	m = updated.(model)
+	var snapshot *snapshotMsg
+	for _, msg := range runCmd(cmd) {
+		if s, ok := msg.(snapshotMsg); ok {
+			snapshot = &s
+		}
+	}
+	if snapshot == nil || snapshot.snapshot == nil {
+		t.Fatalf("no snapshot loaded after the fetch failed: %+v", snapshot)
+	}
+
+	updated, cmd = m.Update(*snapshot)
+	m = updated.(model)
+	for _, msg := range runCmd(cmd) {
+		if details, ok := msg.(matchDetailsMsg); ok {
+			updated, _ = m.Update(details)
+			m = updated.(model)
+		}
+	}
+	if m.showingFetchError() || len(m.matches) == 0 || m.offlineSince.IsZero() {
+		t.Fatalf("showingFetchError() = %v, %d matches, offlineSince = %v after the snapshot loaded", m.showingFetchError(), len(m.matches), m.offlineSince)
+	}
+	if view := m.View(); !strings.Contains(view, "OFFLINE") {
+		t.Errorf("view does not mark the data as offline:\n%s", view)
+	}
+
+	// Leaving the fetch drops the offline mark
+	m.cancelFetch()
+	if !m.offlineSince.IsZero() {
+		t.Error("offlineSince kept after the fetch was cancelled")
+	}
+}
+
+func TestEmptyLiveFetchIsNotAnError(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.startLiveFetch()
+
+	updated, _ := m.Update(liveBatchDataMsg{gen: m.fetchGen, isLast: true})
+	m = updated.(model)
+	if m.showingFetchError() {
+		t.Error("showingFetchError() = true for a fetch that found no live matches")
+	}
+
+	m.startLiveFetch()
+	updated, _ = m.Update(liveBatchDataMsg{gen: m.fetchGen, isLast: true, err: errors.New("status 500")})
+	m = updated.(model)
+	if !m.showingFetchError() || isNetworkError(m.lastFetchErr) {
+		t.Errorf("showingFetchError() = %v, isNetworkError = %v, want a non-network error panel", m.showingFetchError(), isNetworkError(m.lastFetchErr))
+	}
+}
+
+func TestLiveRefreshLeavesOfflineWhenNothingIsLive(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	for _, match := range data.MockLiveMatches() {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.offlineSince = time.Now().Add(-time.Hour)
+
+	// A failed refresh keeps the snapshot on screen
+	updated, _ := m.Update(liveRefreshMsg{err: errors.New("dial tcp: no such host")})
+	m = updated.(model)
+	if m.offlineSince.IsZero() || len(m.matches) == 0 {
+		t.Fatalf("failed refresh dropped the snapshot: offlineSince = %v, %d matches", m.offlineSince, len(m.matches))
+	}
+
+	// Back online with nothing live clears both the snapshot and the offline state
+	updated, _ = m.Update(liveRefreshMsg{})
+	m = updated.(model)
+	if !m.offlineSince.IsZero() || len(m.matches) != 0 {
+		t.Errorf("empty refresh kept offlineSince = %v and %d matches", m.offlineSince, len(m.matches))
+	}
+}
+
+func TestLiveRefreshFailureKeepsMatchesOffline(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	updated, _ := m.Update(liveRefreshMsg{matches: data.MockLiveMatches()})
+	m = updated.(model)
+	shown := len(m.matches)
+	if shown == 0 || !m.offlineSince.IsZero() {
+		t.Fatalf("refresh showed %d matches, offlineSince = %v; want matches online", shown, m.offlineSince)
+	}
+
+	// The network drops: the matches stay, marked as fetched before the failure
+	updated, _ = m.Update(liveRefreshMsg{err: errors.New("dial tcp: no such host")})
+	m = updated.(model)
+	if len(m.matches) != shown || len(m.liveMatchesList.Items()) == 0 {
+		t.Errorf("failed refresh left %d matches and %d list items, want the %d shown", len(m.matches), len(m.liveMatchesList.Items()), shown)
+	}
+	if m.offlineSince.IsZero() || !m.offlineSince.Equal(m.liveFetchedAt) {
+		t.Errorf("offlineSince = %v, want the last fetch at %v", m.offlineSince, m.liveFetchedAt)
+	}
+	if view := m.View(); !strings.Contains(view, "OFFLINE") {
+		t.Errorf("view should show the offline indicator, got:\n%s", view)
+	}
+}
+
+func TestCommentarySkipsLinesAlreadyShown(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.matchDetails = &api.MatchDetails{Match: api.Match{ID: 1}}
+	m.liveUpdates = []string{"event update"}
+
+	updated, cmd := m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m = updated.(model)
+	if !m.fullCommentary || cmd == nil {
+		t.Fatalf("fullCommentary = %v, cmd = %v after c", m.fullCommentary, cmd)
+	}
+	// Fetch directly; running cmd would also wait out the banner
+	first := fetchCommentary(m.fotmobClient, 1, false)().(commentaryMsg)
+	if len(first.entries) == 0 {
+		t.Fatal("no mock commentary for match 1")
+	}
+	updated, _ = m.Update(first)
+	m = updated.(model)
+
+	// A later poll repeats the feed with one new line on top
+	next := first
+	next.entries = append([]api.CommentaryEntry{{ID: "new", Minute: 75, Text: "Shot over the bar"}}, first.entries...)
+	updated, _ = m.Update(next)
+	m = updated.(model)
+
+	if got, want := len(m.commentary), len(first.entries)+1; got != want {
+		t.Fatalf("len(commentary) = %d, want %d", got, want)
+	}
+	updates := m.displayedLiveUpdates()
+	if !strings.Contains(updates[0], "75' Shot over the bar") {
+		t.Errorf("newest update = %q, want the new commentary line", updates[0])
+	}
+
+	// Back to events only
+	updated, _ = m.handleLiveMatchesSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m = updated.(model)
+	if got := m.displayedLiveUpdates(); !slices.Equal(got, m.liveUpdates) {
+		t.Errorf("displayedLiveUpdates() = %q in events mode, want the event updates", got)
+	}
+}
+
+func TestHelpKeyOpensDialogUnlessFiltering(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+
+	question := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}
+	updated, _ := m.handleKeyPress(question)
+	m = updated.(model)
+	if !m.dialogOverlay.ContainsDialog("help") {
+		t.Fatal("? should open the help dialog")
+	}
+	updated, _ = m.handleKeyPress(question)
+	m = updated.(model)
+	if m.dialogOverlay.HasDialogs() {
+		t.Fatal("? should close the help dialog again")
+	}
+
+	// While typing a filter, ? is part of the query
+	m.statsMatchesList.SetFilterState(list.Filtering)
+	updated, _ = m.handleKeyPress(question)
+	m = updated.(model)
+	if m.dialogOverlay.HasDialogs() {
+		t.Error("? should not open the help dialog while filtering")
+	}
+}
+
+func TestMainMenuReachesLastItem(t *testing.T) {
+	m := newTestModel(t)
+
+	for range ui.MainMenuItemCount() + 1 {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+		m = updated.(model)
+	}
+	if last := ui.MainMenuItemCount() - 1; m.selected != last {
+		t.Fatalf("selected = %d after moving past the end, want the last item %d", m.selected, last)
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(model)
+	if m.currentView != viewSettings {
+		t.Errorf("enter on the last item opened view %v, want settings", m.currentView)
+	}
+}
+
+func TestRemappedKeys(t *testing.T) {
+	m := newTestModel(t)
+	keys := data.DefaultKeyMap()
+	keys.Down = []string{"down"}
+	keys.Up = []string{"up"}
+	keys.Quit = []string{"ctrl+q"}
+	m.keys = newKeyMap(keys)
+
+	updated, _ := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m = updated.(model)
+	if m.selected != 0 {
+		t.Errorf("j moved the selection after being unbound")
+	}
+	updated, _ = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(model)
+	if m.selected != 1 {
+		t.Errorf("selected = %d after down, want 1", m.selected)
+	}
+
+	if _, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}); cmd != nil {
+		t.Errorf("q should no longer quit")
+	}
+}
+
+func TestRemappedDateKeysSwitchRegions(t *testing.T) {
+	m := newTestModel(t)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	keys := data.DefaultKeyMap()
+	keys.DateForward = []string{"n"}
+	keys.DateBack = []string{"b"}
+	m.keys = newKeyMap(keys)
+	m.currentView = viewSettings
+	m.settingsState = ui.NewSettingsState()
+
+	press := func(r rune) {
+		t.Helper()
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(model)
+	}
+
+	press('l')
+	if m.settingsState.CurrentRegion != 0 {
+		t.Errorf("l switched to region %d after being unbound", m.settingsState.CurrentRegion)
+	}
+	press('n')
+	if m.settingsState.CurrentRegion != 1 {
+		t.Errorf("region = %d after n, want 1", m.settingsState.CurrentRegion)
+	}
+	press('b')
+	if m.settingsState.CurrentRegion != 0 {
+		t.Errorf("region = %d after b, want 0", m.settingsState.CurrentRegion)
+	}
+}
+
+func TestMatchPageBanner(t *testing.T) {
+	m := newTestModel(t)
+
+	updated, _ := m.Update(matchPageMsg{})
+	if got := updated.(model).transientBanner; got != constants.StatusBannerOpenedPage {
+		t.Errorf("banner after opening = %v, want StatusBannerOpenedPage", got)
+	}
+
+	updated, _ = m.Update(matchPageMsg{err: errors.New("xdg-open: not found")})
+	if got := updated.(model).transientBanner; got != constants.StatusBannerNoBrowser {
+		t.Errorf("banner after a failed open = %v, want StatusBannerNoBrowser", got)
+	}
+}
+
+func TestClipboardBanner(t *testing.T) {
+	m := newTestModel(t)
+
+	tests := []struct {
+		err  error
+		want constants.StatusBannerType
+	}{
+		{nil, constants.StatusBannerCopied},
+		{clipboard.ErrUnavailable, constants.StatusBannerNoClipboard},
+		{errors.New("xclip: exit status 1"), constants.StatusBannerCopyFailed},
+	}
+	for _, tt := range tests {
+		updated, _ := m.Update(clipboardMsg{err: tt.err})
+		if got := updated.(model).transientBanner; got != tt.want {
+			t.Errorf("banner after copying with error %v = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestShiftStatsDay(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	today := time.Now().Format("2006-01-02")
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+
+	// ] on today stays on today
+	updated, cmd := m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	m = updated.(model)
+	if !m.statsAnchor.IsZero() || cmd != nil {
+		t.Fatalf("statsAnchor = %v after ] on today, want today without a fetch", m.statsAnchor)
+	}
+
+	// [ steps back one day and re-fetches the window ending on it
+	updated, cmd = m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("[")})
+	m = updated.(model)
+	if got := m.statsAnchor.Format("2006-01-02"); got != yesterday {
+		t.Fatalf("statsAnchor = %s after [, want %s", got, yesterday)
+	}
+	if cmd == nil || !m.statsViewLoading {
+		t.Error("[ did not re-fetch the stats window")
+	}
+	m.statsDaysLoaded = m.statsTotalDays // Window loaded
+
+	// ] from yesterday is back to today
+	updated, _ = m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")})
+	m = updated.(model)
+	if !m.statsAnchor.IsZero() {
+		t.Errorf("statsAnchor = %v after ] from yesterday, want today (%s)", m.statsAnchor, today)
+	}
+}
+
+func TestStatsDetailsPaging(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	m.height = 30
+	details, _ := data.MockFinishedMatchDetails(data.MockFinishedMatches()[0].ID)
+	m.matchDetails = details
+	m.statsDetailsViewport.Height = 20
+	m.statsRightPanelFocused = true
+
+	contentLines, pageLines := ui.StatsDetailsScrollSize(m.width, m.height, ui.StatsViewConfig{Details: details})
+	last := contentLines - pageLines
+	if last < 2 {
+		t.Fatalf("details fit on %d lines of %d, too short to page through", pageLines, contentLines)
+	}
+
+	press := func(keys ...string) {
+		t.Helper()
+		for _, k := range keys {
+			msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+			switch k {
+			case "ctrl+d":
+				msg = tea.KeyMsg{Type: tea.KeyCtrlD}
+			case "pgup":
+				msg = tea.KeyMsg{Type: tea.KeyPgUp}
+			}
+			updated, _ := m.Update(msg)
+			m = updated.(model)
+		}
+	}
+
+	press("G")
+	if m.statsScrollOffset != last {
+		t.Errorf("offset after G = %d, want the last page at %d", m.statsScrollOffset, last)
+	}
+	press("j", "ctrl+d")
+	if m.statsScrollOffset != last {
+		t.Errorf("offset scrolled past the end to %d, want %d", m.statsScrollOffset, last)
+	}
+	if m.debugViewOpen {
+		t.Fatal("ctrl+d opened the debug log instead of paging the details")
+	}
+	press("pgup", "pgup", "pgup", "pgup")
+	if m.statsScrollOffset != 0 {
+		t.Errorf("offset after paging up = %d, want 0", m.statsScrollOffset)
+	}
+	press("ctrl+d")
+	if want := min(pageLines/2, last); m.statsScrollOffset != want {
+		t.Errorf("offset after ctrl+d = %d, want half a page (%d)", m.statsScrollOffset, want)
+	}
+	press("g")
+	if m.statsScrollOffset != 0 {
+		t.Errorf("offset after g = %d, want 0", m.statsScrollOffset)
+	}
+
+	// Unfocused, g is the stats view's jump to the last match day and leaves the details alone
+	press("G")
+	m.statsRightPanelFocused = false
+	press("g")
+	if m.statsScrollOffset != last {
+		t.Errorf("unfocused g moved the details to %d", m.statsScrollOffset)
+	}
+}
+
+func TestPollMarksHalfTime(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.polling = true
+	live := data.MockLiveMatches()[0]
+	details, err := data.MockMatchDetails(live.ID)
+	if err != nil || details == nil {
+		t.Fatalf("MockMatchDetails(%d) = %v, %v", live.ID, details, err)
+	}
+
+	firstHalf := *details
+	firstHalf.Status = api.MatchStatusLive
+	lastMinute, halfTimeBreak := "45+1'", "HT"
+	firstHalf.LiveTime = &lastMinute
+	firstHalf.Events = nil
+	updated, _ := m.Update(matchDetailsMsg{details: &firstHalf})
+	m = updated.(model)
+	if slices.Contains(m.liveUpdates, "— Half Time —") {
+		t.Fatalf("liveUpdates = %q, want no half-time marker before the break", m.liveUpdates)
+	}
+
+	halfTime := firstHalf
+	halfTime.LiveTime = &halfTimeBreak
+	updated, _ = m.Update(matchDetailsMsg{details: &halfTime})
+	m = updated.(model)
+	if len(m.liveUpdates) == 0 || m.liveUpdates[0] != "— Half Time —" {
+		t.Errorf("liveUpdates = %q, want the half-time marker first", m.liveUpdates)
+	}
+
+	// Polling again during the break doesn't repeat it
+	updated, _ = m.Update(matchDetailsMsg{details: &halfTime})
+	m = updated.(model)
+	count := 0
+	for _, update := range m.liveUpdates {
+		if update == "— Half Time —" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("liveUpdates = %q, want one half-time marker", m.liveUpdates)
+	}
+}
+
+func TestPollDropsRemovedEvents(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.polling = true
+	live := data.MockLiveMatches()[0]
+	details, err := data.MockMatchDetails(live.ID)
+	if err != nil || details == nil || len(details.Events) < 2 {
+		t.Fatalf("MockMatchDetails(%d) = %v, %v, want two or more events", live.ID, details, err)
+	}
+
+	first := *details
+	first.Status = api.MatchStatusLive
+	updated, _ := m.Update(matchDetailsMsg{details: &first})
+	m = updated.(model)
+	shown := len(m.liveUpdates)
+
+	// The poll after a goal is cancelled no longer has that event
+	cancelled := first
+	cancelled.Events = first.Events[1:]
+	updated, _ = m.Update(matchDetailsMsg{details: &cancelled})
+	m = updated.(model)
+	want := m.parser.ParseEvents(cancelled.Events, cancelled.HomeTeam, cancelled.AwayTeam)
+	for _, update := range want {
+		if !slices.Contains(m.liveUpdates, update) {
+			t.Errorf("liveUpdates = %q, missing %q", m.liveUpdates, update)
+		}
+	}
+	if len(m.liveUpdates) >= shown {
+		t.Errorf("liveUpdates kept %d lines after an event was removed, had %d", len(m.liveUpdates), shown)
+	}
+}
+
+func TestTeamStatsDialog(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	m.useMockData = true
+	details, _ := data.MockFinishedMatchDetails(1001) // Man City - Arsenal
+	m.matchDetails = details
+	m.statsRightPanelFocused = true
+
+	updated, cmd := m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	m = updated.(model)
+	var fetched bool
+	for _, msg := range runCmd(cmd) {
+		if stats, ok := msg.(teamStatsMsg); ok {
+			fetched = true
+			updated, _ = m.Update(stats)
+			m = updated.(model)
+		}
+	}
+	if !fetched {
+		t.Fatal("H didn't fetch the home team's season")
+	}
+	stats := m.teamStatsCache[details.HomeTeam.ID]
+	if stats == nil || stats.Record == nil || stats.Record.Position != 2 {
+		t.Fatalf("cached home team stats = %+v, want Man City's record", stats)
+	}
+	if !m.dialogOverlay.HasDialogs() {
+		t.Fatal("team stats dialog not opened")
+	}
+	if view := m.dialogOverlay.View(m.width, m.height); !strings.Contains(view, "2nd") || !strings.Contains(view, "36 scored") {
+		t.Errorf("team stats dialog = %q, want the position and goals", view)
+	}
+
+	// The cached season opens again without a fetch
+	m.dialogOverlay = ui.NewDialogOverlay()
+	updated, cmd = m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	m = updated.(model)
+	if cmd != nil || !m.dialogOverlay.HasDialogs() {
+		t.Errorf("reopening a cached season: cmd = %v, dialog open = %v; want no fetch and the dialog", cmd != nil, m.dialogOverlay.HasDialogs())
+	}
+
+	// A failed fetch says so instead of leaving the key without effect
+	updated, _ = m.Update(teamStatsMsg{team: details.AwayTeam, err: errors.New("status 500")})
+	if got := updated.(model).transientBanner; got != constants.StatusBannerNoTeamStats {
+		t.Errorf("banner after a failed team stats fetch = %v, want StatusBannerNoTeamStats", got)
+	}
+
+	// A team without a league table shows the no season data state
+	dialog := ui.NewTeamStatsDialog("Brazil", data.MockTeamStats(6))
+	if view := dialog.View(m.width, m.height); !strings.Contains(view, "No season data") {
+		t.Errorf("team stats dialog without a record = %q, want the no season data state", view)
+	}
+}
+
+func TestSpoilerModeKeys(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	details, _ := data.MockFinishedMatchDetails(1001)
+	m.matchDetails = details
+
+	press := func(key string) {
+		t.Helper()
+		updated, _ := m.handleStatsSelection(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(model)
+	}
+
+	press("v") // Nothing to reveal with spoiler mode off
+	if len(m.revealedMatches) != 0 {
+		t.Errorf("revealed %v with spoiler mode off", m.revealedMatches)
+	}
+
+	press("z")
+	if !m.spoilerMode || !m.detailsScoreHidden() {
+		t.Fatal("z didn't hide the score")
+	}
+	press("v")
+	if !m.revealedMatches[details.ID] || m.detailsScoreHidden() {
+		t.Error("v didn't reveal the shown match")
+	}
+}
+
+func TestSpoilerModeMasksLiveList(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	for _, match := range data.MockLiveMatches() {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.setLiveListWindow(len(m.matches))
+
+	description := func() string {
+		t.Helper()
+		item, ok := m.liveMatchesList.SelectedItem().(ui.MatchListItem)
+		if !ok {
+			t.Fatal("no match selected in the live list")
+		}
+		return item.Description()
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	m = updated.(model)
+	if got := description(); !strings.HasPrefix(got, ui.HiddenScore) {
+		t.Errorf("description with spoiler mode on = %q, want the score masked", got)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	m = updated.(model)
+	if got := description(); strings.HasPrefix(got, ui.HiddenScore) {
+		t.Errorf("description with spoiler mode off = %q, want the score shown", got)
+	}
+}
+
+func TestAwaitingFirstMatchDetails(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	for _, match := range data.MockLiveMatches() {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.setLiveListWindow(len(m.matches))
+	first := m.matches[0].ID
+
+	updated, cmd := m.loadMatchDetails(first)
+	m = updated.(model)
+	if !m.awaitingDetails(m.liveMatchesList) {
+		t.Fatal("details panel should show the first match loading")
+	}
+	if view := m.View(); !strings.Contains(view, constants.LoadingDetails) || strings.Contains(view, constants.EmptySelectMatch) {
+		t.Errorf("view should say the details are loading, not ask for a selection:\n%s", view)
+	}
+
+	for _, msg := range runCmd(cmd) {
+		if msg, ok := msg.(matchDetailsMsg); ok {
+			updated, _ = m.handleMatchDetails(msg)
+			m = updated.(model)
+		}
+	}
+	if m.loadingMatchID != 0 || m.awaitingDetails(m.liveMatchesList) {
+		t.Errorf("loadingMatchID = %d after the details arrived, want 0", m.loadingMatchID)
+	}
+}
+
+// emptyFetcher finds nothing on Reddit, so every goal resolves to a not found link.
+type emptyFetcher struct{}
+
+func (emptyFetcher) Search(string, int, time.Time, string) ([]reddit.SearchResult, error) {
+	return nil, nil
+}
+
+func TestGoalLinksOnlyChangeOnUpdateLoop(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	details, _ := data.MockFinishedMatchDetails(1001)
+	m.matchDetails = details
+	m.redditClient = reddit.NewClientWithFetcher(emptyFetcher{}, reddit.NewGoalLinkCacheAt(t.TempDir()))
+
+	// Run the prefetch on its own goroutine, as Bubble Tea runs commands, while this one
+	// renders and handles its messages; go test -race flags any write it makes to the model
+	client := m.redditClient
+	msgs := make(chan goalLinksMsg)
+	go func() {
+		defer close(msgs)
+		for cmd := fetchGoalLinks(context.Background(), client, details); cmd != nil; {
+			msg := cmd().(goalLinksMsg)
+			msgs <- msg
+			cmd = msg.next
+		}
+	}()
+	for msg := range msgs {
+		_ = m.buildGoalLinksMap()
+		_ = m.View()
+		updated, _ := m.handleGoalLinks(msg)
+		m = updated.(model)
+	}
+
+	goals := 0
+	for _, event := range details.Events {
+		if event.Type == "goal" {
+			goals++
+		}
+	}
+	if len(m.goalLinks) != goals {
+		t.Errorf("goalLinks has %d entries after the prefetch, want one per goal (%d)", len(m.goalLinks), goals)
+	}
+}
+
+func TestRefreshReplaysReportsNewLinks(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewStats
+	details, _ := data.MockFinishedMatchDetails(1001)
+	m.matchDetails = details
+	cache := reddit.NewGoalLinkCacheAt(t.TempDir())
+	m.redditClient = reddit.NewClientWithFetcher(emptyFetcher{}, cache)
+
+	var goals []reddit.GoalLinkKey
+	for _, event := range details.Events {
+		if event.Type == "goal" {
+			goals = append(goals, reddit.GoalLinkKey{MatchID: details.ID, Minute: event.Minute})
+		}
+	}
+	if len(goals) < 2 {
+		t.Fatalf("mock match %d has %d goals, want at least 2", details.ID, len(goals))
+	}
+
+	// Both goals were searched without luck; since then the cache learned a link for the first
+	m.goalLinks = make(map[reddit.GoalLinkKey]*reddit.GoalLink)
+	for _, key := range goals {
+		m.goalLinks[key] = &reddit.GoalLink{MatchID: key.MatchID, Minute: key.Minute, URL: reddit.NotFoundMarker}
+		if err := cache.SetNotFound(key.MatchID, key.Minute); err != nil {
+			t.Fatalf("SetNotFound: %v", err)
+		}
+	}
+	found := reddit.GoalLink{MatchID: goals[0].MatchID, Minute: goals[0].Minute, URL: "https://streamin.one/v/abc123", FetchedAt: time.Now()}
+	if err := cache.Set(found); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m = updated.(model)
+	if !m.replaysSearching || m.getStatusBannerType() != constants.StatusBannerSearchingReplays {
+		t.Fatalf("P should start a replay search, searching = %v, banner = %v", m.replaysSearching, m.getStatusBannerType())
+	}
+
+	var next tea.Cmd
+	for _, msg := range runCmd(cmd) {
+		if msg, ok := msg.(goalLinksMsg); ok {
+			updated, _ = m.handleGoalLinks(msg)
+			m = updated.(model)
+			next = msg.next
+		}
+	}
+	for next != nil {
+		msg := next().(goalLinksMsg)
+		updated, _ = m.handleGoalLinks(msg)
+		m = updated.(model)
+		next = msg.next
+	}
+
+	if m.replaysSearching {
+		t.Error("the search should be over once the prefetch is done")
+	}
+	if m.replaysFound != 1 || m.transientBanner != constants.StatusBannerReplaysFound {
+		t.Errorf("replaysFound = %d, banner = %v; want 1 new link reported", m.replaysFound, m.transientBanner)
+	}
+	if view := m.View(); !strings.Contains(view, "Found 1 new replay link") {
+		t.Errorf("view should report the new link, got:\n%s", view)
+	}
+	if !isReplayLink(m.goalLinks[goals[0]]) {
+		t.Errorf("goal %v link = %+v, want the cached replay", goals[0], m.goalLinks[goals[0]])
+	}
+	if !reddit.IsNotFound(m.goalLinks[goals[1]]) {
+		t.Errorf("goal %v link = %+v, want searched again and not found", goals[1], m.goalLinks[goals[1]])
+	}
+}
+
+func TestAdjustListPanelPersists(t *testing.T) {
+	m := newTestModel(t)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	m.currentView = viewLiveMatches
+
+	press := func(key string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(model)
+	}
+
+	m.ensureLiveListSize()
+	listWidth := m.liveMatchesList.Width()
+	press(">")
+	if m.listPanelPercent != data.DefaultListPanelPercent+data.ListPanelPercentStep {
+		t.Fatalf("listPanelPercent = %d after >, want one step wider", m.listPanelPercent)
+	}
+	if want := listWidth + m.width*data.ListPanelPercentStep/100; m.liveMatchesList.Width() != want {
+		t.Errorf("match list width = %d after >, want %d", m.liveMatchesList.Width(), want)
+	}
+	settings, _ := data.LoadSettings()
+	if settings.ListPanelPercent != m.listPanelPercent {
+		t.Errorf("saved list_panel_percent = %d, want %d", settings.ListPanelPercent, m.listPanelPercent)
+	}
+
+	for range 10 {
+		press(">")
+	}
+	if m.listPanelPercent != data.MaxListPanelPercent {
+		t.Errorf("listPanelPercent = %d after widening past the limit, want %d", m.listPanelPercent, data.MaxListPanelPercent)
+	}
+	for range 10 {
+		press("<")
+	}
+	if m.listPanelPercent != data.MinListPanelPercent {
+		t.Errorf("listPanelPercent = %d after narrowing past the limit, want %d", m.listPanelPercent, data.MinListPanelPercent)
+	}
+}
+
+func TestResultsTickerToggle(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.width = 60
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	m = updated.(model)
+	for _, msg := range runCmd(cmd) {
+		if msg, ok := msg.(tickerResultsMsg); ok {
+			updated, _ = m.Update(msg)
+			m = updated.(model)
+		}
+	}
+	if !m.tickerShown() || len(m.tickerResults) == 0 {
+		t.Fatalf("T should show the ticker with today's results, got %d results", len(m.tickerResults))
+	}
+	if m.liveViewHeight() != m.height-1 {
+		t.Errorf("liveViewHeight() = %d with the ticker, want one row less than %d", m.liveViewHeight(), m.height)
+	}
+	if view := m.View(); !strings.Contains(view, constants.ResultsTickerLabel) {
+		t.Errorf("live view should end with the results ticker:\n%s", view)
+	}
+
+	// The mock results are wider than the screen, so each tick scrolls them
+	if !m.tickerScrolling() {
+		t.Fatal("ticker should scroll in 60 columns")
+	}
+	updated, _ = m.Update(ui.TickMsg{})
+	m = updated.(model)
+	if m.tickerOffset != 1 {
+		t.Errorf("tickerOffset = %d after a tick, want 1", m.tickerOffset)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	m = updated.(model)
+	if m.tickerShown() || m.liveViewHeight() != m.height {
+		t.Errorf("hidden ticker should leave the whole height to the panels, got %d of %d", m.liveViewHeight(), m.height)
+	}
+}
+
+func TestFocusedMatchKeepsSelection(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.width, m.height = 120, 40
+	for _, match := range data.MockLiveMatches() {
+		m.matches = append(m.matches, ui.MatchDisplay{Match: match})
+	}
+	m.setLiveListWindow(len(m.matches))
+	m.liveMatchesList.Select(1)
+	m.matchDetails = &api.MatchDetails{Match: m.matches[1].Match}
+
+	press := func(msg tea.KeyMsg) {
+		t.Helper()
+		updated, _ := m.handleKeyPress(msg)
+		m = updated.(model)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.focusedMatch {
+		t.Fatal("Enter on the shown match should follow it full-screen")
+	}
+	if view := m.View(); !strings.Contains(view, constants.HelpFocusedMatch) || strings.Contains(view, constants.PanelLiveMatches) {
+		t.Errorf("focused view should show the match without the list:\n%s", view)
+	}
+
+	// Moving down scrolls the match instead of changing the selection
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	if got := m.liveMatchesList.Index(); got != 1 {
+		t.Errorf("selection moved to %d while focused, want 1", got)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.focusedMatch || m.currentView != viewLiveMatches {
+		t.Fatalf("Esc should return to the split view, focused=%v view=%v", m.focusedMatch, m.currentView)
+	}
+	if got := m.liveMatchesList.Index(); got != 1 || m.matchDetails == nil || m.matchDetails.ID != m.matches[1].ID {
+		t.Errorf("selection after Esc = %d, want 1 with its details still shown", got)
+	}
+}
+
+func TestFocusedMatchHalfPageDown(t *testing.T) {
+	m := newTestModel(t)
+	m.currentView = viewLiveMatches
+	m.width, m.height = 120, 20
+	details, _ := data.MockFinishedMatchDetails(data.MockFinishedMatches()[0].ID)
+	m.matchDetails = details
+	m.focusedMatch = true
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	m = updated.(model)
+	if m.debugViewOpen {
+		t.Fatal("ctrl+d opened the debug log instead of scrolling the match")
+	}
+	if m.focusedScroll == 0 {
+		t.Error("ctrl+d should scroll the focused match half a page down")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	m = updated.(model)
+	if !m.debugViewOpen {
+		t.Error("ctrl+l should open the debug log")
+	}
+}
@@ -0,0 +1,263 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// keyMap holds the remappable key bindings, configured in keys.json (see data.KeyMap).
+type keyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	Select      key.Binding
+	Back        key.Binding
+	DateForward key.Binding
+	DateBack    key.Binding
+	ToggleFocus key.Binding
+	Quit        key.Binding
+}
+
+// newKeyMap builds the key bindings from their configured keys.
+func newKeyMap(keys data.KeyMap) keyMap {
+	return keyMap{
+		Up:          binding(keyLabel(keys.Up), "up", keys.Up...),
+		Down:        binding(keyLabel(keys.Down), "down", keys.Down...),
+		Select:      binding(keyLabel(keys.Select), "select", keys.Select...),
+		Back:        binding(keyLabel(keys.Back), "back", keys.Back...),
+		DateForward: binding(keyLabel(keys.DateForward), "next date range", keys.DateForward...),
+		DateBack:    binding(keyLabel(keys.DateBack), "previous date range", keys.DateBack...),
+		ToggleFocus: binding(keyLabel(keys.ToggleFocus), "focus", keys.ToggleFocus...),
+		Quit:        binding(keyLabel(keys.Quit), "quit", keys.Quit...),
+	}
+}
+
+// loadKeyMap applies the key bindings from keys.json, keeping the defaults if it is invalid.
+func (m *model) loadKeyMap() {
+	keys, err := data.LoadKeyMap()
+	if err != nil {
+		m.warnLog(fmt.Sprintf("Key bindings: %v", err))
+	}
+	m.keys = newKeyMap(keys)
+	for _, l := range []*list.Model{&m.liveMatchesList, &m.statsMatchesList, &m.statsEventsList, &m.upcomingMatchesList} {
+		m.keys.applyToList(l)
+	}
+	toggleFocus := m.keys.ToggleFocus
+	m.statsMatchesList.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{toggleFocus}
+	}
+}
+
+// applyToList makes l move its cursor with the configured up/down keys.
+// Quitting is left to the app, so a remapped quit key isn't shadowed by the list's own.
+func (k keyMap) applyToList(l *list.Model) {
+	l.KeyMap.CursorUp.SetKeys(k.Up.Keys()...)
+	l.KeyMap.CursorDown.SetKeys(k.Down.Keys()...)
+	l.DisableQuitKeybindings()
+}
+
+// navigate is the up and down keys as one help entry.
+func (k keyMap) navigate(desc string) key.Binding {
+	keys := append(slices.Clone(k.Up.Keys()), k.Down.Keys()...)
+	return binding(k.Up.Help().Key+" "+k.Down.Help().Key, desc, keys...)
+}
+
+// keyArrows are the labels of keys shown as symbols in help text.
+var keyArrows = map[string]string{"up": "↑", "down": "↓", "left": "←", "right": "→"}
+
+// keyLabel formats keys for help text, e.g. "k/↑".
+func keyLabel(keys []string) string {
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		if arrow, ok := keyArrows[k]; ok {
+			k = arrow
+		}
+		labels[i] = k
+	}
+	return strings.Join(labels, "/")
+}
+
+// binding is shorthand for a key binding with its help entry.
+func binding(help, desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(help, desc))
+}
+
+// helpSections lists the key bindings of view v, grouped as shown in the help dialog (?),
+// followed by those that work everywhere.
+func (k keyMap) helpSections(v view) []ui.HelpSection {
+	var sections []ui.HelpSection
+	switch v {
+	case viewMain:
+		sections = []ui.HelpSection{{
+			Title: "Main menu",
+			Bindings: []key.Binding{
+				k.navigate("navigate"),
+				k.Select,
+			},
+		}}
+	case viewLiveMatches:
+		sections = []ui.HelpSection{{
+			Title: "Live matches",
+			Bindings: []key.Binding{
+				k.navigate("navigate"),
+				withHelp(k.Select, "follow the shown match full-screen"),
+				binding("n/N", "next/previous match in progress", "n", "N"),
+				binding("w", "watch mode: rotate live matches", "w"),
+				binding("c", "events only / full commentary", "c"),
+				binding("u", "collapse/expand updates", "u"),
+				binding("s", "cycle sort order", "s"),
+				binding("F", "favorite teams' matches first", "F"),
+				binding("p", "pin/unpin match to the top", "p"),
+				binding("z", "spoiler mode: hide scores", "z"),
+				binding("v", "reveal match score", "v"),
+				binding("r", "refresh details (retry a failed load)", "r"),
+				binding("P", "search again for missing replay links", "P"),
+				binding("</>", "narrow/widen the match list", "<", ">"),
+				binding("T", "show/hide today's results ticker", "T"),
+				binding("y", "copy match summary", "y"),
+				binding("O", "open on FotMob", "O"),
+				binding("/", "filter by team", "/"),
+			},
+		}, {
+			Title: "Followed match",
+			Bindings: []key.Binding{
+				k.navigate("scroll"),
+				withHelp(k.Back, "back to the list"),
+				binding("ctrl+u/d", "half page up/down", "ctrl+u", "ctrl+d"),
+				binding("pgup/pgdn", "page up/down", "pgup", "pgdown"),
+				binding("g/G", "top/bottom", "g", "G"),
+			},
+		}}
+	case viewStats:
+		sections = []ui.HelpSection{
+			{
+				Title: "Match list",
+				Bindings: []key.Binding{
+					k.navigate("navigate"),
+					k.DateBack,
+					k.DateForward,
+					withHelp(k.ToggleFocus, "focus details"),
+					binding("r", "refresh details (retry a failed load)", "r"),
+					binding("L", "live matches only", "L"),
+					binding("R", "refresh all", "R"),
+					binding("t", "timeline", "t"),
+					binding("p", "top scorers", "p"),
+					binding("s", "cycle sort order", "s"),
+					binding("F", "favorite teams' matches first", "F"),
+					binding("z", "spoiler mode: hide scores", "z"),
+					binding("v", "reveal match score", "v"),
+					binding("g", "jump to last match day / back to today", "g"),
+					binding("[/]", "previous/next day", "[", "]"),
+					binding("P", "search again for missing replay links", "P"),
+					binding("</>", "narrow/widen the match list", "<", ">"),
+					binding("y", "copy match summary", "y"),
+					binding("O", "open on FotMob", "O"),
+					binding("/", "filter by team", "/"),
+				},
+			},
+			{
+				Title: "Details (focused)",
+				Bindings: []key.Binding{
+					k.navigate("scroll"),
+					withHelp(k.ToggleFocus, "back to the list"),
+					binding("ctrl+u/d", "half page up/down", "ctrl+u", "ctrl+d"),
+					binding("pgup/pgdn", "page up/down", "pgup", "pgdown"),
+					binding("g/G", "top/bottom", "g", "G"),
+					binding("e", "events list", "e"),
+					binding("1-4", "events: all/goals/cards/subs", "1", "2", "3", "4"),
+					binding("m/M", "events from later / up to earlier minute", "m", "M"),
+					binding("c", "all minutes", "c"),
+					binding("s", "standings", "s"),
+					binding("H/A", "home/away team season", "H", "A"),
+					binding("f", "formations", "f"),
+					binding("x", "all statistics", "x"),
+				},
+			},
+		}
+	case viewSettings:
+		sections = []ui.HelpSection{{
+			Title: "Settings",
+			Bindings: []key.Binding{
+				k.navigate("navigate"),
+				withHelp(k.DateBack, "previous region"),
+				withHelp(k.DateForward, "next region"),
+				binding("space", "toggle league", " "),
+				binding("a", "toggle logo animation", "a"),
+				binding("/", "filter leagues", "/"),
+				withHelp(k.Select, "save"),
+			},
+		}}
+	case viewStandings:
+		sections = []ui.HelpSection{
+			{
+				Title: "League picker",
+				Bindings: []key.Binding{
+					k.navigate("navigate"),
+					withHelp(k.Select, "show table"),
+					binding("/", "filter leagues", "/"),
+				},
+			},
+			{
+				Title: "League table",
+				Bindings: []key.Binding{
+					k.navigate("scroll"),
+					withHelp(k.DateBack, "previous league"),
+					withHelp(k.DateForward, "next league"),
+					withHelp(k.Back, "back to the leagues"),
+				},
+			},
+		}
+	case viewScorers:
+		sections = []ui.HelpSection{{
+			Title: "Top scorers",
+			Bindings: []key.Binding{
+				k.navigate("scroll"),
+			},
+		}}
+	}
+
+	return append(sections, ui.HelpSection{
+		Title: "Everywhere",
+		Bindings: []key.Binding{
+			binding("?", "show this help", "?"),
+			k.Back,
+			binding("ctrl+l", "debug log", "ctrl+l"),
+			k.Quit,
+		},
+	})
+}
+
+// withHelp returns b with a different help description.
+func withHelp(b key.Binding, desc string) key.Binding {
+	b.SetHelp(b.Help().Key, desc)
+	return b
+}
+
+// openHelpDialog shows the key bindings of the current view, then the global ones.
+func (m *model) openHelpDialog() {
+	if m.dialogOverlay == nil {
+		return
+	}
+	m.dialogOverlay.OpenDialog(ui.NewHelpDialog(m.keys.helpSections(m.currentView)))
+}
+
+// typingFilter reports whether the current view's list is taking filter input,
+// in which case printable keys belong to the filter.
+func (m model) typingFilter() bool {
+	switch m.currentView {
+	case viewLiveMatches:
+		return m.liveMatchesList.FilterState() == list.Filtering
+	case viewStats:
+		return m.statsMatchesList.FilterState() == list.Filtering
+	case viewSettings:
+		return m.settingsState != nil && m.settingsState.List.FilterState() == list.Filtering
+	case viewStandings:
+		return m.standingsState != nil && m.standingsState.List.FilterState() == list.Filtering
+	}
+	return false
+}
@@ -1,9 +1,15 @@
 package app
 
 import (
+	"time"
+
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // liveUpdateMsg contains a live update string for match events.
@@ -14,6 +20,7 @@ type liveUpdateMsg struct {
 // matchDetailsMsg contains match details from API response.
 type matchDetailsMsg struct {
 	details *api.MatchDetails
+	err     error // Set alongside details decoded only in part (api.ErrPartialDetails)
 }
 
 // liveMatchesMsg contains live matches from API response.
@@ -24,30 +31,77 @@ type liveMatchesMsg struct {
 // liveRefreshMsg is sent when live matches are refreshed (periodic 5-min timer).
 type liveRefreshMsg struct {
 	matches []api.Match
+	err     error // Set when the fetch failed, as opposed to nothing being live
 }
 
 // liveBatchDataMsg contains live matches for a batch of leagues (parallel loading).
 // Sent when a batch of leagues completes, allowing progressive UI updates.
 type liveBatchDataMsg struct {
+	gen        int         // Fetch generation that requested this batch
 	batchIndex int         // Which batch (0, 1, 2, ...)
 	isLast     bool        // true if this is the last batch
 	matches    []api.Match // live matches from all leagues in this batch
+	err        error       // set when every league in the batch failed
 }
 
-// statsDataMsg contains all stats data (5 days finished + today upcoming) from API response.
-// This is the unified message for stats view - always fetches 5 days, filters client-side.
+// tickerResultsMsg contains today's finished matches for the live view's results ticker.
+type tickerResultsMsg struct {
+	matches []api.Match
+	err     error
+}
+
+// todaySummaryMsg contains today's match counts for the main menu glance line.
+type todaySummaryMsg struct {
+	summary ui.TodaySummary
+	err     error
+}
+
+// statsDataMsg contains all stats data (the window's finished + today upcoming) from API response.
+// This is the unified message for stats view - fetches the whole window, filters client-side.
 type statsDataMsg struct {
 	data *fotmob.StatsData
 }
 
+// snapshotMsg carries the last known good data of a view, loaded after its fetch failed.
+type snapshotMsg struct {
+	gen      int            // Fetch generation whose failure asked for the snapshot
+	kind     string         // data.SnapshotLive or data.SnapshotStats
+	snapshot *data.Snapshot // nil if none was saved (or it could not be read)
+	err      error
+}
+
+// commentaryMsg contains the text commentary of a live match, newest first.
+type commentaryMsg struct {
+	matchID int
+	entries []api.CommentaryEntry
+	err     error
+}
+
 // statsDayDataMsg contains stats data for a single day (progressive loading).
 // Sent as each day's API calls complete, allowing immediate UI updates.
 type statsDayDataMsg struct {
+	gen      int         // Fetch generation that requested this day
 	dayIndex int         // 0 = today, 1 = yesterday, etc.
 	isToday  bool        // true if this is today's data
 	isLast   bool        // true if this is the last day to fetch
 	finished []api.Match // finished matches for this day
 	upcoming []api.Match // upcoming matches (only for today)
+	live     []api.Match // matches in progress (only for today)
+	err      error       // set when the day could not be fetched
+}
+
+// playerPhotosMsg reports that scorer photos of a match were downloaded.
+// Receiving it re-renders the view so the thumbnails show up.
+type playerPhotosMsg struct {
+	matchID int
+}
+
+// nearestMatchDayMsg carries the result of probing for the nearest earlier day with matches,
+// made when the stats view's whole window turned out empty.
+type nearestMatchDayMsg struct {
+	gen int       // Fetch generation that started the probe
+	day time.Time // Nearest day with finished matches; zero with err set if none
+	err error
 }
 
 // pollTickMsg is sent when the 90-second poll interval elapses.
@@ -56,15 +110,44 @@ type pollTickMsg struct {
 	matchID int
 }
 
+// liveClockTickMsg re-renders the live clock between polls.
+// since identifies the fetch that started the tick chain; a newer fetch ends it.
+type liveClockTickMsg struct {
+	since time.Time
+}
+
+// countdownTickMsg re-renders the view so kickoff countdowns, computed while rendering,
+// keep counting down. A single chain runs for the whole session.
+type countdownTickMsg struct{}
+
+// watchTickMsg advances watch mode to the next live match.
+// gen identifies the rotation that scheduled it; stopping or restarting watch mode ends it.
+type watchTickMsg struct {
+	gen int
+}
+
 // pollDisplayCompleteMsg is sent after minimum display time (1 second) has elapsed.
 // This allows the "Updating..." spinner to be visible for at least 1 second.
 type pollDisplayCompleteMsg struct{}
 
 // goalLinksMsg contains goal replay links fetched from Reddit.
-// Sent after searching r/soccer for Media posts matching goal events.
+// Sent incrementally as the background prefetch resolves each goal.
+// next waits for the following link; nil once the prefetch is done.
+// refresh marks the messages of a manual re-search, see refreshReplays.
 type goalLinksMsg struct {
 	matchID int
 	links   map[reddit.GoalLinkKey]*reddit.GoalLink
+	next    tea.Cmd
+	refresh bool
+}
+
+// replaysLimitExpiredMsg is sent when Reddit's block cooldown ends, to clear its banner.
+type replaysLimitExpiredMsg struct{}
+
+// scorerDetailsMsg contains a chunk of match details fetched for the top scorers view.
+type scorerDetailsMsg struct {
+	gen     int                       // scorersGen that requested this chunk
+	details map[int]*api.MatchDetails // nil for matches that failed to load
 }
 
 // standingsMsg contains league standings from API response.
@@ -76,3 +159,31 @@ type standingsMsg struct {
 	homeTeamID int
 	awayTeamID int
 }
+
+// teamStatsMsg contains a team's season record for the team stats dialog.
+type teamStatsMsg struct {
+	team  api.Team
+	stats *api.TeamSeasonStats
+	err   error
+}
+
+// leagueTableMsg contains a league table for the standalone league table view.
+type leagueTableMsg struct {
+	leagueID  int
+	standings []api.LeagueTableEntry
+}
+
+// clipboardMsg is sent after attempting to copy a match summary to the clipboard.
+type clipboardMsg struct {
+	err error
+}
+
+// matchPageMsg is sent after attempting to open a match page in the browser.
+type matchPageMsg struct {
+	err error
+}
+
+// transientBannerExpiredMsg is sent when a transient status banner should be hidden.
+type transientBannerExpiredMsg struct {
+	banner constants.StatusBannerType
+}
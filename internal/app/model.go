@@ -2,20 +2,21 @@
 package app
 
 import (
+	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/logging"
 	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/0xjuanma/golazo/internal/ui/images"
 	"github.com/0xjuanma/golazo/internal/ui/logo"
-	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -30,6 +31,8 @@ const (
 	viewLiveMatches
 	viewStats
 	viewSettings
+	viewStandings
+	viewScorers
 )
 
 // model holds the application state.
@@ -47,24 +50,73 @@ type model struct {
 	matches             []ui.MatchDisplay
 	upcomingMatches     []ui.MatchDisplay // Upcoming matches for 1-day stats view (deprecated, kept for compatibility)
 	liveUpcomingMatches []ui.MatchDisplay // Upcoming matches for live view (shown at bottom of left panel)
+	matchSort           ui.MatchSort      // Order of the live and stats match lists
+	watchMode           bool              // Live view rotates through live matches
+	updatesCollapsed    bool              // Live updates shrunk to their title (u toggles)
+	focusedMatch        bool              // Live view shows the shown match full-screen, without the list (Enter, Esc leaves)
+	focusedScroll       int               // Lines the full-screen match is scrolled past
+	spoilerMode         bool              // Scores, goals and cards stay hidden until revealed (z toggles)
+	revealedMatches     map[int]bool      // Match IDs revealed with v while in spoiler mode
+	pinnedMatches       map[int]bool      // Live match IDs pinned to the top of the list with p, for the session
+	favoriteTeams       map[int]bool      // Team IDs from favorite_teams; their matches are marked
+	favoritesFirst      bool              // Favorite teams' matches listed before the rest (F toggles)
+	listPanelPercent    int               // Match list's share of the live and stats views' width (< and > adjust)
+	statBarsFillAway    bool              // Percentage stat bars filled with the away share (stat_bars_fill_away)
+	leagueFlags         bool              // Match titles prefixed with the league's flag (league_flags)
+	showTicker          bool              // Results ticker shown at the bottom of the live view (T toggles)
+	tickerResults       []api.Match       // Today's finished matches scrolling through the ticker
+	tickerOffset        int               // Characters the ticker has scrolled by; advances on each spinner tick
+	watchGen            int               // Rotation generation; stale watchTickMsgs are dropped
+	watchInterval       time.Duration     // How long watch mode shows each match
+	playerPhotos        *images.Cache     // Scorer thumbnails; nil unless enabled and supported
 	matchDetails        *api.MatchDetails
-	matchDetailsCache   map[int]*api.MatchDetails // Cache to avoid repeated API calls
-	liveUpdates         []string
-	lastEvents          []api.MatchEvent
-	lastHomeScore       int // Track last known home score for goal notifications
-	lastAwayScore       int // Track last known away score for goal notifications
-
-	// Stats data cache - stores 5 days of data, filtered client-side for Today/3d/5d views
-	statsData *fotmob.StatsData
+	loadingMatchID      int                          // Match whose details are being fetched; 0 when none
+	matchDetailsCache   map[int]*api.MatchDetails    // Cache to avoid repeated API calls
+	teamStatsCache      map[int]*api.TeamSeasonStats // Team season records by team ID, kept for the session
+	liveUpdates         []string                     // Newest first, capped at maxLiveUpdates
+	fullCommentary      bool                         // Live updates show FotMob's text commentary instead of events only (c toggles)
+	commentary          []api.CommentaryEntry        // Commentary of the selected live match, newest first, capped at maxLiveUpdates
+	commentarySeen      map[string]bool              // IDs of commentary entries already shown, so polls don't repeat them
+	maxLiveUpdates      int
+	seenEventIDs        map[int]bool      // IDs of the events already in liveUpdates; polls add only the others
+	matchStage          fotmob.MatchStage // Stage of the match at the last load, to mark half time between polls
+	lastHomeScore       int               // Track last known home score for goal notifications
+	lastAwayScore       int               // Track last known away score for goal notifications
+	liveClockSince      time.Time         // When matchDetails.LiveTime was fetched; the displayed minute advances from here
+
+	// Stats data cache - stores statsDays days of data, filtered client-side for the date ranges
+	statsData     *fotmob.StatsData
+	statsDays     int  // Configured fetch depth (stats_days setting)
+	statsGrouped  bool // Group finished matches under competition headers (group_stats_matches setting)
+	statsLiveOnly bool // List only today's matches in progress (L); kept across visits
+
+	// lastFetchErr is why the last live or stats fetch came back with nothing to show.
+	// The view then shows an error panel offering a retry instead of an empty list.
+	lastFetchErr error
+
+	// offlineSince is when the data on screen was fetched, when a failed fetch fell back
+	// to the last known good snapshot; zero while the data is fresh.
+	offlineSince time.Time
+	// liveFetchedAt is when the live matches on screen were fetched, reported as offlineSince
+	// when a refresh fails.
+	liveFetchedAt time.Time
 
 	// Progressive loading state (stats view)
-	statsDaysLoaded int // Number of days loaded so far (0-5)
-	statsTotalDays  int // Total days to load (5)
+	statsDaysLoaded int // Number of days loaded so far (0-statsTotalDays)
+	statsTotalDays  int // Total days to load (statsDays)
+
+	// Stats window anchoring - an empty window offers a jump to the nearest day with matches
+	statsAnchor       time.Time // Last day of the stats window; zero means today
+	statsProbing      bool      // Looking for the nearest day with matches
+	statsSuggestedDay time.Time // Nearest earlier day with matches; zero if unknown
 
 	// Progressive loading state (live view) - batch-based for parallel fetching
-	liveBatchesLoaded int         // Number of batches loaded so far
-	liveTotalBatches  int         // Total batches to load
-	liveMatchesBuffer []api.Match // Buffer to accumulate live matches during progressive load
+	liveBatchesLoaded int           // Number of batches loaded so far
+	liveTotalBatches  int           // Total batches to load
+	liveBatchSize     int           // Leagues fetched in parallel per batch
+	liveBatchDelay    time.Duration // Pause between batches
+	liveMatchesBuffer []api.Match   // Buffer to accumulate live matches during progressive load
+	liveLoadedCount   int           // Number of matches from m.matches currently pushed into the live list
 
 	// UI components
 	spinner          spinner.Model
@@ -79,6 +131,12 @@ type model struct {
 	statsDetailsViewport   viewport.Model // Scrollable viewport for match details in stats view
 	statsRightPanelFocused bool           // Whether right panel is focused for scrolling
 	statsScrollOffset      int            // Manual scroll offset for right panel content
+	statsScrollMatchID     int            // Match the scroll offset belongs to (kept across focus toggles)
+	statsShowTimeline      bool           // Show events as a single chronological timeline
+	statsEventsList        list.Model     // Filterable list of all match events in stats view
+	statsShowEvents        bool           // Show the events list in the focused right panel
+	statsEventsFilter      ui.EventFilter // Event type filter for the events list
+	statsMinuteRange       ui.MinuteRange // Minutes the event sections and events list are limited to (m/M, c clears)
 
 	// Loading states
 	loading          bool
@@ -94,32 +152,78 @@ type model struct {
 	isDevBuild          bool   // Whether this is a development build
 	newVersionAvailable bool   // Whether a new version of Golazo is available
 	appVersion          string // Current application version string
-	statsDateRange      int    // 1, 3, or 5 days (default: 1)
+	statsDateRange      int    // 1, 3, or statsDays days (default: 1)
+
+	// Today's match counts beneath the main menu, fetched once at startup (nil until loaded)
+	todaySummary        *ui.TodaySummary
+	todaySummaryLoading bool
+
+	// Short-lived banner (e.g. "copied!") shown above the version/debug banners
+	transientBanner constants.StatusBannerType
+
+	// In-app debug log viewer (ctrl+l). Drawn over the current view so currentView-gated
+	// fetch handlers keep running while it is open.
+	debugBuffer   *debugRingBuffer
+	logger        *logging.Logger // nil unless logging is on (--debug or GOLAZO_DEBUG)
+	debugViewOpen bool
+	debugViewport viewport.Model
 
 	// Settings view state
 	settingsState *ui.SettingsState
 
+	// League table view state
+	standingsState *ui.StandingsViewState
+	leagueTables   map[int][]api.LeagueTableEntry // Fetched tables by league ID, kept for the session
+
+	// Top scorers view state (opened from the stats view over its current date range)
+	scorers        []ui.ScorerEntry
+	scorersPending []int // Finished match IDs whose details are still to be fetched
+	scorersLoaded  int   // Matches whose details have been fetched
+	scorersTotal   int   // Matches in the date range
+	scorersLoading bool
+	scorersScroll  int
+	scorersGen     int // Bumped when the view is opened or closed so stale detail chunks are dropped
+
 	// Dialog overlay for modal dialogs
 	dialogOverlay *ui.DialogOverlay
 
 	// API clients
-	fotmobClient *fotmob.Client
+	fotmobClient api.MatchService // FotMob client, or data.MockClient in tests
 	parser       *fotmob.LiveUpdateParser
 	redditClient *reddit.Client
+	// replaysLimitedUntil is the end of the Reddit block cooldown already scheduled to clear its banner.
+	replaysLimitedUntil time.Time
 
-	// Goal replay links from Reddit (keyed by matchID:minute)
+	// Goal replay links from Reddit (keyed by matchID:minute). Only read and written on the
+	// update loop: the background prefetch sends goalLinksMsgs instead of writing here,
+	// so the map needs no lock. Keep it that way for any new fetches.
 	goalLinks map[reddit.GoalLinkKey]*reddit.GoalLink
+	// Cancels the background goal link prefetch for the current match
+	goalLinksCancel context.CancelFunc
+	// replaysSearching is set while a manual replay search runs, see refreshReplays;
+	// replaysFound counts the goals it found a link for that had none before.
+	replaysSearching bool
+	replaysFound     int
+
+	// Progressive list fetch (stats days / live batches). fetchGen increases with every new
+	// fetch or cancellation; responses tagged with an older generation are dropped.
+	fetchCtx    context.Context
+	fetchCancel context.CancelFunc
+	fetchGen    int
 
 	// Notifications
 	notifier *notify.DesktopNotifier
 
 	// Logo animation (main view only)
 	animatedLogo *logo.AnimatedLogo
+
+	// Remappable key bindings (keys.json)
+	keys keyMap
 }
 
 // New creates a new application model with default values.
 // useMockData determines whether to use mock data instead of real API data.
-// debugMode enables debug logging to a file.
+// debugMode enables logging to a file at every level (GOLAZO_DEBUG enables it too, see logging.Open).
 // isDevBuild indicates if this is a development build.
 // newVersionAvailable indicates if a newer version is available.
 // appVersion is the current application version string.
@@ -136,10 +240,15 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	statsViewSpinner.SetWidth(30)
 
 	pollingSpinner := ui.NewRandomCharSpinner()
-	pollingSpinner.SetWidth(10) // Small spinner for polling indicator
+	pollingSpinner.SetWidth(ui.PollingSpinnerWidth) // Small spinner for polling indicator
+
+	settings, _ := data.LoadSettings()
 
-	// Initialize list models with custom delegate
+	// Initialize list models with custom delegate, one line per match in compact mode
 	delegate := ui.NewMatchListDelegate()
+	if settings.CompactScores {
+		delegate = ui.NewCompactMatchListDelegate()
+	}
 
 	// Filter input styles matching neon theme
 	filterCursorStyle, filterPromptStyle := ui.FilterInputStyles()
@@ -159,15 +268,16 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	statsList.SetShowStatusBar(true)
 	statsList.SetFilteringEnabled(true)
 	statsList.SetShowFilter(true)
-	statsList.Filter = list.DefaultFilter // Required for filtering to work
+	statsList.Filter = ui.TeamFilter // Match on home/away team names, ignoring case and accents
 	statsList.Styles.FilterCursor = filterCursorStyle
 	statsList.FilterInput.PromptStyle = filterPromptStyle
 	statsList.FilterInput.Cursor.Style = filterCursorStyle
-	statsList.AdditionalShortHelpKeys = func() []key.Binding {
-		return []key.Binding{
-			key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "focus")),
-		}
-	}
+
+	statsEventsList := list.New([]list.Item{}, ui.NewEventListDelegate(), 0, 0)
+	statsEventsList.SetShowTitle(false)
+	statsEventsList.SetShowStatusBar(false)
+	statsEventsList.SetShowHelp(false)
+	statsEventsList.SetFilteringEnabled(false)
 
 	// Initialize viewport for scrollable match details in stats view
 	statsDetailsViewport := viewport.New(80, 20) // Will be resized dynamically
@@ -183,33 +293,33 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	upcomingList.FilterInput.PromptStyle = filterPromptStyle
 	upcomingList.FilterInput.Cursor.Style = filterCursorStyle
 
+	// Logging is on with --debug or GOLAZO_DEBUG; debug mode follows it
+	logger := logging.Open(debugMode)
+	debugMode = logger != nil
+
 	// Initialize Reddit client (best-effort, nil if fails)
 	var redditClient *reddit.Client
 	if debugMode {
 		redditClient, _ = reddit.NewClientWithDebug(func(message string) {
-			// This will be called by the Reddit client for debug logging
-			// We'll create a model instance to access debugLog, but for now just log directly
-			// This is a bit of a hack, but it works for debug logging
-			configDir, _ := data.ConfigDir()
-			if configDir != "" {
-				logFile := filepath.Join(configDir, "golazo_debug.log")
-				f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-				if err == nil {
-					defer func() { _ = f.Close() }()
-					_, _ = fmt.Fprintf(f, "%s %s\n", time.Now().Format("2006-01-02 15:04:05"), message)
-				}
-			}
+			logger.Log(logging.LevelDebug, message)
 		})
 	} else {
 		redditClient, _ = reddit.NewClient()
 	}
+	if redditClient != nil {
+		redditClient.SetNotFoundRetryWindow(settings.ReplayRetryWindow())
+	}
 
-	// Initialize animated logo for main view
-	animatedLogo := logo.NewAnimatedLogoWithType(appVersion, false, logo.DefaultOpts(), 1200, 1, logo.AnimationWave)
+	// Initialize animated logo for main view, unless disabled in settings
+	var animatedLogo *logo.AnimatedLogo
+	if settings.LogoAnimationEnabled() {
+		animatedLogo = logo.NewAnimatedLogoWithType(appVersion, false, logo.DefaultOpts(), 1200, 1, logo.AnimationWave)
+	}
 
-	return model{
+	m := model{
 		currentView:            viewMain,
 		matchDetailsCache:      make(map[int]*api.MatchDetails),
+		teamStatsCache:         make(map[int]*api.TeamSeasonStats),
 		useMockData:            useMockData,
 		debugMode:              debugMode,
 		isDevBuild:             isDevBuild,
@@ -219,6 +329,10 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		parser:                 fotmob.NewLiveUpdateParser(),
 		redditClient:           redditClient,
 		goalLinks:              make(map[reddit.GoalLinkKey]*reddit.GoalLink),
+		leagueTables:           make(map[int][]api.LeagueTableEntry),
+		debugBuffer:            newDebugRingBuffer(DebugBufferSize),
+		logger:                 logger,
+		debugViewport:          viewport.New(80, 20),
 		notifier:               notify.NewDesktopNotifier(),
 		spinner:                s,
 		randomSpinner:          randomSpinner,
@@ -228,18 +342,70 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		statsMatchesList:       statsList,
 		upcomingMatchesList:    upcomingList,
 		statsDetailsViewport:   statsDetailsViewport,
+		statsEventsList:        statsEventsList,
 		statsRightPanelFocused: false, // Start with left panel focused
 		statsScrollOffset:      0,     // Start at top
 		statsDateRange:         1,
+		statsDays:              settings.StatsFetchDays(),
+		statsGrouped:           settings.GroupStatsMatches,
+		maxLiveUpdates:         settings.LiveUpdatesLimit(),
+		watchInterval:          settings.WatchRotationInterval(),
+		todaySummaryLoading:    true,
 		pendingSelection:       -1,                    // No pending selection
 		dialogOverlay:          ui.NewDialogOverlay(), // Initialize dialog overlay
-		animatedLogo:           animatedLogo,          // Initialize animated logo
+		animatedLogo:           animatedLogo,          // Initialize animated logo (nil = static)
+	}
+
+	m.liveBatchSize, m.liveBatchDelay = settings.LiveBatching()
+	m.applyDisplayTimezone()
+	m.loadKeyMap()
+	// Gradients fall back to the built-in colors on an invalid hex; say which in the debug log
+	design.SetColorWarningHandler(m.debugLog)
+	// Once the TUI owns the terminal, a switch to a temp directory goes to the log instead
+	data.SetStorageWarningHandler(m.warnLog)
+	m.leagueFlags = settings.LeagueFlags
+	m.statBarsFillAway = settings.StatBarsFillAway
+	m.spoilerMode = settings.SpoilerMode
+	m.revealedMatches = make(map[int]bool)
+	m.pinnedMatches = make(map[int]bool)
+	m.favoriteTeams = make(map[int]bool, len(settings.FavoriteTeams))
+	for _, teamID := range settings.FavoriteTeams {
+		m.favoriteTeams[teamID] = true
+	}
+	m.favoritesFirst = settings.FavoritesFirst
+	m.listPanelPercent = settings.ListPanelShare()
+	m.showTicker = settings.ResultsTicker
+	if settings.PlayerPhotos {
+		if protocol := images.Detect(); protocol != images.ProtocolNone {
+			m.playerPhotos = images.NewCache(protocol)
+		}
 	}
+	return m
+}
+
+// applyDisplayTimezone configures the time zone used for displayed kickoff times.
+// Invalid zone names fall back to the system local zone with a logged warning.
+func (m model) applyDisplayTimezone() {
+	settings, _ := data.LoadSettings()
+	loc, err := settings.DisplayLocation()
+	if err != nil {
+		m.warnLog(fmt.Sprintf("%v - using local time", err))
+	}
+	ui.SetDisplayLocation(loc)
 }
 
 // getStatusBannerType returns the appropriate status banner type based on current model state.
-// Priority: Debug > Dev > New Version > None
+// Priority: Transient > Debug > Dev > New Version > None
 func (m model) getStatusBannerType() constants.StatusBannerType {
+	if m.transientBanner != constants.StatusBannerNone {
+		return m.transientBanner
+	}
+	if m.replaysSearching {
+		return constants.StatusBannerSearchingReplays
+	}
+	if m.redditClient != nil && time.Now().Before(m.redditClient.BlockedUntil()) {
+		return constants.StatusBannerReplaysLimited
+	}
 	if m.debugMode {
 		return constants.StatusBannerDebug
 	}
@@ -252,74 +418,10 @@ func (m model) getStatusBannerType() constants.StatusBannerType {
 	return constants.StatusBannerNone
 }
 
-// getScrollableContentLength returns the approximate number of lines in the scrollable content
-func (m model) getScrollableContentLength() int {
-	if m.matchDetails == nil {
-		return 0
-	}
-
-	lineCount := 0
-
-	// Count goals (each goal is typically 1 line + section header)
-	if len(m.matchDetails.Events) > 0 {
-		goalCount := 0
-		for _, event := range m.matchDetails.Events {
-			if event.Type == "goal" {
-				goalCount++
-			}
-		}
-		if goalCount > 0 {
-			lineCount += 1 + goalCount // Section header + goals
-		}
-	}
-
-	// Count cards (each card is typically 1 line + section header)
-	if len(m.matchDetails.Events) > 0 {
-		cardCount := 0
-		for _, event := range m.matchDetails.Events {
-			if event.Type == "card" {
-				cardCount++
-			}
-		}
-		if cardCount > 0 {
-			lineCount += 1 + cardCount // Section header + cards
-		}
-	}
-
-	// Count statistics (each stat is typically 1 line + section header)
-	if len(m.matchDetails.Statistics) > 0 {
-		lineCount += 1 + len(m.matchDetails.Statistics) // Section header + stats
-	}
-
-	// Add spacing between sections
-	if lineCount > 0 {
-		lineCount += 1 // Extra spacing
-	}
-
-	return lineCount
-}
-
-// getHeaderContentHeight returns the approximate height of the header content
-func (m model) getHeaderContentHeight() int {
-	if m.matchDetails == nil {
-		return 1
-	}
-
-	// Header typically has: title, teams, score, league, venue, date, referee, attendance
-	height := 8 // Base header height
-
-	// Add lines for optional fields
-	if m.matchDetails.Referee != "" {
-		height++
-	}
-	if m.matchDetails.Attendance > 0 {
-		height++
-	}
-
-	return height
-}
-
 // Init initializes the application.
 func (m model) Init() tea.Cmd {
-	return tea.Batch(m.spinner.Tick, ui.SpinnerTick())
+	if m.animatedLogo == nil {
+		return tea.Batch(m.spinner.Tick, fetchTodaySummary(m.fotmobClient, m.useMockData), scheduleCountdownTick()) // Static logo - no animation tick needed
+	}
+	return tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchTodaySummary(m.fotmobClient, m.useMockData), scheduleCountdownTick())
 }
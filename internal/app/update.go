@@ -1,17 +1,22 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/clipboard"
+	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/logging"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -34,6 +39,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case matchDetailsMsg:
 		return m.handleMatchDetails(msg)
 
+	case commentaryMsg:
+		return m.handleCommentary(msg)
+
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
@@ -49,15 +57,37 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case statsDataMsg:
 		return m.handleStatsData(msg)
 
+	case todaySummaryMsg:
+		return m.handleTodaySummary(msg)
+
 	case statsDayDataMsg:
 		return m.handleStatsDayData(msg)
 
+	case nearestMatchDayMsg:
+		return m.handleNearestMatchDay(msg)
+
+	case snapshotMsg:
+		return m.handleSnapshot(msg)
+
+	case playerPhotosMsg:
+		// Nothing to update - the thumbnails are drawn from the photo cache
+		return m, nil
+
 	case ui.TickMsg:
 		return m.handleAnimationTick(msg)
 
 	case mainViewCheckMsg:
 		return m.handleMainViewCheck(msg)
 
+	case liveClockTickMsg:
+		return m.handleLiveClockTick(msg)
+
+	case watchTickMsg:
+		return m.handleWatchTick(msg)
+
+	case countdownTickMsg:
+		return m, scheduleCountdownTick()
+
 	case pollTickMsg:
 		return m.handlePollTick(msg)
 
@@ -70,10 +100,37 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case goalLinksMsg:
 		return m.handleGoalLinks(msg)
+	case tickerResultsMsg:
+		return m.handleTickerResults(msg)
+
+	case replaysLimitExpiredMsg:
+		// Nothing to update: redrawing drops the banner once the cooldown is over
+		return m, nil
 
 	case standingsMsg:
 		return m.handleStandings(msg)
 
+	case teamStatsMsg:
+		return m.handleTeamStats(msg)
+
+	case leagueTableMsg:
+		return m.handleLeagueTable(msg)
+
+	case clipboardMsg:
+		return m.handleClipboard(msg)
+	case matchPageMsg:
+		return m.handleMatchPage(msg)
+
+	case scorerDetailsMsg:
+		return m.handleScorerDetails(msg)
+
+	case transientBannerExpiredMsg:
+		// Only clear if a newer banner hasn't replaced it
+		if m.transientBanner == msg.banner {
+			m.transientBanner = constants.StatusBannerNone
+		}
+		return m, nil
+
 	default:
 		// Fallback handler for ui.TickMsg type assertion
 		if _, ok := msg.(ui.TickMsg); ok {
@@ -98,15 +155,15 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 
 	switch m.currentView {
 	case viewLiveMatches:
-		leftWidth := max(m.width*35/100, 25)
+		leftWidth, _ := ui.PanelWidths(m.width, m.listPanelPercent)
 		availableWidth := leftWidth - frameH*2
-		availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight
+		availableHeight := m.liveViewHeight() - frameV*2 - titleHeight - spinnerHeight
 		if availableWidth > 0 && availableHeight > 0 {
 			m.liveMatchesList.SetSize(availableWidth, availableHeight)
 		}
 
 	case viewStats:
-		leftWidth := max(m.width*40/100, 30)
+		leftWidth, _ := ui.PanelWidths(m.width, m.listPanelPercent)
 		availableWidth := leftWidth - frameH*2
 		availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight
 		if availableWidth > 0 && availableHeight > 0 {
@@ -131,7 +188,7 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 
 // handleSpinnerTick updates the standard spinner animation.
 func (m model) handleSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
-	if m.loading || m.mainViewLoading {
+	if m.loading || m.mainViewLoading || m.todaySummaryLoading {
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
@@ -139,10 +196,58 @@ func (m model) handleSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleTodaySummary stores today's match counts for the main menu.
+// Errors are only logged - the glance line is simply left empty.
+func (m model) handleTodaySummary(msg todaySummaryMsg) (tea.Model, tea.Cmd) {
+	m.todaySummaryLoading = false
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Today summary unavailable: %v", msg.err))
+		return m, nil
+	}
+	m.todaySummary = &msg.summary
+	return m, nil
+}
+
+// handleTickerResults stores today's finished matches for the results ticker, starting
+// the spinner tick that scrolls it unless one is running already.
+func (m model) handleTickerResults(msg tickerResultsMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Results ticker unavailable: %v", msg.err))
+		return m, nil
+	}
+	wasActive := m.spinnersActive()
+	m.tickerResults = msg.matches
+	if !wasActive && m.spinnersActive() {
+		return m, ui.SpinnerTick()
+	}
+	return m, nil
+}
+
+// tickerShown reports whether the live view shows the results ticker below its panels.
+// Without finished matches to show, it takes no room.
+func (m model) tickerShown() bool {
+	return m.showTicker && len(m.tickerResults) > 0 && m.currentView == viewLiveMatches && !m.focusedMatch
+}
+
+// tickerScrolling reports whether the shown results ticker is too wide for the screen,
+// so each spinner tick scrolls it.
+func (m model) tickerScrolling() bool {
+	return m.tickerShown() && ui.ResultsTickerScrolls(m.width, ui.ResultsTickerText(m.tickerResults, m.spoilers()))
+}
+
+// liveViewHeight is the height left to the live view's panels above the results ticker.
+func (m model) liveViewHeight() int {
+	if m.tickerShown() {
+		return m.height - 1
+	}
+	return m.height
+}
+
 // handleLiveUpdate processes live match update messages.
 func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
 	if msg.update != "" {
-		m.liveUpdates = append(m.liveUpdates, msg.update)
+		// Newest first, matching the order ParseEvents produces
+		m.liveUpdates = capLiveUpdates(append([]string{msg.update}, m.liveUpdates...), m.maxLiveUpdates)
 	}
 
 	// Continue polling if match is live
@@ -155,6 +260,73 @@ func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// capLiveUpdates keeps the first limit updates of a newest-first list, dropping the oldest.
+// A limit of zero or less keeps everything.
+func capLiveUpdates(updates []string, limit int) []string {
+	if limit <= 0 || len(updates) <= limit {
+		return updates
+	}
+	return updates[:limit]
+}
+
+// toggleCommentary switches the live updates between match events only and FotMob's
+// full text commentary, fetching the commentary of the shown match when turned on.
+func (m model) toggleCommentary() (tea.Model, tea.Cmd) {
+	m.fullCommentary = !m.fullCommentary
+	if !m.fullCommentary {
+		m.transientBanner = constants.StatusBannerCommentaryOff
+		return m, expireTransientBanner(m.transientBanner)
+	}
+
+	m.transientBanner = constants.StatusBannerCommentaryOn
+	cmds := []tea.Cmd{expireTransientBanner(m.transientBanner)}
+	if m.matchDetails != nil {
+		cmds = append(cmds, fetchCommentary(m.fotmobClient, m.matchDetails.ID, m.useMockData))
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// handleCommentary adds the commentary lines not shown yet, newest first.
+// Entries are matched by ID, so lines repeated by later polls are skipped.
+func (m model) handleCommentary(msg commentaryMsg) (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil || msg.matchID != m.matchDetails.ID {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Commentary fetch failed for match %d: %v", msg.matchID, msg.err))
+		return m, nil
+	}
+
+	if m.commentarySeen == nil {
+		m.commentarySeen = make(map[string]bool)
+	}
+	var fresh []api.CommentaryEntry
+	for _, entry := range msg.entries {
+		if !m.commentarySeen[entry.ID] {
+			m.commentarySeen[entry.ID] = true
+			fresh = append(fresh, entry)
+		}
+	}
+	if len(fresh) == 0 {
+		return m, nil
+	}
+
+	m.commentary = append(fresh, m.commentary...)
+	if m.maxLiveUpdates > 0 && len(m.commentary) > m.maxLiveUpdates {
+		m.commentary = m.commentary[:m.maxLiveUpdates]
+	}
+	return m, nil
+}
+
+// displayedLiveUpdates returns the updates shown in the live view: the commentary in full
+// commentary mode when the match has any, otherwise the match events.
+func (m model) displayedLiveUpdates() []string {
+	if m.fullCommentary && len(m.commentary) > 0 {
+		return m.parser.FormatCommentary(m.commentary)
+	}
+	return m.liveUpdates
+}
+
 // handleMatchDetails processes match details response messages.
 func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
@@ -162,14 +334,22 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	if msg.details == nil {
 		// Clear match details when API call fails so we don't show stale data
 		m.matchDetails = nil
+		m.loadingMatchID = 0
 		m.loading = false
 		m.liveViewLoading = false
 		m.statsViewLoading = false
-		m.debugLog("handleMatchDetails: match details is nil")
+		m.errorLog("handleMatchDetails: match details is nil")
 		return m, nil
 	}
 
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("handleMatchDetails: %v", msg.err))
+	}
+
 	m.matchDetails = msg.details
+	if msg.details.ID == m.loadingMatchID {
+		m.loadingMatchID = 0
+	}
 	m.debugLog(fmt.Sprintf("handleMatchDetails: loaded match %d (%s vs %s) with %d events, status=%v",
 		msg.details.ID, msg.details.HomeTeam.Name, msg.details.AwayTeam.Name, len(msg.details.Events), msg.details.Status))
 
@@ -216,12 +396,25 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 	if hasGoals {
-		cmds = append(cmds, fetchGoalLinks(m.redditClient, msg.details))
+		// Replace any prefetch still running for a previous load
+		if m.goalLinksCancel != nil {
+			m.goalLinksCancel()
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		m.goalLinksCancel = cancel
+		cmds = append(cmds, fetchGoalLinks(ctx, m.redditClient, msg.details))
+
+		if m.playerPhotos.Enabled() {
+			cmds = append(cmds, fetchPlayerPhotos(m.playerPhotos, msg.details))
+		}
 	}
 
-	// Cache for stats view (including during preload)
-	if m.currentView == viewStats || m.pendingSelection == 0 {
+	// Cache for stats view (including during preload and while the scorers view is on top of it)
+	if m.currentView == viewStats || m.currentView == viewScorers || m.pendingSelection == 0 {
 		m.matchDetailsCache[msg.details.ID] = msg.details
+		if m.statsShowEvents {
+			m.refreshStatsEventsList()
+		}
 		m.loading = false
 		m.statsViewLoading = false
 		return m, tea.Batch(cmds...)
@@ -243,22 +436,61 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 
 		// Detect new goals during poll refresh (not initial load)
 		// Only notify when: polling is active AND we have previous score data
-		hasScoreData := m.lastHomeScore > 0 || m.lastAwayScore > 0 || len(m.lastEvents) > 0
+		hasScoreData := m.lastHomeScore > 0 || m.lastAwayScore > 0 || len(m.seenEventIDs) > 0
 		if m.polling && hasScoreData {
 			m.notifyNewGoals(msg.details)
 		}
 
+		// Detect the final whistle during poll refresh; polling stops below
+		if m.polling && msg.details.Status == api.MatchStatusFinished {
+			m.debugLog(fmt.Sprintf("handleMatchDetails: match %d finished, stopping polling", msg.details.ID))
+		}
+
 		// Update tracked scores for next comparison
 		m.lastHomeScore = homeScore
 		m.lastAwayScore = awayScore
 
-		// Parse ALL events to rebuild the live updates list
-		// This ensures proper ordering (descending by minute) and uniqueness
-		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
-		m.lastEvents = msg.details.Events
+		// The first load of a match builds the live updates from all its events; polls then
+		// add only the events that appeared since, by event ID, and rebuild the feed when
+		// one was removed (a goal cancelled after review)
+		// Half-time markers go in when the stage moves on: on a first load mid-match, every
+		// boundary since kick-off; on a poll, those crossed since the previous one ("45'" to "HT")
+		var updates []string
+		stage, fromStage := fotmob.StageOf(msg.details), m.matchStage
+		if m.seenEventIDs == nil || fotmob.EventsRemoved(m.seenEventIDs, msg.details.Events) {
+			updates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
+			fromStage = fotmob.StageFirstHalf
+		} else {
+			newUpdates := m.parser.NewEventUpdates(m.seenEventIDs, msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
+			if len(newUpdates) > 0 {
+				m.debugLog(fmt.Sprintf("handleMatchDetails: %d new events for match %d", len(newUpdates), msg.details.ID))
+			}
+			updates = append(newUpdates, m.liveUpdates...)
+		}
+		if stage != fotmob.StageUnknown {
+			updates = m.parser.AddStageUpdates(updates, fromStage, stage)
+			m.matchStage = stage
+		}
+		if msg.details.Status == api.MatchStatusFinished {
+			// Close the feed with the final score, also after a manual refresh
+			if fullTime := m.parser.FullTimeUpdate(msg.details); !slices.Contains(updates, fullTime) {
+				updates = append([]string{fullTime}, updates...)
+			}
+		}
+		m.liveUpdates = capLiveUpdates(updates, m.maxLiveUpdates)
+		m.seenEventIDs = fotmob.EventIDs(msg.details.Events)
+
+		// Pick up new commentary lines with every poll
+		if m.fullCommentary {
+			cmds = append(cmds, fetchCommentary(m.fotmobClient, msg.details.ID, m.useMockData))
+		}
 
 		// Continue polling if match is live
 		if msg.details.Status == api.MatchStatusLive {
+			// The fetched minute is authoritative; restart the local clock from it
+			m.liveClockSince = time.Now()
+			cmds = append(cmds, scheduleLiveClockTick(m.liveClockSince))
+
 			// For initial load, clear loading state
 			// For poll refresh, loading is cleared by 1s timer (pollDisplayCompleteMsg)
 			if !m.polling {
@@ -294,10 +526,39 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	switch msg.String() {
-	case "q", "ctrl+c":
+	// Debug log viewer takes all keys while open
+	if m.debugViewOpen {
+		return m.handleDebugViewKeys(msg)
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Quit):
 		return m, tea.Quit
-	case "esc":
+	case msg.String() == "?":
+		// Key bindings of the current view (not while typing a filter)
+		if !m.typingFilter() {
+			m.openHelpDialog()
+			return m, nil
+		}
+	case msg.String() == "ctrl+l":
+		// Hidden key: open the in-app debug log viewer
+		m.debugViewOpen = true
+		m.syncDebugViewport()
+		m.debugViewport.GotoBottom()
+		return m, nil
+	case msg.String() == "ctrl+r":
+		// Hidden key: show the shown match's details as parsed, for bug reports
+		if m.matchDetails != nil && (m.currentView == viewLiveMatches || m.currentView == viewStats) {
+			m.dialogOverlay.OpenDialog(ui.NewRawJSONDialog(m.matchDetails))
+			return m, nil
+		}
+	case key.Matches(msg, m.keys.Back):
+		// Esc from the full-screen match returns to the list, keeping the selection
+		if m.currentView == viewLiveMatches && m.focusedMatch {
+			m.focusedMatch = false
+			return m, nil
+		}
+
 		// Check if any list is in filtering mode - if so, let the list handle Esc
 		// to cancel the filter instead of navigating back
 		isFiltering := false
@@ -313,6 +574,16 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				isFiltering = m.settingsState.List.FilterState() == list.Filtering ||
 					m.settingsState.List.FilterState() == list.FilterApplied
 			}
+		case viewStandings:
+			if m.standingsState != nil {
+				// Esc from a table goes back to the league picker
+				if m.standingsState.ShowingTable() {
+					m.standingsState.ShowPicker()
+					return m, nil
+				}
+				isFiltering = m.standingsState.List.FilterState() == list.Filtering ||
+					m.standingsState.List.FilterState() == list.FilterApplied
+			}
 		}
 
 		if isFiltering {
@@ -320,6 +591,11 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			break
 		}
 
+		// The scorers view belongs to the stats view, so Esc returns there
+		if m.currentView == viewScorers {
+			return m.closeScorersView()
+		}
+
 		if m.currentView != viewMain {
 			return m.resetToMainView()
 		}
@@ -335,6 +611,10 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleStatsSelection(msg)
 	case viewSettings:
 		return m.handleSettingsViewKeys(msg)
+	case viewStandings:
+		return m.handleStandingsViewKeys(msg)
+	case viewScorers:
+		return m.handleScorersViewKeys(msg)
 	}
 
 	return m, nil
@@ -345,23 +625,170 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.currentView = viewMain
 	m.selected = 0
 	m.matchDetails = nil
+	m.loadingMatchID = 0
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
-	m.lastEvents = nil
+	m.commentary = nil
+	m.commentarySeen = nil
+	m.seenEventIDs = nil
+	m.matchStage = fotmob.StageUnknown
 	m.lastHomeScore = 0
 	m.lastAwayScore = 0
 	m.loading = false
 	m.polling = false
+	m.stopWatchMode()
 	m.matches = nil
 	m.upcomingMatches = nil
+	m.focusedMatch = false
 	m.statsRightPanelFocused = false
 	m.statsScrollOffset = 0
+	m.statsScrollMatchID = 0
+
+	// Abandon any progressive fetch still running for the view being left
+	m.cancelFetch()
+	m.statsViewLoading = false
+	m.liveViewLoading = false
+	m.statsDaysLoaded = 0
+	m.statsTotalDays = 0
+	m.clearStatsAnchor()
+	m.lastFetchErr = nil
+
+	if m.goalLinksCancel != nil {
+		m.goalLinksCancel()
+		m.goalLinksCancel = nil
+	}
+	m.replaysSearching = false
 	return m, nil
 }
 
+// handleClipboard shows a transient banner for the result of a copy attempt.
+// A missing clipboard tool is reported in the banner rather than treated as an error.
+func (m model) handleClipboard(msg clipboardMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.err == nil:
+		m.transientBanner = constants.StatusBannerCopied
+	case errors.Is(msg.err, clipboard.ErrUnavailable):
+		m.transientBanner = constants.StatusBannerNoClipboard
+	default:
+		m.warnLog(fmt.Sprintf("Copy to clipboard failed: %v", msg.err))
+		m.transientBanner = constants.StatusBannerCopyFailed
+	}
+	return m, expireTransientBanner(m.transientBanner)
+}
+
+// handleMatchPage shows a transient banner for the result of opening a match page.
+func (m model) handleMatchPage(msg matchPageMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Open match page failed: %v", msg.err))
+		m.transientBanner = constants.StatusBannerNoBrowser
+	} else {
+		m.transientBanner = constants.StatusBannerOpenedPage
+	}
+	return m, expireTransientBanner(m.transientBanner)
+}
+
 // handleLiveMatchesSelection handles list navigation in live matches view.
 func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// r retries a fetch that failed with nothing to show
+	if msg.String() == "r" && m.showingFetchError() {
+		return m.retryFetch()
+	}
+
+	// The full-screen match scrolls; keys that would change the shown match are ignored
+	if m.focusedMatch {
+		switch {
+		case key.Matches(msg, m.keys.Up):
+			m.scrollFocusedMatch(ui.ScrollLineUp)
+			return m, nil
+		case key.Matches(msg, m.keys.Down):
+			m.scrollFocusedMatch(ui.ScrollLineDown)
+			return m, nil
+		}
+		if action, ok := statsPagingKeys[msg.String()]; ok {
+			m.scrollFocusedMatch(action)
+			return m, nil
+		}
+		switch msg.String() {
+		case "c", "u", "z", "v", "r", "y", "O", "P":
+			// Handled below as in the split view
+		default:
+			return m, nil
+		}
+	}
+
+	// w toggles watch mode; any other key stops it and is handled as usual
+	if msg.String() == "w" && m.liveMatchesList.FilterState() != list.Filtering {
+		if m.watchMode {
+			m.stopWatchMode()
+			return m, nil
+		}
+		return m.startWatchMode()
+	}
+	m.stopWatchMode()
+
+	// Copy match summary (not while typing a filter)
+	if msg.String() == "y" && m.matchDetails != nil && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, copyMatchSummary(m.matchDetails)
+	}
+
+	// Open the match page on FotMob (not while typing a filter)
+	if msg.String() == "O" && m.matchDetails != nil && m.liveMatchesList.FilterState() != list.Filtering {
+		return m, openMatchPage(m.matchDetails)
+	}
+
+	// c switches the updates between match events only and the full commentary
+	if msg.String() == "c" && m.liveMatchesList.FilterState() != list.Filtering {
+		return m.toggleCommentary()
+	}
+
+	// u collapses the updates to their title, or expands them again
+	if msg.String() == "u" && m.liveMatchesList.FilterState() != list.Filtering {
+		m.updatesCollapsed = !m.updatesCollapsed
+		return m, nil
+	}
+
+	// z toggles spoiler mode; v reveals the shown match (not while typing a filter)
+	if m.liveMatchesList.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "z":
+			return m.toggleSpoilerMode()
+		case "v":
+			return m.revealMatch(m.liveMatchesList)
+		}
+	}
+
+	// Jump to the next/previous match in progress (not while typing a filter)
+	if m.liveMatchesList.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "n":
+			return m.jumpToLiveMatch(1)
+		case "N":
+			return m.jumpToLiveMatch(-1)
+		case "s":
+			if m.liveMatchesList.FilterState() == list.Unfiltered {
+				return m.cycleMatchSort()
+			}
+		case "F":
+			if m.liveMatchesList.FilterState() == list.Unfiltered {
+				return m.toggleFavoritesFirst()
+			}
+		case "P":
+			return m.refreshReplays()
+		case "<":
+			return m.adjustListPanel(-1)
+		case ">":
+			return m.adjustListPanel(1)
+		case "T":
+			return m.toggleResultsTicker()
+		case "p":
+			if m.liveMatchesList.FilterState() == list.Unfiltered {
+				return m.togglePin()
+			}
+		}
+	}
+
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
+	wasFiltering := m.liveMatchesList.FilterState() == list.Filtering
 	var preUpdateMatchID int
 	if preItem := m.liveMatchesList.SelectedItem(); preItem != nil {
 		if item, ok := preItem.(ui.MatchListItem); ok {
@@ -369,9 +796,21 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Filtering must search the full backing set, not just the loaded window
+	hasMore := m.liveLoadedCount < len(m.matches)
+	if hasMore && (msg.String() == "/" || m.liveMatchesList.FilterState() != list.Unfiltered) {
+		m.setLiveListWindow(len(m.matches))
+	}
+
 	var listCmd tea.Cmd
 	m.liveMatchesList, listCmd = m.liveMatchesList.Update(msg)
 
+	// Load the next window when the selection nears the end of the loaded matches
+	if m.liveLoadedCount < len(m.matches) && m.liveMatchesList.FilterState() == list.Unfiltered &&
+		m.liveMatchesList.Index() >= m.liveLoadedCount-liveLoadMoreThreshold {
+		m.setLiveListWindow(m.liveLoadedCount + LiveListPageSize)
+	}
+
 	// Get currently displayed match ID
 	currentMatchID := 0
 	if m.matchDetails != nil {
@@ -389,7 +828,7 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Use pre-update selection if it was valid and different from current
 	// This handles the filter case where Enter clears the filter
 	targetMatchID := postUpdateMatchID
-	if msg.String() == "enter" && preUpdateMatchID != 0 {
+	if key.Matches(msg, m.keys.Select) && preUpdateMatchID != 0 {
 		targetMatchID = preUpdateMatchID
 	}
 
@@ -404,11 +843,18 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.loadMatchDetails(targetMatchID)
 	}
 
+	// Enter on the shown match follows it full-screen (not when it accepts a filter)
+	if key.Matches(msg, m.keys.Select) && !wasFiltering && m.matchDetails != nil && targetMatchID == currentMatchID {
+		m.focusedMatch = true
+		m.focusedScroll = 0
+		return m, listCmd
+	}
+
 	// Handle refresh key (r) to force refresh current match
 	if msg.String() == "r" {
 		m.debugLog(fmt.Sprintf("Live matches refresh key pressed - matchDetails is nil: %v", m.matchDetails == nil))
 		if m.matchDetails != nil {
-			m.debugLog(fmt.Sprintf("Forcing refresh for match ID: %d in live matches view", m.matchDetails.ID))
+			m.infoLog(fmt.Sprintf("Forcing refresh for match ID: %d in live matches view", m.matchDetails.ID))
 			return m.loadMatchDetailsWithRefresh(m.matchDetails.ID, true)
 		} else {
 			m.debugLog("Cannot refresh - no match details currently loaded")
@@ -418,58 +864,84 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, listCmd
 }
 
+// statsPagingKeys page through the focused stats details.
+var statsPagingKeys = map[string]ui.ScrollAction{
+	"ctrl+u": ui.ScrollHalfPageUp,
+	"ctrl+d": ui.ScrollHalfPageDown,
+	"pgup":   ui.ScrollPageUp,
+	"pgdown": ui.ScrollPageDown,
+	"g":      ui.ScrollTop,
+	"G":      ui.ScrollBottom,
+}
+
+// scrollStatsDetails moves the focused stats details by action, within the rendered content.
+func (m *model) scrollStatsDetails(action ui.ScrollAction) {
+	contentLines, pageLines := ui.StatsDetailsScrollSize(m.width, m.height, m.statsViewConfig())
+	m.statsScrollOffset = ui.Scroll(m.statsScrollOffset, action, contentLines, pageLines)
+}
+
+// scrollFocusedMatch moves the full-screen match by action, within the rendered content.
+func (m *model) scrollFocusedMatch(action ui.ScrollAction) {
+	contentLines, pageLines := ui.FocusedMatchScrollSize(m.width, m.height, m.focusedMatchConfig())
+	m.focusedScroll = ui.Scroll(m.focusedScroll, action, contentLines, pageLines)
+}
+
 // handleStatsSelection handles list navigation and date range changes in stats view.
 func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// r retries a fetch that failed with nothing to show
+	if msg.String() == "r" && m.showingFetchError() {
+		return m.retryFetch()
+	}
+
 	// Check if list is in filtering mode - if so, let list handle ALL keys
 	isFiltering := m.statsMatchesList.FilterState() == list.Filtering
 
 	// Handle keys based on focus state
 	if m.statsRightPanelFocused && m.matchDetails != nil && m.statsDetailsViewport.Height > 0 {
 		// Right panel focused - handle scrolling keys and dialog triggers
-		switch msg.String() {
-		case "up", "k":
-			// Manual scroll up
-			if m.matchDetails != nil && m.statsScrollOffset > 0 {
-				m.statsScrollOffset--
+		if m.statsShowEvents {
+			if _, paging := statsPagingKeys[msg.String()]; paging || key.Matches(msg, m.keys.Up, m.keys.Down) {
+				// Navigate the events list instead of scrolling details
+				var cmd tea.Cmd
+				m.statsEventsList, cmd = m.statsEventsList.Update(msg)
+				return m, cmd
 			}
+			switch msg.String() {
+			case "1", "2", "3", "4":
+				// Select event filter: all, goals, cards, subs
+				m.statsEventsFilter = ui.EventFilter(msg.String()[0] - '1')
+				m.refreshStatsEventsList()
+				return m, nil
+			}
+		}
+		if action, ok := statsPagingKeys[msg.String()]; ok {
+			m.scrollStatsDetails(action)
 			return m, nil
-		case "down", "j":
-			// Manual scroll down with bounds checking
-			if m.matchDetails != nil && m.statsRightPanelFocused {
-				// Get content dimensions
-				scrollableLines := m.getScrollableContentLength()
-				headerHeight := m.getHeaderContentHeight()
-
-				// Calculate available height for scrolling
-				availableHeight := m.height - 10 // Approximate panel height minus borders/spinner
-				if availableHeight < 10 {
-					availableHeight = 10
-				}
-				scrollableHeight := availableHeight - headerHeight
-				if scrollableHeight < 3 {
-					scrollableHeight = 3
-				}
-
-				// Check if we can scroll down further
-				maxOffset := scrollableLines - scrollableHeight
-				if maxOffset < 0 {
-					maxOffset = 0
-				}
-
-				if m.statsScrollOffset < maxOffset {
-					m.statsScrollOffset++
-				}
+		}
+		switch {
+		case msg.String() == "e":
+			// Toggle the filterable events list
+			m.statsShowEvents = !m.statsShowEvents
+			m.statsScrollOffset = 0
+			if m.statsShowEvents {
+				m.refreshStatsEventsList()
 			}
 			return m, nil
-		case "tab":
-			// Tab toggles focus back to left panel
+		case key.Matches(msg, m.keys.Up):
+			m.scrollStatsDetails(ui.ScrollLineUp)
+			return m, nil
+		case key.Matches(msg, m.keys.Down):
+			m.scrollStatsDetails(ui.ScrollLineDown)
+			return m, nil
+		case key.Matches(msg, m.keys.ToggleFocus):
+			// Toggle focus back to left panel
 			m.statsRightPanelFocused = false
 			return m, nil
-		case "f":
+		case msg.String() == "f":
 			// Open formations dialog
 			m.openFormationsDialog()
 			return m, nil
-		case "s":
+		case msg.String() == "s":
 			// Fetch standings and open dialog
 			if m.matchDetails != nil {
 				return m, fetchStandings(
@@ -482,20 +954,97 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				)
 			}
 			return m, nil
-		case "x":
+		case msg.String() == "H" || msg.String() == "A":
+			// Season record of the home or away team
+			return m.openTeamStats(msg.String() == "H")
+		case msg.String() == "m" || msg.String() == "M" || msg.String() == "c":
+			// Limit the events to a minute range: m moves the start on, M the end back, c clears
+			switch msg.String() {
+			case "m":
+				m.statsMinuteRange = m.statsMinuteRange.StepFrom()
+			case "M":
+				m.statsMinuteRange = m.statsMinuteRange.StepTo()
+			default:
+				m.statsMinuteRange = ui.MinuteRange{}
+			}
+			m.statsScrollOffset = 0
+			if m.statsShowEvents {
+				m.refreshStatsEventsList()
+			}
+			return m, nil
+		case msg.String() == "x":
 			// Open full statistics dialog
 			m.openStatisticsDialog()
 			return m, nil
+		case msg.String() == "t":
+			// Toggle timeline view
+			return m.handleStatsViewKeys(msg)
 		}
 	}
 
 	// Only handle date range navigation when NOT filtering
 	if !isFiltering {
-		if msg.String() == "h" || msg.String() == "left" || msg.String() == "l" || msg.String() == "right" {
+		if msg.String() == "y" && m.matchDetails != nil {
+			// Copy match summary to the clipboard
+			return m, copyMatchSummary(m.matchDetails)
+		}
+		if msg.String() == "O" && m.matchDetails != nil {
+			// Open the match page on FotMob
+			return m, openMatchPage(m.matchDetails)
+		}
+		if msg.String() == "z" {
+			// Hide or show scores until revealed
+			return m.toggleSpoilerMode()
+		}
+		if msg.String() == "v" {
+			// Reveal the shown match in spoiler mode
+			return m.revealMatch(m.statsMatchesList)
+		}
+		if msg.String() == "p" {
+			// Top scorers across the matches in the current date range
+			return m.openScorersView()
+		}
+		if msg.String() == "s" && m.statsMatchesList.FilterState() == list.Unfiltered {
+			return m.cycleMatchSort()
+		}
+		if msg.String() == "F" && m.statsMatchesList.FilterState() == list.Unfiltered {
+			// List the favorite teams' matches first, or back in the plain order
+			return m.toggleFavoritesFirst()
+		}
+		if msg.String() == "P" {
+			// Search Reddit again for the shown match's missing replay links
+			return m.refreshReplays()
+		}
+		if msg.String() == "<" || msg.String() == ">" {
+			// Narrow or widen the match list
+			step := 1
+			if msg.String() == "<" {
+				step = -1
+			}
+			return m.adjustListPanel(step)
+		}
+		if msg.String() == "g" {
+			// Jump to the suggested match day, or back to today from an earlier one
+			switch {
+			case !m.statsSuggestedDay.IsZero():
+				return m.jumpToStatsDay(m.statsSuggestedDay)
+			case !m.statsAnchor.IsZero():
+				return m.jumpToStatsDay(time.Time{})
+			}
+		}
+		if msg.String() == "[" || msg.String() == "]" {
+			// Step the window back or forward by one match day
+			step := -1
+			if msg.String() == "]" {
+				step = 1
+			}
+			return m.shiftStatsDay(step)
+		}
+		if key.Matches(msg, m.keys.DateBack, m.keys.DateForward) {
 			return m.handleStatsViewKeys(msg)
 		}
-		// Handle tab toggle when not filtering
-		if msg.String() == "tab" {
+		// Handle tab toggle, live only and refresh all when not filtering
+		if key.Matches(msg, m.keys.ToggleFocus) || msg.String() == "t" || msg.String() == "L" || msg.String() == "R" {
 			return m.handleStatsViewKeys(msg)
 		}
 	}
@@ -508,9 +1057,11 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	// Handle list navigation
+	// Handle list navigation, stepping over group headers
 	var listCmd tea.Cmd
+	preUpdateIndex := m.statsMatchesList.Index()
 	m.statsMatchesList, listCmd = m.statsMatchesList.Update(msg)
+	ui.SkipGroupHeaders(&m.statsMatchesList, preUpdateIndex)
 
 	// Get currently displayed match ID
 	currentMatchID := 0
@@ -529,7 +1080,7 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Use pre-update selection if it was valid and different from current
 	// This handles the filter case where Enter clears the filter
 	targetMatchID := postUpdateMatchID
-	if msg.String() == "enter" && preUpdateMatchID != 0 {
+	if key.Matches(msg, m.keys.Select) && preUpdateMatchID != 0 {
 		targetMatchID = preUpdateMatchID
 	}
 
@@ -548,7 +1099,7 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if msg.String() == "r" {
 		m.debugLog(fmt.Sprintf("Refresh key pressed - matchDetails is nil: %v", m.matchDetails == nil))
 		if m.matchDetails != nil {
-			m.debugLog(fmt.Sprintf("Forcing refresh for match ID: %d", m.matchDetails.ID))
+			m.infoLog(fmt.Sprintf("Forcing refresh for match ID: %d", m.matchDetails.ID))
 			return m.loadStatsMatchDetailsWithRefresh(m.matchDetails.ID, true)
 		} else {
 			m.debugLog("Cannot refresh - no match details currently loaded")
@@ -578,12 +1129,14 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	}
 
 	m.matches = displayMatches
+	m.liveFetchedAt = time.Now()
 	m.selected = 0
 	m.loading = false
 	cmds = append(cmds, ui.SpinnerTick())
 
-	// Update list
-	m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+	// Update list (first window only - the rest loads as the user scrolls)
+	m.liveLoadedCount = 0
+	m.setLiveListWindow(LiveListPageSize)
 	m.updateLiveListSize()
 
 	if len(displayMatches) > 0 {
@@ -612,20 +1165,49 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 
 	// Schedule the next refresh
 	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	if m.showTicker {
+		cmds = append(cmds, fetchTickerResults(m.fotmobClient, m.useMockData))
+	}
+
+	if msg.err != nil {
+		// Keep showing the matches on screen, marked offline since their fetch; with none
+		// shown, fall back to the last known good snapshot. Already offline, nothing changes.
+		m.warnLog(fmt.Sprintf("Live refresh failed: %v", msg.err))
+		if m.offlineSince.IsZero() {
+			if len(m.matches) > 0 {
+				m.offlineSince = m.liveFetchedAt
+			} else if !m.useMockData {
+				cmds = append(cmds, loadSnapshot(data.SnapshotLive, m.fetchGen))
+			}
+		}
+		return m, tea.Batch(cmds...)
+	}
+
+	// Back online: fresh data replaces the snapshot, even when nothing is live
+	m.offlineSince = time.Time{}
+	m.liveFetchedAt = time.Now()
 
 	if len(msg.matches) == 0 {
 		// No live matches - clear list but keep view
 		m.matches = nil
+		m.liveLoadedCount = 0
 		m.liveMatchesList.SetItems(nil)
 		return m, tea.Batch(cmds...)
 	}
 
-	// Convert to display format
+	if !m.useMockData {
+		cmds = append(cmds, saveSnapshot(data.SnapshotLive, data.Snapshot{LiveMatches: msg.matches}))
+	}
+
+	// Convert to display format, keeping the fetch order to re-sort from
+	m.liveMatchesBuffer = msg.matches
 	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
 	for _, match := range msg.matches {
 		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
 	}
 
+	m.sortLiveMatches(displayMatches)
+
 	// Preserve current selection if possible
 	currentMatchID := 0
 	if m.selected >= 0 && m.selected < len(m.matches) {
@@ -633,8 +1215,6 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 	}
 
 	m.matches = displayMatches
-	m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
-	m.updateLiveListSize()
 
 	// Try to restore previous selection
 	newSelected := 0
@@ -644,6 +1224,10 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 			break
 		}
 	}
+
+	// Keep the loaded window, growing it if the selection moved beyond it
+	m.setLiveListWindow(max(m.liveLoadedCount, newSelected+1))
+	m.updateLiveListSize()
 	m.selected = newSelected
 	m.liveMatchesList.Select(newSelected)
 
@@ -653,8 +1237,21 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 // handleLiveBatchData processes parallel batch loading - multiple leagues at once.
 // Results are shown after each batch completes, giving progressive updates while being fast.
 func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
+	// Drop batches from a fetch that was cancelled or superseded
+	if msg.gen != m.fetchGen {
+		m.debugLog(fmt.Sprintf("Dropping stale live batch %d (gen %d, current %d)", msg.batchIndex, msg.gen, m.fetchGen))
+		return m, nil
+	}
+
 	var cmds []tea.Cmd
 
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Live batch %d failed: %v", msg.batchIndex, msg.err))
+		if m.lastFetchErr == nil {
+			m.lastFetchErr = msg.err
+		}
+	}
+
 	// Accumulate live matches from this batch
 	if len(msg.matches) > 0 {
 		m.liveMatchesBuffer = append(m.liveMatchesBuffer, msg.matches...)
@@ -669,10 +1266,16 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		for _, match := range m.liveMatchesBuffer {
 			displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
 		}
+		m.sortLiveMatches(displayMatches)
 		m.matches = displayMatches
-		m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+		m.setLiveListWindow(max(m.liveLoadedCount, LiveListPageSize))
 		m.updateLiveListSize()
 
+		// Later batches can sort in ahead of the selected match; keep it selected
+		if m.matchDetails != nil {
+			m.selectLiveMatch(m.matchDetails.ID)
+		}
+
 		// On first batch with matches, select first match and load details
 		if msg.batchIndex == 0 || (len(msg.matches) > 0 && m.matchDetails == nil && len(m.matches) > 0) {
 			if m.selected == 0 && m.matchDetails == nil && len(m.matches) > 0 {
@@ -691,9 +1294,24 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		m.liveViewLoading = false
 		m.loading = false
 
+		// Failed batches only matter when they left nothing to show
+		if len(m.liveMatchesBuffer) > 0 {
+			m.lastFetchErr = nil
+			m.liveFetchedAt = time.Now()
+		}
+
 		// Cache the final result
-		if m.fotmobClient != nil && len(m.liveMatchesBuffer) > 0 {
-			m.fotmobClient.Cache().SetLiveMatches(m.liveMatchesBuffer)
+		if cache := m.responseCache(); cache != nil && len(m.liveMatchesBuffer) > 0 {
+			cache.SetLiveMatches(m.liveMatchesBuffer)
+		}
+
+		// Keep the result as the last known good data, or fall back to it when the fetch failed
+		if !m.useMockData {
+			if len(m.liveMatchesBuffer) > 0 {
+				cmds = append(cmds, saveSnapshot(data.SnapshotLive, data.Snapshot{LiveMatches: m.liveMatchesBuffer}))
+			} else if m.lastFetchErr != nil {
+				cmds = append(cmds, loadSnapshot(data.SnapshotLive, m.fetchGen))
+			}
 		}
 
 		// Schedule periodic refresh
@@ -704,7 +1322,7 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 
 	// Otherwise, fetch next batch
 	nextBatchIndex := msg.batchIndex + 1
-	cmds = append(cmds, fetchLiveBatchData(m.fotmobClient, m.useMockData, nextBatchIndex))
+	cmds = append(cmds, fetchLiveBatchData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, nextBatchIndex, m.liveBatchSize, m.liveBatchDelay))
 
 	// Keep spinner running
 	cmds = append(cmds, ui.SpinnerTick())
@@ -712,10 +1330,135 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// liveLoadMoreThreshold is how close (in items) the selection must get to the end
+// of the loaded window before the next window is pushed into the live list.
+const liveLoadMoreThreshold = 3
+
+// jumpToLiveMatch moves the live list selection to the next (delta > 0) or previous (delta < 0)
+// match in progress, wrapping around at the ends, and loads its details.
+// With a filter applied only the filtered matches are considered.
+func (m model) jumpToLiveMatch(delta int) (tea.Model, tea.Cmd) {
+	matches, filtered := m.liveJumpCandidates()
+
+	target := nextLiveMatchIndex(matches, m.liveMatchesList.Index(), delta)
+	if target < 0 {
+		m.transientBanner = constants.StatusBannerNoLiveMatches
+		return m, expireTransientBanner(m.transientBanner)
+	}
+
+	if !filtered && target >= m.liveLoadedCount {
+		m.setLiveListWindow(target + LiveListPageSize)
+	}
+	m.liveMatchesList.Select(target)
+
+	matchID := matches[target].ID
+	if m.matchDetails != nil && m.matchDetails.ID == matchID {
+		return m, nil
+	}
+	for i, match := range m.matches {
+		if match.ID == matchID {
+			m.selected = i
+			break
+		}
+	}
+	return m.loadMatchDetails(matchID)
+}
+
+// liveJumpCandidates returns the matches jumpToLiveMatch picks from, indexed like the live list:
+// the filtered matches when a filter is applied (filtered is true), otherwise all matches.
+func (m model) liveJumpCandidates() (matches []api.Match, filtered bool) {
+	if m.liveMatchesList.FilterState() == list.FilterApplied {
+		for _, item := range m.liveMatchesList.VisibleItems() {
+			if matchItem, ok := item.(ui.MatchListItem); ok {
+				matches = append(matches, matchItem.Match)
+			}
+		}
+		return matches, true
+	}
+
+	// Search the full backing set, not just the loaded window
+	for _, match := range m.matches {
+		matches = append(matches, match.Match)
+	}
+	return matches, false
+}
+
+// startWatchMode starts rotating through the live matches every watchInterval.
+// Restarting invalidates any rotation timer still pending, so only one is ever active.
+func (m model) startWatchMode() (tea.Model, tea.Cmd) {
+	matches, _ := m.liveJumpCandidates()
+	if nextLiveMatchIndex(matches, m.liveMatchesList.Index(), 1) < 0 {
+		m.transientBanner = constants.StatusBannerNoLiveMatches
+		return m, expireTransientBanner(m.transientBanner)
+	}
+
+	m.watchMode = true
+	m.watchGen++
+	return m, scheduleWatchTick(m.watchInterval, m.watchGen)
+}
+
+// stopWatchMode leaves watch mode; its pending rotation tick is dropped.
+func (m *model) stopWatchMode() {
+	if m.watchMode {
+		m.watchMode = false
+		m.watchGen++
+	}
+}
+
+// handleWatchTick moves watch mode on to the next live match and schedules the following rotation.
+// Watch mode stops when no match is live anymore.
+func (m model) handleWatchTick(msg watchTickMsg) (tea.Model, tea.Cmd) {
+	if !m.watchMode || msg.gen != m.watchGen || m.currentView != viewLiveMatches {
+		return m, nil
+	}
+
+	matches, _ := m.liveJumpCandidates()
+	if nextLiveMatchIndex(matches, m.liveMatchesList.Index(), 1) < 0 {
+		m.stopWatchMode()
+		m.transientBanner = constants.StatusBannerNoLiveMatches
+		return m, expireTransientBanner(m.transientBanner)
+	}
+
+	updated, cmd := m.jumpToLiveMatch(1)
+	m = updated.(model)
+	return m, tea.Batch(cmd, scheduleWatchTick(m.watchInterval, m.watchGen))
+}
+
+// nextLiveMatchIndex returns the index of the next live match after from, stepping by
+// the sign of delta and wrapping around. The match at from is only returned if it is
+// the only live one. Returns -1 if no match is live.
+func nextLiveMatchIndex(matches []api.Match, from, delta int) int {
+	n := len(matches)
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+	for i := 1; i <= n; i++ {
+		idx := ((from+step*i)%n + n) % n
+		if matches[idx].Status == api.MatchStatusLive {
+			return idx
+		}
+	}
+	return -1
+}
+
+// setLiveListWindow pushes the first count matches from the backing slice into the live list,
+// followed by a "loading more" sentinel when matches remain. The selection index is preserved.
+func (m *model) setLiveListWindow(count int) {
+	count = min(count, len(m.matches))
+	index := m.liveMatchesList.Index()
+	m.liveLoadedCount = count
+	m.markDisplay(m.matches)
+	m.liveMatchesList.SetItems(ui.ToMatchListItemsWindow(m.matches, count))
+	if index < len(m.liveMatchesList.Items()) {
+		m.liveMatchesList.Select(index)
+	}
+}
+
 // updateLiveListSize sets the live list dimensions based on window size.
 func (m *model) updateLiveListSize() {
 	const spinnerHeight = 3
-	leftWidth := max(m.width*35/100, 25)
+	leftWidth, _ := ui.PanelWidths(m.width, m.listPanelPercent)
 	if m.width == 0 {
 		leftWidth = 40
 	}
@@ -724,7 +1467,7 @@ func (m *model) updateLiveListSize() {
 	frameHeight := 6
 	titleHeight := 3
 	availableWidth := leftWidth - frameWidth
-	availableHeight := m.height - frameHeight - titleHeight - spinnerHeight
+	availableHeight := m.liveViewHeight() - frameHeight - titleHeight - spinnerHeight
 	if m.height == 0 {
 		availableHeight = 20
 	}
@@ -757,7 +1500,7 @@ func (m model) handleStatsData(msg statsDataMsg) (tea.Model, tea.Cmd) {
 
 	// If we have matches, load details for the first one
 	if len(m.matches) > 0 {
-		m.statsMatchesList.Select(0)
+		m.selectStatsMatch(m.matches[0].ID)
 		updatedModel, loadCmd := m.loadStatsMatchDetails(m.matches[0].ID)
 		if updatedM, ok := updatedModel.(model); ok {
 			m = updatedM
@@ -774,14 +1517,28 @@ func (m model) handleStatsData(msg statsDataMsg) (tea.Model, tea.Cmd) {
 // handleStatsDayData processes progressive loading - one day's data at a time.
 // Results are shown immediately as each day completes, giving instant feedback.
 func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
+	// Drop days from a fetch that was cancelled or superseded
+	if msg.gen != m.fetchGen {
+		m.debugLog(fmt.Sprintf("Dropping stale stats day %d (gen %d, current %d)", msg.dayIndex, msg.gen, m.fetchGen))
+		return m, nil
+	}
+
 	var cmds []tea.Cmd
 
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Stats day %d failed: %v", msg.dayIndex, msg.err))
+		if m.lastFetchErr == nil {
+			m.lastFetchErr = msg.err
+		}
+	}
+
 	// Initialize statsData if nil (first day)
 	if m.statsData == nil {
 		m.statsData = &fotmob.StatsData{
 			AllFinished:   []api.Match{},
 			TodayFinished: []api.Match{},
 			TodayUpcoming: []api.Match{},
+			TodayLive:     []api.Match{},
 		}
 	}
 
@@ -834,6 +1591,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 				existingIDs[match.ID] = true
 			}
 		}
+		api.SortByKickoff(m.statsData.TodayUpcoming)
 
 		// Populate liveUpcomingMatches for the live view
 		upcomingDisplay := make([]ui.MatchDisplay, 0, len(m.statsData.TodayUpcoming))
@@ -843,6 +1601,20 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 		m.liveUpcomingMatches = upcomingDisplay
 	}
 
+	// Matches in progress (only from today), for the live only filter
+	if msg.isToday && len(msg.live) > 0 {
+		existingIDs := make(map[int]bool)
+		for _, match := range m.statsData.TodayLive {
+			existingIDs[match.ID] = true
+		}
+		for _, match := range msg.live {
+			if !existingIDs[match.ID] {
+				m.statsData.TodayLive = append(m.statsData.TodayLive, match)
+				existingIDs[match.ID] = true
+			}
+		}
+	}
+
 	// Track progress
 	m.statsDaysLoaded++
 
@@ -853,7 +1625,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 	firstDayWithMatches := msg.dayIndex == 0 && len(m.matches) > 0 && m.matchDetails == nil
 	if firstDayWithMatches {
 		m.selected = 0
-		m.statsMatchesList.Select(0)
+		m.selectStatsMatch(m.matches[0].ID)
 		updatedModel, loadCmd := m.loadStatsMatchDetails(m.matches[0].ID)
 		if updatedM, ok := updatedModel.(model); ok {
 			m = updatedM
@@ -865,12 +1637,35 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 	if msg.isLast {
 		m.statsViewLoading = false
 		m.loading = false
+
+		empty := len(m.statsData.AllFinished) == 0 && len(m.statsData.TodayUpcoming) == 0 && len(m.statsData.TodayLive) == 0
+		if !empty {
+			// Failed days only matter when they left nothing to show
+			m.lastFetchErr = nil
+		}
+
+		// The snapshot is of the window ending today; other windows are neither saved nor replaced by it
+		if !m.useMockData && m.statsAnchor.IsZero() {
+			if !empty {
+				cmds = append(cmds, saveSnapshot(data.SnapshotStats, data.Snapshot{Stats: m.statsData}))
+			} else if m.lastFetchErr != nil {
+				cmds = append(cmds, loadSnapshot(data.SnapshotStats, m.fetchGen))
+			}
+		}
+
+		// Nothing in the whole window (e.g. an international break) - look for the last match day.
+		// An empty window after failed requests says nothing about match days; the error panel shows instead.
+		if empty && m.lastFetchErr == nil && !m.useMockData {
+			m.statsProbing = true
+			dayBefore := m.statsReferenceDay().AddDate(0, 0, -m.statsTotalDays)
+			cmds = append(cmds, findNearestMatchDay(m.fetchCtx, m.fotmobClient, m.fetchGen, dayBefore))
+		}
 		return m, tea.Batch(cmds...)
 	}
 
 	// Otherwise, fetch next day
 	nextDayIndex := msg.dayIndex + 1
-	cmds = append(cmds, fetchStatsDayData(m.fotmobClient, m.useMockData, nextDayIndex, m.statsTotalDays))
+	cmds = append(cmds, fetchStatsDayData(m.fetchCtx, m.fotmobClient, m.useMockData, m.fetchGen, nextDayIndex, m.statsTotalDays, m.statsAnchor))
 
 	// Keep spinner running
 	cmds = append(cmds, ui.SpinnerTick())
@@ -878,26 +1673,111 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// handleSnapshot shows the last known good data of a view whose fetch failed, marking it offline.
+// Without a snapshot the error panel stays up.
+func (m model) handleSnapshot(msg snapshotMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.fetchGen {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.warnLog(fmt.Sprintf("Loading %s snapshot failed: %v", msg.kind, msg.err))
+	}
+	if msg.snapshot == nil {
+		return m, nil
+	}
+
+	switch {
+	case msg.kind == data.SnapshotLive && m.currentView == viewLiveMatches && len(msg.snapshot.LiveMatches) > 0:
+		m.liveMatchesBuffer = msg.snapshot.LiveMatches
+		displayMatches := make([]ui.MatchDisplay, 0, len(m.liveMatchesBuffer))
+		for _, match := range m.liveMatchesBuffer {
+			displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
+		}
+		m.sortLiveMatches(displayMatches)
+		m.matches = displayMatches
+		m.setLiveListWindow(LiveListPageSize)
+		m.updateLiveListSize()
+		m.liveMatchesList.Select(0)
+	case msg.kind == data.SnapshotStats && m.currentView == viewStats && msg.snapshot.Stats != nil:
+		m.statsData = msg.snapshot.Stats
+		m.applyStatsDateFilter()
+		if len(m.matches) > 0 {
+			m.selectStatsMatch(m.matches[0].ID)
+		}
+	default:
+		return m, nil
+	}
+
+	m.warnLog(fmt.Sprintf("Showing %s snapshot from %s after: %v", msg.kind, msg.snapshot.SavedAt.Format(time.RFC3339), m.lastFetchErr))
+	m.lastFetchErr = nil
+	m.offlineSince = msg.snapshot.SavedAt
+	if msg.kind == data.SnapshotLive {
+		m.liveFetchedAt = msg.snapshot.SavedAt
+	}
+	m.selected = 0
+	if len(m.matches) == 0 {
+		return m, nil
+	}
+	if msg.kind == data.SnapshotLive {
+		return m.loadMatchDetails(m.matches[0].ID)
+	}
+	return m.loadStatsMatchDetails(m.matches[0].ID)
+}
+
+// handleNearestMatchDay records the day an empty stats window can jump to with g.
+func (m model) handleNearestMatchDay(msg nearestMatchDayMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.fetchGen {
+		return m, nil
+	}
+
+	m.statsProbing = false
+	if msg.err != nil {
+		if !errors.Is(msg.err, api.ErrNoMatchDay) {
+			m.warnLog(fmt.Sprintf("Nearest match day probe failed: %v", msg.err))
+		}
+		return m, nil
+	}
+	m.statsSuggestedDay = msg.day
+	return m, nil
+}
+
+// statsReferenceDay returns the last day of the stats window: the anchor, or today.
+func (m model) statsReferenceDay() time.Time {
+	if m.statsAnchor.IsZero() {
+		return time.Now()
+	}
+	return m.statsAnchor
+}
+
 // applyStatsDateFilter applies the current date range filter to the cached stats data.
-// This enables instant switching between Today/3d/5d views without new API calls.
-// All filtering is done client-side from the cached 5-day data based on match MatchTime.
+// This enables instant switching between the date ranges without new API calls.
+// All filtering is done client-side from the cached window based on match MatchTime.
 func (m *model) applyStatsDateFilter() {
 	if m.statsData == nil {
 		return
 	}
 
-	// Filter all views from AllFinished based on match's actual MatchTime date
+	// Live only lists today's matches in progress instead, so none when looking at an earlier match day
+	source := m.statsData.AllFinished
+	if m.statsLiveOnly {
+		source = nil
+		if m.statsAnchor.IsZero() {
+			for _, match := range m.statsData.TodayLive {
+				if match.Status == api.MatchStatusLive {
+					source = append(source, match)
+				}
+			}
+		}
+	}
+
+	// Filter all views from the source based on match's actual MatchTime date
 	var finishedMatches []api.Match
-	switch m.statsDateRange {
-	case 1:
-		// Today only - filter by match date
-		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, 1)
-	case 3:
-		// Last 3 days - filter by match date
-		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, 3)
-	default:
-		// 5 days - use all data
-		finishedMatches = m.statsData.AllFinished
+	if m.statsDateRange < m.statsDays {
+		// Today (or the anchor day) and the days before it - filter by match date
+		finishedMatches = filterMatchesByDaysFrom(source, m.statsDateRange, m.statsReferenceDay())
+	} else {
+		// Whole window - use all data
+		finishedMatches = source
 	}
 
 	// Convert to display format
@@ -905,21 +1785,49 @@ func (m *model) applyStatsDateFilter() {
 	for _, match := range finishedMatches {
 		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
 	}
+	m.sortMatches(displayMatches)
+
+	// Keep the selected match selected when it is still listed
+	selectedID := selectedMatchID(m.statsMatchesList)
+
+	items := ui.ToMatchListItems(displayMatches)
+	if m.statsGrouped {
+		// Grouping reorders the matches; keep m.matches in list order
+		items = ui.ToGroupedMatchListItems(displayMatches)
+		displayMatches = displayMatches[:0]
+		for _, item := range items {
+			if match, ok := item.(ui.MatchListItem); ok {
+				displayMatches = append(displayMatches, match.Display)
+			}
+		}
+	}
+
 	m.matches = displayMatches
-	m.statsMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+	m.statsMatchesList.SetItems(items)
+	for i, match := range displayMatches {
+		if match.ID == selectedID {
+			m.selected = i
+			m.selectStatsMatch(match.ID)
+			break
+		}
+	}
 	// Note: Upcoming matches are now shown in the Live view instead
 }
 
 // filterMatchesByDays filters matches to only include those from the last N days.
 // Uses LOCAL time for date comparison so "today" matches user's actual timezone.
 func filterMatchesByDays(matches []api.Match, days int) []api.Match {
+	return filterMatchesByDaysFrom(matches, days, time.Now())
+}
+
+// filterMatchesByDaysFrom is filterMatchesByDays for the N days ending on the day of last.
+func filterMatchesByDaysFrom(matches []api.Match, days int, last time.Time) []api.Match {
 	if days <= 0 {
 		return matches
 	}
 
 	// Use local time so "today" matches the user's actual day
-	now := time.Now().Local()
-	cutoff := now.AddDate(0, 0, -(days - 1)) // Include today as day 1
+	cutoff := last.Local().AddDate(0, 0, -(days - 1)) // Include the last day as day 1
 	cutoffDate := cutoff.Format("2006-01-02")
 
 	var filtered []api.Match
@@ -946,9 +1854,11 @@ func (m model) handleAnimationTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 	}
 
 	// Check if any spinner needs to be animated
-	spinnersActive := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.polling
+	standingsLoading := m.currentView == viewStandings && m.standingsState != nil && m.standingsState.Loading
+	scorersLoading := m.currentView == viewScorers && m.scorersLoading
+	tickerScrolling := m.tickerScrolling()
 
-	if !logoAnimating && !spinnersActive {
+	if !logoAnimating && !m.spinnersActive() {
 		// No animations active - don't continue the tick chain
 		return m, nil
 	}
@@ -966,15 +1876,33 @@ func (m model) handleAnimationTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 		m.statsViewSpinner.Tick()
 	}
 
-	// Update polling spinner when polling is active
-	if m.polling && m.pollingSpinner != nil {
+	if standingsLoading || scorersLoading {
+		m.randomSpinner.Tick()
+	}
+
+	// Update polling spinner when polling or searching for replays
+	if (m.polling || m.replaysSearching) && m.pollingSpinner != nil {
 		m.pollingSpinner.Tick()
 	}
 
+	if tickerScrolling {
+		m.tickerOffset++
+	}
+
 	// Return ONE tick command to continue the animation chain
 	return m, ui.SpinnerTick()
 }
 
+// spinnersActive reports whether a spinner, or the scrolling results ticker, needs the
+// animation tick. Commands starting one only start a tick when none was active, so a
+// single tick chain runs.
+func (m model) spinnersActive() bool {
+	standingsLoading := m.currentView == viewStandings && m.standingsState != nil && m.standingsState.Loading
+	scorersLoading := m.currentView == viewScorers && m.scorersLoading
+	return m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.polling || m.replaysSearching ||
+		standingsLoading || scorersLoading || m.tickerScrolling()
+}
+
 // handleMainViewCheck processes main view check completion and navigates to selected view.
 func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 	m.mainViewLoading = false
@@ -990,7 +1918,7 @@ func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 
 		// If matches already loaded, ensure first match is selected
 		if len(m.matches) > 0 {
-			m.statsMatchesList.Select(0)
+			m.selectStatsMatch(m.matches[0].ID)
 
 			// Load details from cache if available, otherwise start fetch
 			if cached, ok := m.matchDetailsCache[m.matches[0].ID]; ok {
@@ -1047,6 +1975,12 @@ func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Stop polling once the latest fetched status is no longer live
+	if m.matchDetails.Status != api.MatchStatusLive {
+		m.polling = false
+		return m, nil
+	}
+
 	// Set loading state to show "Updating..." spinner
 	m.loading = true
 
@@ -1059,6 +1993,16 @@ func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 	)
 }
 
+// handleLiveClockTick re-renders the estimated live minute and keeps ticking while the
+// clock started by the latest fetch is still the one shown.
+func (m model) handleLiveClockTick(msg liveClockTickMsg) (tea.Model, tea.Cmd) {
+	if !msg.since.Equal(m.liveClockSince) || m.currentView != viewLiveMatches ||
+		m.matchDetails == nil || m.matchDetails.Status != api.MatchStatusLive {
+		return m, nil
+	}
+	return m, scheduleLiveClockTick(msg.since)
+}
+
 // handlePollDisplayComplete hides the spinner after 1s display time.
 func (m model) handlePollDisplayComplete() (tea.Model, tea.Cmd) {
 	// Hide spinner - the 1s visual feedback is complete
@@ -1087,6 +2031,10 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 		if m.settingsState != nil {
 			m.settingsState.List, cmd = m.settingsState.List.Update(msg)
 		}
+	case viewStandings:
+		if m.standingsState != nil {
+			m.standingsState.List, cmd = m.standingsState.List.Update(msg)
+		}
 	}
 
 	return m, cmd
@@ -1152,9 +2100,16 @@ func max(a, b int) int {
 // handleGoalLinks processes goal replay links fetched from Reddit.
 func (m model) handleGoalLinks(msg goalLinksMsg) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("handleGoalLinks called for match %d with %d links", msg.matchID, len(msg.links)))
+	next := tea.Batch(msg.next, m.scheduleReplaysLimitExpiry())
 	if len(msg.links) == 0 {
-		m.debugLog(fmt.Sprintf("GoalLinks completed for match %d: no links found", msg.matchID))
-		return m, nil
+		m.debugLog(fmt.Sprintf("GoalLinks completed for match %d", msg.matchID))
+		if msg.refresh && m.replaysSearching {
+			// Report the manual search, see refreshReplays
+			m.replaysSearching = false
+			m.transientBanner = constants.StatusBannerReplaysFound
+			return m, tea.Batch(next, expireTransientBanner(m.transientBanner))
+		}
+		return m, next
 	}
 
 	m.debugLog(fmt.Sprintf("GoalLinks completed for match %d: processing %d links", msg.matchID, len(msg.links)))
@@ -1168,8 +2123,11 @@ func (m model) handleGoalLinks(msg goalLinksMsg) (tea.Model, tea.Cmd) {
 	failedLinks := 0
 
 	for key, link := range msg.links {
+		if msg.refresh && m.replaysSearching && isReplayLink(link) && !isReplayLink(m.goalLinks[key]) {
+			m.replaysFound++
+		}
 		m.goalLinks[key] = link
-		if link != nil && link.URL != "" && link.URL != "__NOT_FOUND__" {
+		if isReplayLink(link) {
 			validLinks++
 			m.debugLog(fmt.Sprintf("Cached goal link: %d:%d → %s (post: %s)", key.MatchID, key.Minute, link.URL, link.PostURL))
 		} else if link != nil && link.URL == "__NOT_FOUND__" {
@@ -1178,135 +2136,52 @@ func (m model) handleGoalLinks(msg goalLinksMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	m.debugLog(fmt.Sprintf("Goal link batch complete: %d valid, %d failed", validLinks, failedLinks))
+	m.debugLog(fmt.Sprintf("Goal link update: %d valid, %d failed", validLinks, failedLinks))
 
-	return m, nil
+	// Keep listening for the next resolved link
+	return m, next
 }
 
-// debugLog writes debug messages to a log file without interfering with the UI
-// Only writes when debug mode is enabled. Implements log rotation to prevent excessive growth.
-func (m model) debugLog(message string) {
-	if !m.debugMode {
-		return // Silently skip if debug mode is not enabled
-	}
-
-	configDir, err := data.ConfigDir()
-	if err != nil {
-		return // Silently fail if we can't get config dir
-	}
-
-	logFile := filepath.Join(configDir, "golazo_debug.log")
-
-	// Check file size and rotate if necessary
-	if err := m.rotateDebugLogIfNeeded(logFile); err != nil {
-		return // Silently fail if rotation fails
-	}
-
-	f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return // Silently fail if we can't open log file
-	}
-	defer func() { _ = f.Close() }()
-
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
-	logLine := fmt.Sprintf("[%s] %s\n", timestamp, message)
-	_, _ = f.WriteString(logLine)
+// isReplayLink reports whether a goal link holds a replay URL, not a "not found" marker.
+func isReplayLink(link *reddit.GoalLink) bool {
+	return link != nil && link.URL != "" && link.URL != reddit.NotFoundMarker
 }
 
-// rotateDebugLogIfNeeded rotates the debug log file when it exceeds size limits
-// Keeps up to 3 rotated files and limits current log to ~1000 lines
-func (m model) rotateDebugLogIfNeeded(logFile string) error {
-	const maxSize = 10 * 1024 * 1024 // 10MB
-	const maxLines = 1000            // Keep ~1000 recent lines
-	const maxRotatedFiles = 3
-
-	// Check if file exists and get its size
-	info, err := os.Stat(logFile)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil // File doesn't exist, no rotation needed
-		}
-		return err
-	}
-
-	fileSize := info.Size()
-
-	// If file is too large, rotate it
-	if fileSize > maxSize {
-		// Create rotated filename with timestamp
-		timestamp := time.Now().Format("2006-01-02_15-04-05")
-		rotatedFile := logFile + "." + timestamp + ".bak"
-
-		// Rename current log to rotated file
-		if err := os.Rename(logFile, rotatedFile); err != nil {
-			return err
-		}
-
-		// Clean up old rotated files (keep only maxRotatedFiles)
-		m.cleanupOldRotatedLogs(logFile, maxRotatedFiles)
-	}
-
-	// Always trim current log to maxLines to prevent immediate regrowth
-	return m.trimDebugLogToMaxLines(logFile, maxLines)
+// scheduleReplaysLimitExpiry schedules a redraw for when Reddit's block cooldown ends,
+// so the replays limited banner clears. Each cooldown is scheduled once.
+func (m *model) scheduleReplaysLimitExpiry() tea.Cmd {
+	if m.redditClient == nil {
+		return nil
+	}
+	until := m.redditClient.BlockedUntil()
+	if !until.After(time.Now()) || until.Equal(m.replaysLimitedUntil) {
+		return nil
+	}
+	m.warnLog(fmt.Sprintf("Reddit is blocking replay lookups until %s", until.Format("15:04:05")))
+	m.replaysLimitedUntil = until
+	return tea.Tick(time.Until(until), func(time.Time) tea.Msg {
+		return replaysLimitExpiredMsg{}
+	})
 }
 
-// cleanupOldRotatedLogs removes old rotated log files, keeping only the most recent ones
-func (m model) cleanupOldRotatedLogs(logFile string, maxFiles int) {
-	pattern := logFile + ".*.bak"
-	matches, err := filepath.Glob(pattern)
-	if err != nil {
-		return
-	}
-
-	// Sort by modification time (newest first)
-	type fileInfo struct {
-		path    string
-		modTime time.Time
-	}
-	var files []fileInfo
-	for _, match := range matches {
-		info, err := os.Stat(match)
-		if err != nil {
-			continue
-		}
-		files = append(files, fileInfo{match, info.ModTime()})
-	}
+// debugLog records a DEBUG line, see log.
+func (m model) debugLog(message string) { m.log(logging.LevelDebug, message) }
 
-	// Sort by modification time (newest first)
-	for i := 0; i < len(files)-1; i++ {
-		for j := i + 1; j < len(files); j++ {
-			if files[i].modTime.Before(files[j].modTime) {
-				files[i], files[j] = files[j], files[i]
-			}
-		}
-	}
+// infoLog records an INFO line, see log.
+func (m model) infoLog(message string) { m.log(logging.LevelInfo, message) }
 
-	// Remove files beyond maxFiles
-	for i := maxFiles; i < len(files); i++ {
-		_ = os.Remove(files[i].path)
-	}
-}
+// warnLog records a WARN line, see log.
+func (m model) warnLog(message string) { m.log(logging.LevelWarn, message) }
 
-// trimDebugLogToMaxLines keeps only the last maxLines lines in the log file
-func (m model) trimDebugLogToMaxLines(logFile string, maxLines int) error {
-	content, err := os.ReadFile(logFile)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil // File doesn't exist, nothing to trim
-		}
-		return err
-	}
+// errorLog records an ERROR line, see log.
+func (m model) errorLog(message string) { m.log(logging.LevelError, message) }
 
-	lines := strings.Split(string(content), "\n")
-	if len(lines) <= maxLines+1 { // +1 for potential empty line at end
-		return nil // No trimming needed
+// log records a message in the in-app debug buffer (always) and the log file (when logging is on).
+func (m model) log(level logging.Level, message string) {
+	if m.debugBuffer != nil {
+		m.debugBuffer.Add(time.Now().Format("15:04:05") + " " + level.String() + " " + message)
 	}
-
-	// Keep only the last maxLines lines
-	lines = lines[len(lines)-maxLines-1:]
-
-	// Write back the trimmed content
-	return os.WriteFile(logFile, []byte(strings.Join(lines, "\n")), 0644)
+	m.logger.Log(level, message)
 }
 
 // GoalReplayURL returns the replay URL for a goal if available.
@@ -1350,6 +2225,65 @@ func (m *model) openFormationsDialog() {
 	m.dialogOverlay.OpenDialog(dialog)
 }
 
+// handleLeagueTable caches a fetched league table for the session.
+// Failed fetches aren't cached so the league can be retried by switching back to it.
+func (m model) handleLeagueTable(msg leagueTableMsg) (tea.Model, tea.Cmd) {
+	if len(msg.standings) > 0 {
+		m.leagueTables[msg.leagueID] = msg.standings
+		// The table view renders the zones of its league list entry
+		m.warnLeagueZones(msg.leagueID, data.LeagueZones(msg.leagueID), len(msg.standings))
+	} else {
+		m.debugLog(fmt.Sprintf("handleLeagueTable: no standings for league %d", msg.leagueID))
+	}
+
+	// Only stop the loading state if the table is for the league currently shown
+	if m.standingsState != nil {
+		if league, ok := m.standingsState.CurrentLeague(); ok && league.ID == msg.leagueID {
+			m.standingsState.Loading = false
+		}
+	}
+
+	return m, nil
+}
+
+// leagueZones returns the table zones of a league to render its table of teams places with,
+// see warnLeagueZones.
+func (m model) leagueZones(leagueID, teams int) data.TableZones {
+	zones := data.LeagueZones(leagueID)
+	m.warnLeagueZones(leagueID, zones, teams)
+	return zones
+}
+
+// warnLeagueZones logs a warning when a league's table zones don't fit its table of teams places.
+func (m model) warnLeagueZones(leagueID int, zones data.TableZones, teams int) {
+	if err := zones.Validate(teams); err != nil {
+		m.warnLog(fmt.Sprintf("League %d: %v", leagueID, err))
+	}
+}
+
+// handleScorerDetails caches a chunk of match details for the top scorers view,
+// re-aggregates the scorers and fetches the next chunk while the view is open.
+func (m model) handleScorerDetails(msg scorerDetailsMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.scorersGen {
+		return m, nil
+	}
+
+	for matchID, details := range msg.details {
+		if details != nil {
+			m.matchDetailsCache[matchID] = details
+		}
+	}
+	// Failed fetches count as loaded so progress still reaches the total
+	m.scorersLoaded += len(msg.details)
+	m.scorers = ui.AggregateScorers(m.scorerMatchDetails())
+
+	if len(m.scorersPending) == 0 {
+		m.scorersLoading = false
+		return m, nil
+	}
+	return m, m.fetchNextScorerDetails()
+}
+
 // handleStandings processes standings data and opens the standings dialog.
 func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("handleStandings: received msg with %d standings, leagueID=%d, leagueName=%s",
@@ -1370,6 +2304,7 @@ func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 		msg.standings,
 		msg.homeTeamID,
 		msg.awayTeamID,
+		m.leagueZones(msg.leagueID, len(msg.standings)),
 	)
 	m.dialogOverlay.OpenDialog(dialog)
 	m.debugLog(fmt.Sprintf("handleStandings: dialog opened, HasDialogs=%v", m.dialogOverlay.HasDialogs()))
@@ -1377,6 +2312,37 @@ func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// openTeamStats opens the season record of the current match's home or away team,
+// fetching it unless it was already loaded this session.
+func (m model) openTeamStats(home bool) (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return m, nil
+	}
+	team := m.matchDetails.AwayTeam
+	if home {
+		team = m.matchDetails.HomeTeam
+	}
+	if stats, ok := m.teamStatsCache[team.ID]; ok {
+		m.dialogOverlay.OpenDialog(ui.NewTeamStatsDialog(team.Name, stats))
+		return m, nil
+	}
+	return m, fetchTeamStats(m.fotmobClient, team, m.useMockData)
+}
+
+// handleTeamStats caches a team's season record and opens the team stats dialog.
+func (m model) handleTeamStats(msg teamStatsMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil || msg.stats == nil {
+		m.warnLog(fmt.Sprintf("Team stats for %s unavailable: %v", msg.team.Name, msg.err))
+		m.transientBanner = constants.StatusBannerNoTeamStats
+		return m, expireTransientBanner(m.transientBanner)
+	}
+	m.teamStatsCache[msg.team.ID] = msg.stats
+	if m.dialogOverlay != nil {
+		m.dialogOverlay.OpenDialog(ui.NewTeamStatsDialog(msg.team.Name, msg.stats))
+	}
+	return m, nil
+}
+
 // openStatisticsDialog opens the full statistics dialog for the current match.
 func (m *model) openStatisticsDialog() {
 	if m.matchDetails == nil || m.dialogOverlay == nil {
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestNextLiveMatchIndex(t *testing.T) {
+	matches := []api.Match{
+		{ID: 1, Status: api.MatchStatusFinished},
+		{ID: 2, Status: api.MatchStatusLive},
+		{ID: 3, Status: api.MatchStatusNotStarted},
+		{ID: 4, Status: api.MatchStatusLive},
+		{ID: 5, Status: api.MatchStatusFinished},
+	}
+
+	tests := []struct {
+		from, delta, want int
+	}{
+		{0, 1, 1},
+		{1, 1, 3},
+		{3, 1, 1}, // Wraps forward
+		{3, -1, 1},
+		{1, -1, 3}, // Wraps backward
+		{4, -1, 3},
+	}
+	for _, tt := range tests {
+		if got := nextLiveMatchIndex(matches, tt.from, tt.delta); got != tt.want {
+			t.Errorf("nextLiveMatchIndex(from=%d, delta=%d) = %d, want %d", tt.from, tt.delta, got, tt.want)
+		}
+	}
+
+	if got := nextLiveMatchIndex(matches[:1], 0, 1); got != -1 {
+		t.Errorf("nextLiveMatchIndex() with no live matches = %d, want -1", got)
+	}
+	if got := nextLiveMatchIndex(nil, 0, 1); got != -1 {
+		t.Errorf("nextLiveMatchIndex() on empty list = %d, want -1", got)
+	}
+}
+
+func TestCapLiveUpdates(t *testing.T) {
+	updates := []string{"90'", "75'", "40'", "12'"}
+
+	if got, want := capLiveUpdates(updates, 2), []string{"90'", "75'"}; !slices.Equal(got, want) {
+		t.Errorf("capLiveUpdates(2) = %v, want %v", got, want)
+	}
+	if got := capLiveUpdates(updates, 10); !slices.Equal(got, updates) {
+		t.Errorf("capLiveUpdates(10) = %v, want %v", got, updates)
+	}
+	if got := capLiveUpdates(updates, 0); !slices.Equal(got, updates) {
+		t.Errorf("capLiveUpdates(0) = %v, want all updates", got)
+	}
+}
@@ -1,18 +1,30 @@
 package app
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"net"
+	"time"
 
+	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/ui/logo"
+	"github.com/charmbracelet/bubbles/list"
 )
 
 // View renders the current application state.
 func (m model) View() string {
-	// DEBUG: Log that view is being called
-	m.debugLog(fmt.Sprintf("VIEW: View() called, currentView=%v, width=%d, height=%d, matchDetails=%v", m.currentView, m.width, m.height, m.matchDetails != nil))
+	// DEBUG: Log that view is being called (file only - runs every frame)
+	m.logger.Debugf("VIEW: View() called, currentView=%v, width=%d, height=%d, matchDetails=%v", m.currentView, m.width, m.height, m.matchDetails != nil)
 	if m.matchDetails != nil {
-		m.debugLog(fmt.Sprintf("VIEW: matchDetails ID=%d, Status=%s, Highlights=%v", m.matchDetails.ID, m.matchDetails.Status, m.matchDetails.Highlight != nil))
+		m.logger.Debugf("VIEW: matchDetails ID=%d, Status=%s, Highlights=%v", m.matchDetails.ID, m.matchDetails.Status, m.matchDetails.Highlight != nil)
+	}
+
+	// Debug log viewer is drawn over everything, including dialogs
+	if m.debugViewOpen {
+		m.syncDebugViewport()
+		return ui.RenderDebugView(m.width, m.height, m.debugViewport, len(m.debugBuffer.Lines()), m.getStatusBannerType())
 	}
 
 	// If dialog overlay has active dialogs, render dialog on top
@@ -22,56 +34,69 @@ func (m model) View() string {
 
 	switch m.currentView {
 	case viewMain:
-		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.animatedLogo)
+		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.logoView(), m.todaySummary, m.todaySummaryLoading)
 
 	case viewLiveMatches:
+		if m.showingFetchError() {
+			return ui.RenderFetchErrorView(m.width, m.height, constants.PanelLiveMatches, m.lastFetchErr, isNetworkError(m.lastFetchErr), m.getStatusBannerType())
+		}
+		if m.focusedMatch && m.matchDetails != nil {
+			return ui.RenderFocusedMatchView(m.width, m.height, m.focusedMatchConfig())
+		}
 		m.ensureLiveListSize()
-		return ui.RenderMultiPanelViewWithList(
-			m.width, m.height,
-			m.liveMatchesList,
-			m.matchDetails,
-			m.liveUpdates,
-			m.spinner,
-			m.loading,
-			m.randomSpinner,
-			m.liveViewLoading,
-			m.liveBatchesLoaded,
-			m.liveTotalBatches,
-			m.pollingSpinner,
-			m.polling,
-			m.liveUpcomingMatches,
-			m.buildGoalLinksMap(),
-			m.getStatusBannerType(),
-		)
+		view := ui.RenderMultiPanelViewWithList(m.width, m.liveViewHeight(), m.liveViewConfig())
+		if m.tickerShown() {
+			ticker := ui.RenderResultsTicker(m.width, ui.ResultsTickerText(m.tickerResults, m.spoilers()), m.tickerOffset)
+			view += "\n" + ticker
+		}
+		return view
 
 	case viewStats:
+		if m.showingFetchError() {
+			return ui.RenderFetchErrorView(m.width, m.height, constants.PanelFinishedMatches, m.lastFetchErr, isNetworkError(m.lastFetchErr), m.getStatusBannerType())
+		}
 		m.ensureStatsListSize()
-		spinner := m.ensureStatsSpinner()
-		return ui.RenderStatsViewWithList(
-			m.width, m.height,
-			m.statsMatchesList,
-			m.matchDetails,
-			spinner,
-			m.statsViewLoading,
-			m.statsDateRange,
-			m.statsDaysLoaded,
-			m.statsTotalDays,
-			m.buildGoalLinksMap(),
-			m.getStatusBannerType(),
-			&m.statsDetailsViewport,
-			m.statsRightPanelFocused,
-			m.statsScrollOffset,
-		)
+		return ui.RenderStatsViewWithList(m.width, m.height, m.statsViewConfig())
 
 	case viewSettings:
 		return ui.RenderSettingsView(m.width, m.height, m.settingsState, m.getStatusBannerType())
 
+	case viewStandings:
+		return ui.RenderStandingsView(m.width, m.height, m.standingsState, m.leagueTables, m.randomSpinner, m.getStatusBannerType())
+
+	case viewScorers:
+		return ui.RenderScorersView(m.width, m.height, m.scorers, m.scorersScroll, m.scorersLoaded, m.scorersTotal, m.scorersLoading, m.randomSpinner, m.getStatusBannerType())
+
 	default:
-		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.animatedLogo)
+		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.logoView(), m.todaySummary, m.todaySummaryLoading)
 	}
 }
 
+// showingFetchError reports whether the current view's fetch failed with nothing to show,
+// in which case an error panel replaces the empty list.
+func (m model) showingFetchError() bool {
+	if m.lastFetchErr == nil {
+		return false
+	}
+	switch m.currentView {
+	case viewLiveMatches:
+		return !m.liveViewLoading && len(m.matches) == 0
+	case viewStats:
+		return !m.statsViewLoading && len(m.matches) == 0
+	}
+	return false
+}
+
+// isNetworkError reports whether err means FotMob could not be reached at all
+// (no connection, DNS failure, timeout) rather than answering with an error.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
 // ensureLiveListSize ensures list dimensions are set before rendering.
+// The size is in rows: the list fits as many matches as the delegate's item height allows,
+// so compact (one line) and regular rows share the same math.
 func (m *model) ensureLiveListSize() {
 	if m.width <= 0 || m.height <= 0 {
 		return
@@ -84,9 +109,9 @@ func (m *model) ensureLiveListSize() {
 		spinnerHeight = 3
 	)
 
-	leftWidth := max(m.width*35/100, 25)
+	leftWidth, _ := ui.PanelWidths(m.width, m.listPanelPercent)
 	availableWidth := leftWidth - frameH*2
-	availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight
+	availableHeight := m.liveViewHeight() - frameV*2 - titleHeight - spinnerHeight
 
 	if availableWidth > 0 && availableHeight > 0 {
 		m.liveMatchesList.SetSize(availableWidth, availableHeight)
@@ -94,6 +119,7 @@ func (m *model) ensureLiveListSize() {
 }
 
 // ensureStatsListSize ensures stats list dimensions are set before rendering.
+// Like ensureLiveListSize, the rows per match come from the list delegate.
 func (m *model) ensureStatsListSize() {
 	if m.width <= 0 || m.height <= 0 {
 		return
@@ -108,7 +134,7 @@ func (m *model) ensureStatsListSize() {
 		selectorHeight = 2 // Date selector + spacing
 	)
 
-	leftWidth := max(m.width*40/100, 30)
+	leftWidth, _ := ui.PanelWidths(m.width, m.listPanelPercent)
 	availableWidth := leftWidth - frameH*2
 	availableHeight := m.height - frameV*2 - titleHeight - spinnerHeight - headerHeight - selectorHeight
 
@@ -146,3 +172,110 @@ func (m *model) buildGoalLinksMap() ui.GoalLinksMap {
 
 // Ensure reddit.GoalLinkKey is used (avoid unused import)
 var _ reddit.GoalLinkKey
+
+// logoView returns the main menu logo: the current animation frame, or the static
+// logo when the animation is disabled. Both have the same dimensions.
+func (m model) logoView() string {
+	if m.animatedLogo == nil {
+		return logo.Render(m.appVersion, false, logo.DefaultOpts())
+	}
+	return m.animatedLogo.View()
+}
+
+// watchIndicatorInterval returns the watch mode rotation interval to show in the live view,
+// or zero when watch mode is off.
+func (m model) watchIndicatorInterval() time.Duration {
+	if !m.watchMode {
+		return 0
+	}
+	return m.watchInterval
+}
+
+// awaitingDetails reports whether the details panel is empty while the details of the
+// match selected in l are being fetched, so it can say so instead of asking for a selection.
+func (m model) awaitingDetails(l list.Model) bool {
+	return m.matchDetails == nil && m.loadingMatchID != 0 && m.loadingMatchID == selectedMatchID(l)
+}
+
+// focusedMatchConfig returns what the full-screen match view shows of the shown match.
+func (m model) focusedMatchConfig() ui.FocusedMatchConfig {
+	return ui.FocusedMatchConfig{
+		Details:          m.matchDetails,
+		ScoreHidden:      m.detailsScoreHidden(),
+		LiveUpdates:      m.displayedLiveUpdates(),
+		UpdatesCollapsed: m.updatesCollapsed,
+		LiveClockSince:   m.liveClockSince,
+		PollingSpinner:   m.pollingSpinner,
+		IsPolling:        m.polling || m.replaysSearching,
+		Loading:          m.loading || m.replaysSearching,
+		GoalLinks:        m.buildGoalLinksMap(),
+		PlayerPhotos:     m.playerPhotos,
+		ScrollOffset:     m.focusedScroll,
+		FillAway:         m.statBarsFillAway,
+		BannerType:       m.getStatusBannerType(),
+		ReplaysFound:     m.replaysFound,
+	}
+}
+
+// liveViewConfig collects the state the live matches view renders.
+func (m model) liveViewConfig() ui.LiveViewConfig {
+	return ui.LiveViewConfig{
+		List:             m.liveMatchesList,
+		ListPanelPercent: m.listPanelPercent,
+		UpcomingMatches:  m.liveUpcomingMatches,
+		Details:          m.matchDetails,
+		ScoreHidden:      m.detailsScoreHidden(),
+		DetailsLoading:   m.awaitingDetails(m.liveMatchesList),
+		LiveUpdates:      m.displayedLiveUpdates(),
+		UpdatesCollapsed: m.updatesCollapsed,
+		LiveClockSince:   m.liveClockSince,
+		PollingSpinner:   m.pollingSpinner,
+		IsPolling:        m.polling || m.replaysSearching,
+		Loading:          m.loading || m.replaysSearching,
+		GoalLinks:        m.buildGoalLinksMap(),
+		PlayerPhotos:     m.playerPhotos,
+		RandomSpinner:    m.randomSpinner,
+		ViewLoading:      m.liveViewLoading,
+		LeaguesLoaded:    m.liveBatchesLoaded,
+		TotalLeagues:     m.liveTotalBatches,
+		WatchInterval:    m.watchIndicatorInterval(),
+		OfflineSince:     m.offlineSince,
+		BannerType:       m.getStatusBannerType(),
+		ReplaysFound:     m.replaysFound,
+	}
+}
+
+// statsViewConfig collects the state the stats view renders.
+func (m model) statsViewConfig() ui.StatsViewConfig {
+	return ui.StatsViewConfig{
+		List:             m.statsMatchesList,
+		ListPanelPercent: m.listPanelPercent,
+		DateRange:        m.statsDateRange,
+		DayHint: ui.StatsDayHint{
+			Anchor:    m.statsAnchor,
+			Searching: m.statsProbing,
+			Suggested: m.statsSuggestedDay,
+			LiveOnly:  m.statsLiveOnly,
+		},
+		Details:           m.matchDetails,
+		ScoreHidden:       m.detailsScoreHidden(),
+		DetailsLoading:    m.awaitingDetails(m.statsMatchesList),
+		GoalLinks:         m.buildGoalLinksMap(),
+		PlayerPhotos:      m.playerPhotos,
+		RightPanelFocused: m.statsRightPanelFocused,
+		ScrollOffset:      m.statsScrollOffset,
+		ShowTimeline:      m.statsShowTimeline,
+		ShowEvents:        m.statsShowEvents,
+		EventsList:        m.statsEventsList,
+		EventsFilter:      m.statsEventsFilter,
+		MinuteRange:       m.statsMinuteRange,
+		FillAway:          m.statBarsFillAway,
+		RandomSpinner:     m.ensureStatsSpinner(),
+		ViewLoading:       m.statsViewLoading,
+		DaysLoaded:        m.statsDaysLoaded,
+		TotalDays:         m.statsTotalDays,
+		OfflineSince:      m.offlineSince,
+		BannerType:        m.getStatusBannerType(),
+		ReplaysFound:      m.replaysFound,
+	}
+}
@@ -0,0 +1,59 @@
+// Package clipboard writes text to the system clipboard using platform tools.
+// Supports pbcopy (macOS), clip.exe (Windows/WSL) and wl-copy/xclip/xsel (Linux).
+package clipboard
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnavailable is returned when no clipboard tool is available on the system.
+var ErrUnavailable = errors.New("no clipboard tool available")
+
+// command is a clipboard tool invocation that reads the text from stdin.
+type command struct {
+	name string
+	args []string
+}
+
+// candidates returns the clipboard tools to try for the current platform, in order.
+func candidates() []command {
+	switch runtime.GOOS {
+	case "darwin":
+		return []command{{name: "pbcopy"}}
+	case "windows":
+		return []command{{name: "clip.exe"}}
+	default:
+		return []command{
+			{name: "wl-copy"},
+			{name: "xclip", args: []string{"-selection", "clipboard"}},
+			{name: "xsel", args: []string{"--clipboard", "--input"}},
+			{name: "clip.exe"}, // WSL
+		}
+	}
+}
+
+// find returns the first available clipboard tool.
+func find() (command, bool) {
+	for _, c := range candidates() {
+		if _, err := exec.LookPath(c.name); err == nil {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+// Write copies text to the system clipboard.
+// Returns ErrUnavailable (without side effects) if no clipboard tool is installed.
+func Write(text string) error {
+	c, ok := find()
+	if !ok {
+		return ErrUnavailable
+	}
+
+	cmd := exec.Command(c.name, c.args...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
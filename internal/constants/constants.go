@@ -18,4 +18,47 @@ const (
 	StatusBannerNewVersion
 	// StatusBannerDev indicates this is a development build.
 	StatusBannerDev
+	// StatusBannerCopied indicates a match summary was just copied to the clipboard.
+	StatusBannerCopied
+	// StatusBannerNoClipboard indicates copying failed because no clipboard tool is available.
+	StatusBannerNoClipboard
+	// StatusBannerCopyFailed indicates the clipboard tool failed to copy the match summary.
+	StatusBannerCopyFailed
+	// StatusBannerOpenedPage indicates the match page was just opened in the browser.
+	StatusBannerOpenedPage
+	// StatusBannerNoBrowser indicates the match page couldn't be opened in a browser.
+	StatusBannerNoBrowser
+	// StatusBannerNoLiveMatches indicates a jump to the next live match found none in the list.
+	StatusBannerNoLiveMatches
+	// StatusBannerSortKickoff indicates the match lists were just sorted by kickoff time.
+	StatusBannerSortKickoff
+	// StatusBannerSortLeague indicates the match lists were just sorted by league, then kickoff time.
+	StatusBannerSortLeague
+	// StatusBannerSortHomeTeam indicates the match lists were just sorted by home team.
+	StatusBannerSortHomeTeam
+	// StatusBannerCommentaryOn indicates the live updates now show the full text commentary.
+	StatusBannerCommentaryOn
+	// StatusBannerCommentaryOff indicates the live updates are back to match events only.
+	StatusBannerCommentaryOff
+	// StatusBannerReplaysLimited indicates Reddit is blocking replay link lookups for a while.
+	StatusBannerReplaysLimited
+	// StatusBannerSpoilersOn indicates scores are now hidden until a match is revealed.
+	StatusBannerSpoilersOn
+	// StatusBannerSpoilersOff indicates scores are shown again.
+	StatusBannerSpoilersOff
+	// StatusBannerFavoritesOn indicates favorite teams' matches are now listed first.
+	StatusBannerFavoritesOn
+	// StatusBannerFavoritesOff indicates the match lists are back to the plain sort order.
+	StatusBannerFavoritesOff
+	// StatusBannerNoFavorites indicates the favorites sort was asked for without favorite teams set.
+	StatusBannerNoFavorites
+	// StatusBannerSearchingReplays indicates a manual search for the shown match's replay links is running.
+	StatusBannerSearchingReplays
+	// StatusBannerNoTeamStats indicates a team's season record couldn't be fetched.
+	StatusBannerNoTeamStats
+	// StatusBannerReplaysFound reports how many replay links a manual search found.
+	StatusBannerReplaysFound
 )
+
+// TransientBannerDuration is how long short-lived banners (e.g. "copied!") stay visible.
+const TransientBannerDuration = 2 * time.Second
@@ -4,6 +4,7 @@ package constants
 const (
 	MenuStats       = "Finished Matches"
 	MenuLiveMatches = "Live Matches"
+	MenuStandings   = "League Tables"
 	MenuSettings    = "Settings"
 )
 
@@ -14,10 +15,18 @@ const (
 	PanelMatchDetails      = "Match Details"
 	PanelMatchList         = "Match List"
 	PanelUpcomingMatches   = "Upcoming Matches"
+	PanelAwaitingKickoff   = "Awaiting Kickoff"
+	AdvancesMarker         = "➤ advances" // Next to the team through a decided two-legged tie
 	PanelMinuteByMinute    = "Minute-by-minute"
 	PanelMatchStatistics   = "Match Statistics"
 	PanelUpdates           = "Updates"
+	PanelUpdatesCount      = " (%d updates)" // Appended to the updates title while collapsed
 	PanelLeaguePreferences = "League Preferences"
+	PanelLeagueTables      = "League Tables"
+	PanelDebugLog          = "Debug Log"
+	PanelTopScorers        = "Top Scorers"
+	PanelKeyboardShortcuts = "Keyboard Shortcuts"
+	PanelMatchJSON         = "Match JSON"
 )
 
 // Empty state messages
@@ -27,21 +36,62 @@ const (
 	EmptySelectMatch       = "Select a match"
 	EmptyNoUpdates         = "No updates"
 	EmptyNoMatches         = "No matches available"
+	EmptyNoEvents          = "No events"
+	EmptyNoLeagues         = "No tracked leagues"
+	EmptyNoDebugLines      = "No debug output yet"
+	EmptyNoMatchesToday    = "No matches today"
+	EmptyNoScorers         = "No goals scored"
+	SpoilerHiddenNote      = "Goals and cards hidden (spoiler mode) · v: reveal"
 )
 
 // Help text
 const (
-	HelpMainMenu           = "↑/↓: navigate  Enter: select  q: quit"
-	HelpMatchesView        = "↑/↓: navigate  r: refresh details  /: filter  Esc: back  q: quit"
-	HelpSettingsView       = "↑/↓: navigate  ←/→: switch tabs  Space: toggle  /: filter  Enter: save  Esc: back"
-	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh details  /: filter  Esc: back"
-	HelpStatsViewUnfocused = "Tab: focus details"
-	HelpStatsViewFocused   = "Tab: unfocus  s: standings  f: formations  x: all statistics  ↑/↓: scroll"
+	HelpMainMenu           = "↑/↓: navigate  Enter: select  ?: help  q: quit"
+	HelpMatchesView        = "↑/↓: navigate  Enter: focus match  n/N: next/prev live  w: watch  c: commentary  u: collapse updates  s: sort  F: favorites first  p: pin  z/v: spoilers/reveal  r: refresh details  P: search replays  </>: panel widths  T: results ticker  y: copy summary  O: open on FotMob  /: filter  ?: help  Esc: back  q: quit"
+	HelpSettingsView       = "↑/↓: navigate  ←/→: switch tabs  Space: toggle  a: logo animation  /: filter  Enter: save  Esc: back"
+	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh details  t: timeline  L: live only  R: refresh all  p: scorers  s: sort  F: favorites first  z/v: spoilers/reveal  g: last match day  [/]: prev/next day  P: search replays  </>: panel widths  y: copy summary  O: open on FotMob  /: filter  ?: help  Esc: back"
+	HelpStatsViewUnfocused = "Tab: focus details  t: timeline  R: refresh all  p: scorers  s: sort  y: copy"
+	HelpStatsViewFocused   = "Tab: unfocus  s: standings  H/A: home/away season  f: formations  x: all statistics  t: timeline  e: events  m/M: minutes  c: all minutes  ↑/↓: scroll  ctrl+u/d pgup/pgdn: page  g/G: top/bottom"
 	HelpStandingsDialog    = "Esc: close"
+	HelpTeamStatsDialog    = "Esc: close"
+	HelpStandingsPicker    = "↑/↓: navigate  Enter: show table  /: filter  Esc: back"
+	HelpStandingsView      = "j/k: scroll  h/l: switch league  Esc: leagues"
+	HelpScorersView        = "j/k: scroll  Esc: back"
+	HelpDebugView          = "j/k: scroll  g/G: top/bottom  c: clear  Esc/ctrl+l: close"
 	HelpFormationsDialog   = "Tab/←/→: switch team  Esc: close"
 	HelpStatisticsDialog   = "↑/↓: navigate  Esc: close"
+	HelpHelpDialog         = "j/k: scroll  ?/Esc: close"
+	HelpFocusedMatch       = "j/k: scroll  g/G: top/bottom  u: collapse updates  Esc: back to list"
+	HelpRawJSONDialog      = "j/k: scroll  g/G: top/bottom  /: search  n/N: next/prev match  Esc: close"
 )
 
+// Stats view match day hints; the verb is a date such as "Sat 12 Oct".
+const (
+	StatsSearchingMatchDay = "Looking for the last day with matches…"
+	StatsSuggestedMatchDay = "Last matches were on %s\nPress g to jump there"
+	StatsAnchoredDay       = "Up to %s · [/]: day · g: back to today"
+	StatsLiveOnlyIndicator = "● LIVE"
+	StatsLiveOnlyOff       = "Press L to show all matches"
+)
+
+// Fetch error panel, shown instead of an empty list when loading matches failed
+const (
+	FetchErrorNetwork = "Couldn't reach FotMob - check your connection"
+	FetchErrorFailed  = "Couldn't load matches"
+	FetchErrorRetry   = "r: retry  Esc: back"
+)
+
+// WatchModeIndicator is shown in the live view while watch mode rotates through live matches.
+// The verb is the rotation interval.
+const WatchModeIndicator = "◉ Watching live matches, next every %s (any key stops)"
+
+// OfflineIndicator is shown in the live and stats views while they show the last known good data
+// because a fresh fetch failed. The verb is when that data was fetched.
+const OfflineIndicator = "OFFLINE — showing cached data from %s"
+
+// ResultsTickerLabel leads the results ticker at the bottom of the live view.
+const ResultsTickerLabel = "RESULTS"
+
 // Status text
 const (
 	StatusLive            = "LIVE"
@@ -49,11 +99,25 @@ const (
 	StatusNotStarted      = "VS"
 	StatusNotStartedShort = "NS"
 	StatusFinishedText    = "Finished"
+	StatusPostponed       = "Postponed"
+	StatusAbandoned       = "Abandoned"
+	KickingOff            = "kicking off…"
 )
 
 // Loading text
 const (
 	LoadingFetching = "Fetching..."
+	LoadingMore     = "Loading more…"
+	LoadingDetails  = "Loading match details…"
+	UpdatingTitle   = "Updating...  " // Followed by the polling spinner in the updates title
+
+	// LoadingMatchDetailsProgress is formatted with the loaded and total match counts.
+	LoadingMatchDetailsProgress = "Loading match details %d/%d"
+
+	// LoadingDayProgress and LoadingBatchProgress are formatted with the step being
+	// fetched and the total steps of the stats and live view fetches.
+	LoadingDayProgress   = "Loading day %d/%d"
+	LoadingBatchProgress = "Batch %d/%d"
 )
 
 // Notification text
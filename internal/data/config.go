@@ -1 +1,144 @@
 package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// ConfigVersion is the layout version of the config directory written by this build.
+// Bump it together with a new entry in configMigrations when a config format changes.
+const ConfigVersion = 1
+
+const configVersionFileName = "version.json"
+
+// configVersionFile is the content of version.json.
+type configVersionFile struct {
+	Version int `json:"version"`
+}
+
+// configMigrations upgrade the config directory: configMigrations[i] moves it from
+// version i to i+1. Each must be idempotent, so an interrupted run can simply be repeated.
+var configMigrations = []func(dir string) error{
+	migrateLegacyConfigDir, // 0 -> 1
+}
+
+// MigrateConfig upgrades the config directory to ConfigVersion and records the version in
+// version.json. Directories from newer builds are left untouched. Safe to run at every startup.
+func MigrateConfig() error {
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+	return migrateConfigDir(dir)
+}
+
+func migrateConfigDir(dir string) error {
+	version, err := readConfigVersion(dir)
+	if err != nil {
+		return err
+	}
+
+	for version < ConfigVersion {
+		if err := configMigrations[version](dir); err != nil {
+			return fmt.Errorf("migrate config from version %d: %w", version, err)
+		}
+		version++
+		// Record each step so a failure later on doesn't repeat the finished ones
+		if err := writeConfigVersion(dir, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readConfigVersion returns the version recorded in dir, or 0 for directories
+// from before versioning.
+func readConfigVersion(dir string) (int, error) {
+	data, err := os.ReadFile(filepath.Join(dir, configVersionFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read config version: %w", err)
+	}
+
+	var file configVersionFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return 0, fmt.Errorf("parse %s: %w", configVersionFileName, err)
+	}
+	return file.Version, nil
+}
+
+func writeConfigVersion(dir string, version int) error {
+	data, err := json.Marshal(configVersionFile{Version: version})
+	if err != nil {
+		return fmt.Errorf("marshal config version: %w", err)
+	}
+	return os.WriteFile(filepath.Join(dir, configVersionFileName), data, 0644)
+}
+
+// legacyConfigFiles are the user-edited files worth carrying over from a legacy config directory.
+var legacyConfigFiles = []string{settingsFileName, trackedLeaguesFileName}
+
+// migrateLegacyConfigDir copies settings and tracked leagues from ~/.golazo, where every
+// platform kept them before Linux moved to the XDG config directory. Files already present in
+// dir win, and the legacy directory is left as it is.
+func migrateLegacyConfigDir(dir string) error {
+	if runtime.GOOS != "linux" {
+		return nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil // No home directory, so no legacy directory either
+	}
+
+	legacyDir := filepath.Join(homeDir, configDir)
+	if filepath.Clean(legacyDir) == filepath.Clean(dir) {
+		return nil
+	}
+
+	for _, name := range legacyConfigFiles {
+		if err := copyFileIfMissing(filepath.Join(legacyDir, name), filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyFileIfMissing copies src to dst unless src doesn't exist or dst already does.
+func copyFileIfMissing(src, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open %s: %w", src, err)
+	}
+	defer func() { _ = in.Close() }()
+
+	// Write under a temporary name first so an interrupted copy is never taken for the real file
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", tmp, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("copy %s: %w", src, err)
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write %s: %w", tmp, err)
+	}
+	return os.Rename(tmp, dst)
+}
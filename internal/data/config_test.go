@@ -0,0 +1,131 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestMigrateConfigDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	dir := t.TempDir()
+
+	if runtime.GOOS == "linux" {
+		legacy := filepath.Join(home, configDir)
+		if err := os.MkdirAll(legacy, 0755); err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, filepath.Join(legacy, settingsFileName), "league_flags: true\n")
+		writeFile(t, filepath.Join(legacy, trackedLeaguesFileName), "[legacy]")
+		writeFile(t, filepath.Join(dir, trackedLeaguesFileName), "[current]")
+	}
+
+	// Running twice must give the same result as running once
+	for range 2 {
+		if err := migrateConfigDir(dir); err != nil {
+			t.Fatalf("migrateConfigDir() error = %v", err)
+		}
+	}
+
+	if version, err := readConfigVersion(dir); err != nil || version != ConfigVersion {
+		t.Errorf("readConfigVersion() = %d, %v; want %d", version, err, ConfigVersion)
+	}
+	if runtime.GOOS == "linux" {
+		if got := readFile(t, filepath.Join(dir, settingsFileName)); got != "league_flags: true\n" {
+			t.Errorf("settings = %q, want the legacy settings copied over", got)
+		}
+		if got := readFile(t, filepath.Join(dir, trackedLeaguesFileName)); got != "[current]" {
+			t.Errorf("leagues = %q, want the existing file kept", got)
+		}
+	}
+
+	// A directory from a newer build is left alone
+	writeFile(t, filepath.Join(dir, configVersionFileName), `{"version":99}`)
+	if err := migrateConfigDir(dir); err != nil {
+		t.Fatalf("migrateConfigDir() on a newer layout error = %v", err)
+	}
+	if version, _ := readConfigVersion(dir); version != 99 {
+		t.Errorf("version = %d after migrating a newer layout, want 99", version)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestStorageDirsFallBackToTemp(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("home directory comes from USERPROFILE on Windows")
+	}
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	t.Setenv("HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("XDG_CACHE_HOME", "")
+
+	var warnings []string
+	SetStorageWarningHandler(func(message string) { warnings = append(warnings, message) })
+	t.Cleanup(func() { SetStorageWarningHandler(nil) })
+
+	for range 2 {
+		configPath, err := ConfigDir()
+		if err != nil {
+			t.Fatalf("ConfigDir: %v", err)
+		}
+		if want := filepath.Join(tmp, tempDirName(), "config"); configPath != want {
+			t.Errorf("ConfigDir = %q, want %q", configPath, want)
+		}
+		cachePath, err := CacheDir()
+		if err != nil {
+			t.Fatalf("CacheDir: %v", err)
+		}
+		if want := filepath.Join(tmp, tempDirName(), "cache"); cachePath != want {
+			t.Errorf("CacheDir = %q, want %q", cachePath, want)
+		}
+		if !IsFallbackDir(configPath) || !IsFallbackDir(cachePath) {
+			t.Error("fallback directories not reported by IsFallbackDir")
+		}
+	}
+	if len(warnings) != 2 {
+		t.Errorf("got %d warnings, want one per directory: %q", len(warnings), warnings)
+	}
+	if info, err := os.Stat(filepath.Join(tmp, tempDirName())); err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("fallback directory = %v, %v, want it private to the user", info, err)
+	}
+}
+
+func TestStorageFallbackRejectsForeignDir(t *testing.T) {
+	if runtime.GOOS == "windows" || os.Getuid() != 0 {
+		t.Skip("needs root to hand the directory to another user")
+	}
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	t.Setenv("HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	planted := filepath.Join(tmp, tempDirName())
+	if err := os.Mkdir(planted, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(planted, 12345, 12345); err != nil {
+		t.Fatal(err)
+	}
+
+	if dir, err := ConfigDir(); err == nil {
+		t.Errorf("ConfigDir() = %q, want an error for a directory owned by another user", dir)
+	}
+}
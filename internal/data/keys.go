@@ -0,0 +1,134 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const keysFileName = "keys.json"
+
+// reservedKeys are bound to fixed actions and can't be remapped.
+var reservedKeys = map[string]string{
+	"?":      "help",
+	"ctrl+l": "debug log",
+	"ctrl+r": "raw match JSON",
+}
+
+// KeyMap lists the keys of each remappable action, using Bubble Tea key names
+// such as "k", "up", "enter" or "ctrl+c". Actions left out of keys.json keep their defaults.
+type KeyMap struct {
+	Up          []string `json:"up,omitempty"`
+	Down        []string `json:"down,omitempty"`
+	Select      []string `json:"select,omitempty"`
+	Back        []string `json:"back,omitempty"`
+	DateForward []string `json:"date_forward,omitempty"`
+	DateBack    []string `json:"date_back,omitempty"`
+	ToggleFocus []string `json:"toggle_focus,omitempty"`
+	Quit        []string `json:"quit,omitempty"`
+}
+
+// DefaultKeyMap returns the built-in key bindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up:          []string{"k", "up"},
+		Down:        []string{"j", "down"},
+		Select:      []string{"enter"},
+		Back:        []string{"esc"},
+		DateForward: []string{"l", "right"},
+		DateBack:    []string{"h", "left"},
+		ToggleFocus: []string{"tab"},
+		Quit:        []string{"q", "ctrl+c"},
+	}
+}
+
+// KeysPath returns the path to the key bindings file.
+func KeysPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, keysFileName), nil
+}
+
+// LoadKeyMap returns the default key bindings overridden by those in keys.json.
+// Returns the defaults if the file doesn't exist, and also, together with an error,
+// if it can't be read or the result binds one key to two actions.
+func LoadKeyMap() (KeyMap, error) {
+	path, err := KeysPath()
+	if err != nil {
+		return DefaultKeyMap(), err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return DefaultKeyMap(), nil
+	}
+	if err != nil {
+		return DefaultKeyMap(), err
+	}
+
+	var custom KeyMap
+	if err := json.Unmarshal(data, &custom); err != nil {
+		return DefaultKeyMap(), fmt.Errorf("parse %s: %w", keysFileName, err)
+	}
+
+	keys := DefaultKeyMap().merge(custom)
+	if err := keys.validate(); err != nil {
+		return DefaultKeyMap(), fmt.Errorf("%s: %w; using the default keys", keysFileName, err)
+	}
+	return keys, nil
+}
+
+// keyAction is a named action of a KeyMap, pointing at its keys.
+type keyAction struct {
+	name string
+	keys *[]string
+}
+
+// actions returns the actions of k in declaration order.
+func (k *KeyMap) actions() []keyAction {
+	return []keyAction{
+		{"up", &k.Up},
+		{"down", &k.Down},
+		{"select", &k.Select},
+		{"back", &k.Back},
+		{"date_forward", &k.DateForward},
+		{"date_back", &k.DateBack},
+		{"toggle_focus", &k.ToggleFocus},
+		{"quit", &k.Quit},
+	}
+}
+
+// merge returns k with every action set in custom replaced by custom's keys.
+func (k KeyMap) merge(custom KeyMap) KeyMap {
+	overrides := custom.actions()
+	for i, action := range k.actions() {
+		if keys := *overrides[i].keys; len(keys) > 0 {
+			*action.keys = keys
+		}
+	}
+	return k
+}
+
+// validate reports empty or reserved keys and keys bound to more than one action.
+func (k KeyMap) validate() error {
+	boundTo := make(map[string]string)
+	for _, action := range k.actions() {
+		for _, key := range *action.keys {
+			if key == "" {
+				return fmt.Errorf("%s has an empty key", action.name)
+			}
+			if reserved, ok := reservedKeys[key]; ok {
+				return fmt.Errorf("%q is reserved for %s", key, reserved)
+			}
+			if other, ok := boundTo[key]; ok && other != action.name {
+				return fmt.Errorf("%q is bound to both %s and %s", key, other, action.name)
+			}
+			boundTo[key] = action.name
+		}
+	}
+	return nil
+}
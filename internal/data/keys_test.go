@@ -0,0 +1,55 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestLoadKeyMap(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	keys, err := LoadKeyMap()
+	if err != nil || !slices.Equal(keys.Up, DefaultKeyMap().Up) {
+		t.Fatalf("LoadKeyMap() without keys.json = %v, %v; want the defaults", keys, err)
+	}
+
+	path, err := KeysPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		wantUp  []string
+		wantErr bool
+	}{
+		{"override", `{"up": ["up"], "down": ["down"]}`, []string{"up"}, false},
+		{"conflict", `{"up": ["q"]}`, DefaultKeyMap().Up, true},
+		{"reserved", `{"back": ["?"]}`, DefaultKeyMap().Up, true},
+		{"reserved debug log", `{"back": ["ctrl+l"]}`, DefaultKeyMap().Up, true},
+		{"reserved raw json", `{"quit": ["ctrl+r"]}`, DefaultKeyMap().Up, true},
+		{"invalid json", `{"up": "k"}`, DefaultKeyMap().Up, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeFile(t, path, tt.content)
+			keys, err := LoadKeyMap()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadKeyMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(keys.Up, tt.wantUp) {
+				t.Errorf("Up = %v, want %v", keys.Up, tt.wantUp)
+			}
+			if !slices.Equal(keys.Quit, DefaultKeyMap().Quit) {
+				t.Errorf("Quit = %v, want the default", keys.Quit)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+const trackedLeaguesFileName = "leagues.json"
+
+// TrackedLeague is a league entry in leagues.json.
+// Entries whose ID matches a built-in league override it; others are added.
+// The spot counts color the league table, see TableZones; leaving them all out
+// keeps the built-in zones of an overridden league.
+type TrackedLeague struct {
+	ID                   int    `json:"id"`
+	Name                 string `json:"name"`
+	Country              string `json:"country"`
+	Region               string `json:"region"`
+	ChampionsLeagueSpots int    `json:"champions_league_spots,omitempty"`
+	EuropaSpots          int    `json:"europa_spots,omitempty"`
+	RelegationSpots      int    `json:"relegation_spots,omitempty"`
+}
+
+// zones returns the table zones set on a tracked league.
+func (l TrackedLeague) zones() TableZones {
+	return TableZones{
+		ChampionsLeagueSpots: l.ChampionsLeagueSpots,
+		EuropaSpots:          l.EuropaSpots,
+		RelegationSpots:      l.RelegationSpots,
+	}
+}
+
+// TrackedLeaguesPath returns the path to the tracked leagues file.
+func TrackedLeaguesPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, trackedLeaguesFileName), nil
+}
+
+// LoadTrackedLeagues returns the built-in leagues merged with those in leagues.json.
+// Returns the built-in set if the file doesn't exist. Invalid entries (non-positive ID
+// or empty name) are skipped and reported in the returned error alongside the merged set.
+func LoadTrackedLeagues() (map[string][]LeagueInfo, error) {
+	custom, err := loadTrackedLeaguesFile()
+	if err != nil {
+		return mergeTrackedLeagues(AllSupportedLeagues, nil), err
+	}
+
+	var valid []TrackedLeague
+	var errs []error
+	for i, league := range custom {
+		if err := league.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("leagues.json entry %d: %w", i, err))
+			continue
+		}
+		valid = append(valid, league)
+	}
+
+	return mergeTrackedLeagues(AllSupportedLeagues, valid), errors.Join(errs...)
+}
+
+// SaveTrackedLeagues writes the tracked leagues to leagues.json.
+func SaveTrackedLeagues(leagues []TrackedLeague) error {
+	for i, league := range leagues {
+		if err := league.validate(); err != nil {
+			return fmt.Errorf("league %d: %w", i, err)
+		}
+	}
+
+	path, err := TrackedLeaguesPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(leagues, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// CustomLeagueIDs returns the IDs of leagues in leagues.json that aren't built in.
+func CustomLeagueIDs() []int {
+	custom, err := loadTrackedLeaguesFile()
+	if err != nil {
+		return nil
+	}
+
+	builtin := builtinLeagueIDs()
+	var ids []int
+	for _, league := range custom {
+		if league.validate() != nil || slices.Contains(builtin, league.ID) || slices.Contains(ids, league.ID) {
+			continue
+		}
+		ids = append(ids, league.ID)
+	}
+	return ids
+}
+
+// loadTrackedLeaguesFile reads the raw entries from leagues.json.
+// Returns no entries if the file doesn't exist.
+func loadTrackedLeaguesFile() ([]TrackedLeague, error) {
+	path, err := TrackedLeaguesPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var leagues []TrackedLeague
+	if err := json.Unmarshal(data, &leagues); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", trackedLeaguesFileName, err)
+	}
+	return leagues, nil
+}
+
+// validate checks that a tracked league has a positive ID, a name and no negative zone.
+func (l TrackedLeague) validate() error {
+	if l.ID <= 0 {
+		return fmt.Errorf("invalid league id %d", l.ID)
+	}
+	if l.Name == "" {
+		return fmt.Errorf("league %d has no name", l.ID)
+	}
+	if err := l.zones().Validate(0); err != nil {
+		return fmt.Errorf("league %d: %w", l.ID, err)
+	}
+	return nil
+}
+
+// mergeTrackedLeagues overlays custom leagues on the built-in set without modifying it.
+// Overrides replace the built-in entry in place (moving it if the region changes), keeping its
+// table zones unless they set their own; unknown or empty regions fall back to the Global region.
+func mergeTrackedLeagues(builtin map[string][]LeagueInfo, custom []TrackedLeague) map[string][]LeagueInfo {
+	merged := make(map[string][]LeagueInfo, len(builtin))
+	for region, leagues := range builtin {
+		merged[region] = slices.Clone(leagues)
+	}
+
+	for _, league := range custom {
+		region := league.Region
+		if !slices.Contains(GetAllRegions(), region) {
+			region = RegionGlobal
+		}
+
+		info := LeagueInfo{ID: league.ID, Name: league.Name, Country: league.Country, Zones: league.zones()}
+		replaced := false
+		for r, leagues := range merged {
+			idx := slices.IndexFunc(leagues, func(l LeagueInfo) bool { return l.ID == league.ID })
+			if idx < 0 {
+				continue
+			}
+			if info.Zones.IsZero() {
+				info.Zones = leagues[idx].Zones
+			}
+			if r == region {
+				leagues[idx] = info
+				replaced = true
+			} else {
+				merged[r] = slices.Delete(leagues, idx, idx+1)
+			}
+		}
+		if !replaced {
+			merged[region] = append(merged[region], info)
+		}
+	}
+
+	return merged
+}
+
+// builtinLeagueIDs returns the IDs of the compiled-in leagues.
+func builtinLeagueIDs() []int {
+	var ids []int
+	for _, leagues := range AllSupportedLeagues {
+		for _, league := range leagues {
+			ids = append(ids, league.ID)
+		}
+	}
+	return ids
+}
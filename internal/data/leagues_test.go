@@ -0,0 +1,90 @@
+package data
+
+import "testing"
+
+func TestMergeTrackedLeagues(t *testing.T) {
+	builtin := map[string][]LeagueInfo{
+		RegionEurope: {
+			{ID: 47, Name: "Premier League", Country: "England"},
+			{ID: 87, Name: "La Liga", Country: "Spain", Zones: TableZones{ChampionsLeagueSpots: 4, RelegationSpots: 3}},
+		},
+	}
+
+	merged := mergeTrackedLeagues(builtin, []TrackedLeague{
+		{ID: 87, Name: "LaLiga EA Sports", Country: "Spain", Region: RegionEurope},
+		{ID: 47, Name: "Premier League", Country: "England", Region: RegionEurope, ChampionsLeagueSpots: 5, RelegationSpots: 3},
+		{ID: 9999, Name: "Custom League", Country: "Nowhere", Region: "Atlantis", RelegationSpots: 1},
+	})
+
+	europe := merged[RegionEurope]
+	if len(europe) != 2 || europe[1].Name != "LaLiga EA Sports" {
+		t.Errorf("override not applied in place: %+v", europe)
+	}
+	if europe[1].Zones.ChampionsLeagueSpots != 4 {
+		t.Errorf("override dropped the built-in table zones: %+v", europe[1])
+	}
+	if want := (TableZones{ChampionsLeagueSpots: 5, RelegationSpots: 3}); europe[0].Zones != want {
+		t.Errorf("override zones = %+v, want %+v", europe[0].Zones, want)
+	}
+	if builtin[RegionEurope][1].Name != "La Liga" {
+		t.Errorf("built-in set was modified: %+v", builtin[RegionEurope])
+	}
+
+	global := merged[RegionGlobal]
+	if len(global) != 1 || global[0].ID != 9999 {
+		t.Errorf("custom league with unknown region should land in Global: %+v", global)
+	} else if global[0].Zones.RelegationSpots != 1 {
+		t.Errorf("custom league lost its zones: %+v", global[0])
+	}
+}
+
+func TestTrackedLeagueValidate(t *testing.T) {
+	tests := []struct {
+		league  TrackedLeague
+		wantErr bool
+	}{
+		{TrackedLeague{ID: 47, Name: "Premier League"}, false},
+		{TrackedLeague{ID: 0, Name: "Zero"}, true},
+		{TrackedLeague{ID: -3, Name: "Negative"}, true},
+		{TrackedLeague{ID: 47}, true},
+		{TrackedLeague{ID: 47, Name: "Premier League", RelegationSpots: -1}, true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.league.validate(); (err != nil) != tt.wantErr {
+			t.Errorf("validate(%+v) error = %v, wantErr %v", tt.league, err, tt.wantErr)
+		}
+	}
+}
+
+func TestTableZonesZone(t *testing.T) {
+	zones := TableZones{ChampionsLeagueSpots: 4, EuropaSpots: 2, RelegationSpots: 3}
+	want := map[int]TableZone{
+		1: ZoneChampionsLeague, 4: ZoneChampionsLeague,
+		5: ZoneEuropa, 6: ZoneEuropa,
+		7: ZoneNone, 17: ZoneNone,
+		18: ZoneRelegation, 20: ZoneRelegation,
+	}
+	for position, zone := range want {
+		if got := zones.Zone(position, 20); got != zone {
+			t.Errorf("Zone(%d, 20) = %v, want %v", position, got, zone)
+		}
+	}
+
+	if got := (TableZones{}).Zone(20, 20); got != ZoneNone {
+		t.Errorf("a league without zones put position 20 in zone %v", got)
+	}
+}
+
+func TestTableZonesValidate(t *testing.T) {
+	zones := TableZones{ChampionsLeagueSpots: 4, EuropaSpots: 2, RelegationSpots: 3}
+	if err := zones.Validate(20); err != nil {
+		t.Errorf("Validate(20) = %v, want nil", err)
+	}
+	if err := zones.Validate(8); err == nil {
+		t.Error("Validate(8) = nil, want an error for 9 zone places in 8 teams")
+	}
+	if err := (TableZones{EuropaSpots: -1}).Validate(20); err == nil {
+		t.Error("negative zone passed validation")
+	}
+}
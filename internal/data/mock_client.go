@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// MockClient is an api.MatchService serving the bundled mock data instead of calling FotMob.
+// All mock matches are dated today; other dates have no matches. Standings are not mocked.
+type MockClient struct{}
+
+var _ api.MatchService = MockClient{}
+
+// MatchesByDate returns today's mock matches.
+func (c MockClient) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
+}
+
+// MatchesByDateWithTabs returns today's mock matches: live matches for "fixtures",
+// finished matches for "results".
+func (MockClient) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if date.Format("2006-01-02") != time.Now().Format("2006-01-02") {
+		return nil, nil
+	}
+
+	var matches []api.Match
+	if slices.Contains(tabs, "fixtures") {
+		matches = append(matches, MockLiveMatches()...)
+	}
+	if slices.Contains(tabs, "results") {
+		matches = append(matches, MockFinishedMatches()...)
+	}
+	return matches, nil
+}
+
+// MatchDetails returns the mock details of a finished or live match, or nil for unknown IDs.
+func (MockClient) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if details, _ := MockFinishedMatchDetails(matchID); details != nil {
+		return details, nil
+	}
+	return MockMatchDetails(matchID)
+}
+
+// MatchDetailsForceRefresh is MatchDetails; the mock has no cache.
+func (c MockClient) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	return c.MatchDetails(ctx, matchID)
+}
+
+// BatchMatchDetails returns the mock details of each match.
+func (c MockClient) BatchMatchDetails(ctx context.Context, matchIDs []int) map[int]*api.MatchDetails {
+	results := make(map[int]*api.MatchDetails, len(matchIDs))
+	for _, id := range matchIDs {
+		results[id], _ = c.MatchDetails(ctx, id)
+	}
+	return results
+}
+
+// LeagueTable returns no standings.
+func (MockClient) LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]api.LeagueTableEntry, error) {
+	return nil, ctx.Err()
+}
+
+// LeagueTableWithParent returns no standings.
+func (c MockClient) LeagueTableWithParent(ctx context.Context, leagueID int, leagueName string, parentLeagueID int) ([]api.LeagueTableEntry, error) {
+	return c.LeagueTable(ctx, leagueID, leagueName)
+}
+
+// TeamStats returns the mock season record of a team, see MockTeamStats.
+func (MockClient) TeamStats(ctx context.Context, teamID int) (*api.TeamSeasonStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return MockTeamStats(teamID), nil
+}
+
+// LiveMatches returns the mock live matches.
+func (MockClient) LiveMatches(ctx context.Context) ([]api.Match, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return MockLiveMatches(), nil
+}
+
+// LiveMatchesForceRefresh is LiveMatches; the mock has no cache.
+func (c MockClient) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, error) {
+	return c.LiveMatches(ctx)
+}
+
+// LiveMatchesForLeague returns the mock live matches of one league.
+func (c MockClient) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.Match, error) {
+	live, err := c.LiveMatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []api.Match
+	for _, match := range live {
+		if match.League.ID == leagueID {
+			matches = append(matches, match)
+		}
+	}
+	return matches, nil
+}
+
+// StatsData returns the mock finished matches, all of them today's.
+func (MockClient) StatsData(ctx context.Context, days int) (*api.StatsData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	finished := MockFinishedMatches()
+	return &api.StatsData{
+		AllFinished:   finished,
+		TodayFinished: finished,
+	}, nil
+}
+
+// NearestMatchDay walks back from from to the first day with mock finished matches, i.e. today.
+func (c MockClient) NearestMatchDay(ctx context.Context, from time.Time, maxBack int) (time.Time, error) {
+	for i := 0; i <= maxBack; i++ {
+		day := from.AddDate(0, 0, -i)
+		matches, err := c.MatchesByDateWithTabs(ctx, day, []string{"results"})
+		if err != nil {
+			return time.Time{}, err
+		}
+		if len(matches) > 0 {
+			return day, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w in the %d days before %s", api.ErrNoMatchDay, maxBack+1, from.Format("2006-01-02"))
+}
+
+// Commentary turns the mock live updates of a match into commentary, newest first.
+func (MockClient) Commentary(ctx context.Context, matchID int) ([]api.CommentaryEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	updates := getMockLiveUpdates(matchID)
+	entries := make([]api.CommentaryEntry, 0, len(updates))
+	for i, update := range slices.Backward(updates) {
+		minute, text, ok := strings.Cut(update, "' ")
+		if !ok {
+			minute, text = "0", update
+		}
+		m, _ := strconv.Atoi(minute)
+		entries = append(entries, api.CommentaryEntry{
+			ID:     fmt.Sprintf("mock-%d-%d", matchID, i),
+			Minute: m,
+			Text:   text,
+		})
+	}
+	return entries, nil
+}
+
+// TodaySummary counts the mock live and finished matches.
+func (MockClient) TodaySummary(ctx context.Context) (live, finished, upcoming int, err error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, 0, err
+	}
+	live, finished, upcoming = api.CountMatchStates(append(MockLiveMatches(), MockFinishedMatches()...))
+	return live, finished, upcoming, nil
+}
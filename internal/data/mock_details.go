@@ -16,12 +16,13 @@ func MockMatchDetails(matchID int) (*api.MatchDetails, error) {
 			events := generateLiveMatchEvents(matchID, liveMatches[i])
 			stats := generateMockStatistics(matchID)
 			return &api.MatchDetails{
-				Match:      liveMatches[i],
-				Events:     events,
-				Statistics: stats,
-				Venue:      getMockVenue(matchID),
-				Referee:    getMockReferee(matchID),
-				Attendance: getMockAttendance(matchID),
+				Match:        liveMatches[i],
+				Events:       events,
+				Statistics:   stats,
+				LiveMomentum: getMockLiveMomentum(matchID),
+				Venue:        getMockVenue(matchID),
+				Referee:      getMockReferee(matchID),
+				Attendance:   getMockAttendance(matchID),
 			}, nil
 		}
 	}
@@ -89,15 +90,18 @@ func generateLiveMatchEvents(matchID int, match api.Match) []api.MatchEvent {
 
 	case 2004: // Arsenal 2-3 Liverpool (FT) - Premier League
 		events = []api.MatchEvent{
-			{ID: 17, Minute: 8, DisplayMinute: "8'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 36, Minute: 7, DisplayMinute: "7'", Type: "var", Team: match.AwayTeam, EventType: stringPtr(api.VARPenaltyAwarded), Reason: "foul", Timestamp: time.Now()},
+			{ID: 17, Minute: 8, DisplayMinute: "8'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Salah"), GoalType: api.GoalTypePenalty, Timestamp: time.Now()},
 			{ID: 18, Minute: 15, DisplayMinute: "15'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Rice"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
 			{ID: 19, Minute: 23, DisplayMinute: "23'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
 			{ID: 20, Minute: 34, DisplayMinute: "34'", Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Gakpo"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
+			{ID: 37, Minute: 40, DisplayMinute: "40'", Type: "var", Team: match.HomeTeam, EventType: stringPtr(api.VARGoalDisallowed), Reason: "offside", Timestamp: time.Now()},
 			{ID: 21, Minute: 45, DisplayMinute: "45+1'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Nunez"), Timestamp: time.Now()},
 			{ID: 22, Minute: 56, DisplayMinute: "56'", Type: "card", Team: match.AwayTeam, Player: stringPtr("Van Dijk"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
 			{ID: 23, Minute: 67, DisplayMinute: "67'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Martinelli"), Timestamp: time.Now()},
 			{ID: 24, Minute: 78, DisplayMinute: "78'", Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Trossard"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
 			{ID: 25, Minute: 85, DisplayMinute: "85'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Gabriel"), EventType: stringPtr("red"), Timestamp: time.Now()},
+			{ID: 35, Minute: 88, DisplayMinute: "88'", Type: "card", Team: match.AwayTeam, Player: stringPtr("Van Dijk"), EventType: stringPtr(api.CardSecondYellow), Timestamp: time.Now()},
 			{ID: 26, Minute: 90, DisplayMinute: "90+3'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Diaz"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
 		}
 
@@ -124,9 +128,13 @@ func generateMockStatistics(matchID int) []api.MatchStatistic {
 	case 2001, 1001: // Chelsea matches
 		return []api.MatchStatistic{
 			{Key: "possession", Label: "Possession %", HomeValue: "58", AwayValue: "42"},
+			{Key: "expected_goals", Label: "Expected goals (xG)", HomeValue: "1.84", AwayValue: "0.92"},
+			{Key: "big_chance", Label: "Big chances", HomeValue: "3", AwayValue: "1"},
 			{Key: "shots_total", Label: "Total Shots", HomeValue: "14", AwayValue: "8"},
 			{Key: "shots_on_target", Label: "Shots on Target", HomeValue: "6", AwayValue: "3"},
 			{Key: "corners", Label: "Corners", HomeValue: "7", AwayValue: "4"},
+			{Key: "duel_won", Label: "Duels won", HomeValue: "31", AwayValue: "25"},
+			{Key: "tackles_succeeded", Label: "Tackles won", HomeValue: "12 (57%)", AwayValue: "9 (45%)"},
 			{Key: "fouls", Label: "Fouls", HomeValue: "9", AwayValue: "12"},
 		}
 	case 2002, 1003: // Madrid matches
@@ -135,6 +143,8 @@ func generateMockStatistics(matchID int) []api.MatchStatistic {
 			{Key: "shots_total", Label: "Total Shots", HomeValue: "11", AwayValue: "9"},
 			{Key: "shots_on_target", Label: "Shots on Target", HomeValue: "4", AwayValue: "4"},
 			{Key: "corners", Label: "Corners", HomeValue: "5", AwayValue: "6"},
+			{Key: "duel_won", Label: "Duels won", HomeValue: "38", AwayValue: "40"},
+			{Key: "tackles_succeeded", Label: "Tackles won", HomeValue: "14 (61%)", AwayValue: "16 (64%)"},
 			{Key: "fouls", Label: "Fouls", HomeValue: "11", AwayValue: "14"},
 		}
 	case 2003, 1005: // Champions League matches
@@ -143,6 +153,8 @@ func generateMockStatistics(matchID int) []api.MatchStatistic {
 			{Key: "shots_total", Label: "Total Shots", HomeValue: "18", AwayValue: "10"},
 			{Key: "shots_on_target", Label: "Shots on Target", HomeValue: "8", AwayValue: "5"},
 			{Key: "corners", Label: "Corners", HomeValue: "9", AwayValue: "3"},
+			{Key: "duel_won", Label: "Duels won", HomeValue: "29", AwayValue: "33"},
+			{Key: "tackles_succeeded", Label: "Tackles won", HomeValue: "10 (53%)", AwayValue: "18 (69%)"},
 			{Key: "fouls", Label: "Fouls", HomeValue: "7", AwayValue: "10"},
 		}
 	default:
@@ -151,6 +163,8 @@ func generateMockStatistics(matchID int) []api.MatchStatistic {
 			{Key: "shots_total", Label: "Total Shots", HomeValue: "12", AwayValue: "10"},
 			{Key: "shots_on_target", Label: "Shots on Target", HomeValue: "5", AwayValue: "4"},
 			{Key: "corners", Label: "Corners", HomeValue: "6", AwayValue: "5"},
+			{Key: "duel_won", Label: "Duels won", HomeValue: "35", AwayValue: "35"},
+			{Key: "tackles_succeeded", Label: "Tackles won", HomeValue: "13 (59%)", AwayValue: "12 (55%)"},
 			{Key: "fouls", Label: "Fouls", HomeValue: "10", AwayValue: "11"},
 		}
 	}
@@ -225,6 +239,23 @@ func getMockAttendance(matchID int) int {
 	return 50000
 }
 
+// getMockLiveMomentum returns which side is on top right now (-1 away .. 1 home)
+// for some live matches, as with FotMob's coverage.
+func getMockLiveMomentum(matchID int) *float64 {
+	momentum := map[int]float64{
+		2001: 0.45, // Chelsea pressing for a third
+		2003: -0.7, // Bayern chasing the equalizer
+	}
+	if m, ok := momentum[matchID]; ok {
+		return &m
+	}
+	return nil
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
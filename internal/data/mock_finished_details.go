@@ -42,6 +42,17 @@ func MockFinishedMatchDetails(matchID int) (*api.MatchDetails, error) {
 		details.Penalties = penalties
 	}
 
+	// Add mock momentum graph for some matches to demonstrate the sparkline
+	details.MomentumSeries = getMockMomentum(matchID)
+
+	// Add mock aggregate score for second legs of two-legged ties
+	details.Aggregate = getMockAggregate(matchID)
+	if details.Aggregate != nil {
+		// The mock ties are all won by the home side
+		advanced := details.HomeTeam.ID
+		details.Advanced = &advanced
+	}
+
 	// Add mock highlights for some matches to demonstrate the feature
 	if highlight := getMockHighlight(matchID); highlight != nil {
 		details.Highlight = highlight
@@ -79,11 +90,12 @@ func generateFinishedMatchEvents(matchID int, match api.Match) []api.MatchEvent
 	case 1012: // Napoli 3-1 Roma
 		events = []api.MatchEvent{
 			{ID: 59, Minute: 12, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Osimhen"), Timestamp: time.Now()},
-			{ID: 60, Minute: 28, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Dybala"), Timestamp: time.Now()},
+			{ID: 65, Minute: 26, Type: "var", Team: match.AwayTeam, EventType: stringPtr(api.VARPenaltyAwarded), Reason: "handball", Timestamp: time.Now()},
+			{ID: 60, Minute: 28, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Dybala"), GoalType: api.GoalTypePenalty, Timestamp: time.Now()},
 			{ID: 61, Minute: 45, Type: "card", Team: match.AwayTeam, Player: stringPtr("Cristante"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
 			{ID: 62, Minute: 56, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Kvaratskhelia"), Assist: stringPtr("Osimhen"), Timestamp: time.Now()},
 			{ID: 63, Minute: 78, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Simeone"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 64, Minute: 89, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Politano"), Timestamp: time.Now()},
+			{ID: 64, Minute: 89, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Mancini"), OwnGoal: boolPtr(true), GoalType: api.GoalTypeOwnGoal, Timestamp: time.Now()},
 		}
 
 	// ═══════════════════════════════════════════════
@@ -195,6 +207,36 @@ func getMockPenalties(matchID int) *struct {
 	}
 }
 
+// getMockMomentum returns a mock per-minute momentum series (home share, 0-100).
+// Only some matches have momentum data, as with FotMob's coverage.
+func getMockMomentum(matchID int) []float64 {
+	switch matchID {
+	case 1001: // Man City 2-1 Arsenal - City on top early, Arsenal push late
+		series := make([]float64, 90)
+		for minute := range series {
+			series[minute] = 80 - float64(minute)*2/3 + float64(minute%7)*3
+		}
+		return series
+	default:
+		return nil // No momentum data for this match
+	}
+}
+
+// getMockAggregate returns mock aggregate data for testing two-legged ties.
+// Only cup knockout second legs have an aggregate score.
+func getMockAggregate(matchID int) *api.AggregateScore {
+	switch matchID {
+	case 1012: // Napoli 3-1 Roma (second leg, lost the first 1-2)
+		return &api.AggregateScore{
+			Home:     4,
+			Away:     3,
+			FirstLeg: &api.LegScore{Home: 1, Away: 2},
+		}
+	default:
+		return nil // Not a two-legged tie
+	}
+}
+
 // getMockHighlight returns mock highlight data for testing the highlights feature.
 // Only some matches have highlights to simulate real-world availability.
 func getMockHighlight(matchID int) *api.MatchHighlight {
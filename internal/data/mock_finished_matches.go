@@ -86,7 +86,7 @@ func MockFinishedMatches() []api.Match {
 			HomeScore: intPtr(3),
 			AwayScore: intPtr(1),
 			MatchTime: timePtr(today.Add(18 * time.Hour)), // 18:00 today
-			Round:     "Round of 16 - 1st Leg",
+			Round:     "Round of 16",
 		},
 
 		// ═══════════════════════════════════════════════
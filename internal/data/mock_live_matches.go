@@ -13,7 +13,7 @@ func MockLiveMatches() []api.Match {
 
 	return []api.Match{
 		// ═══════════════════════════════════════════════
-		// ONGOING MATCHES (3) - with live events
+		// ONGOING MATCHES (4) - with live events
 		// ═══════════════════════════════════════════════
 
 		// Match 1: Premier League - Chelsea vs Tottenham (67')
@@ -91,6 +91,29 @@ func MockLiveMatches() []api.Match {
 			Round:     "Round of 16",
 		},
 
+		// Match 6: Serie A - Inter vs Milan (1'), just kicked off so FotMob has no score yet
+		{
+			ID: 2006,
+			League: api.League{
+				ID:   55,
+				Name: "Serie A",
+			},
+			HomeTeam: api.Team{
+				ID:        8636,
+				Name:      "Inter",
+				ShortName: "Inter",
+			},
+			AwayTeam: api.Team{
+				ID:        8564,
+				Name:      "AC Milan",
+				ShortName: "Milan",
+			},
+			Status:    api.MatchStatusLive,
+			LiveTime:  stringPtr("1'"),
+			MatchTime: &now,
+			Round:     "Matchday 20",
+		},
+
 		// ═══════════════════════════════════════════════
 		// JUST FINISHED MATCHES (2) - for "all events" view
 		// ═══════════════════════════════════════════════
@@ -0,0 +1,33 @@
+package data
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// MockTeamStats returns a mock season record for a few Premier League teams. Other teams
+// have no league record, like national teams, so the "no season data" state can be seen too.
+func MockTeamStats(teamID int) *api.TeamSeasonStats {
+	stats := &api.TeamSeasonStats{Team: api.Team{ID: teamID}, Season: "2025/2026"}
+
+	var record api.LeagueTableEntry
+	switch teamID {
+	case 50: // Manchester City
+		stats.Team.Name, stats.Team.ShortName = "Manchester City", "Man City"
+		record = api.LeagueTableEntry{Position: 2, Played: 16, Won: 11, Drawn: 3, Lost: 2, GoalsFor: 36, GoalsAgainst: 14, Points: 36}
+		stats.Form = []string{"W", "W", "D", "W", "L"}
+	case 42: // Arsenal
+		stats.Team.Name, stats.Team.ShortName = "Arsenal", "Arsenal"
+		record = api.LeagueTableEntry{Position: 1, Played: 16, Won: 12, Drawn: 2, Lost: 2, GoalsFor: 33, GoalsAgainst: 10, Points: 38}
+		stats.Form = []string{"W", "D", "W", "W", "W"}
+	case 49: // Chelsea
+		stats.Team.Name, stats.Team.ShortName = "Chelsea", "Chelsea"
+		record = api.LeagueTableEntry{Position: 4, Played: 16, Won: 9, Drawn: 4, Lost: 3, GoalsFor: 30, GoalsAgainst: 18, Points: 31}
+		stats.Form = []string{"L", "W", "W", "D", "W"}
+	default:
+		return stats
+	}
+
+	record.Team = stats.Team
+	record.GoalDifference = record.GoalsFor - record.GoalsAgainst
+	stats.LeagueName = "Premier League"
+	stats.Record = &record
+	return stats
+}
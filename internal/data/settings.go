@@ -1,20 +1,61 @@
 package data
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 const settingsFileName = "settings.yaml"
 
+// DefaultMaxLiveUpdates is the number of live updates kept per match when max_live_updates is unset.
+const DefaultMaxLiveUpdates = 50
+
+// DefaultWatchIntervalSeconds is how long watch mode shows each live match when watch_interval is unset.
+const DefaultWatchIntervalSeconds = 20
+
+// minWatchIntervalSeconds keeps watch mode from rotating faster than details can load.
+const minWatchIntervalSeconds = 5
+
+// Stats view fetch depth in days, including today.
+const (
+	DefaultStatsDays = 5
+	MinStatsDays     = 3
+	MaxStatsDays     = 14
+)
+
+// Match list share of the live and stats views' width, in percent; the details panel gets the rest.
+const (
+	DefaultListPanelPercent = 35
+	MinListPanelPercent     = 25
+	MaxListPanelPercent     = 60
+	ListPanelPercentStep    = 5
+)
+
+// Minutes before a goal whose replay wasn't found is searched on Reddit again.
+const (
+	DefaultReplayRetryMinutes = 5
+	MaxReplayRetryMinutes     = 24 * 60
+)
+
+// Live view preloading: leagues fetched in parallel per batch, and the pause between batches.
+const (
+	DefaultLiveBatchSize  = 4
+	MinLiveBatchSize      = 1
+	MaxLiveBatchSize      = 16
+	MaxLiveBatchDelayMsec = 5000
+)
+
 // LeagueInfo contains league metadata for display purposes.
 type LeagueInfo struct {
 	ID      int
 	Name    string
 	Country string
+	Zones   TableZones // Qualification and relegation places; zero if unknown
 }
 
 // Region constants for organizing leagues
@@ -29,15 +70,15 @@ const (
 var AllSupportedLeagues = map[string][]LeagueInfo{
 	RegionEurope: {
 		// Top 5 European Leagues
-		{ID: 47, Name: "Premier League", Country: "England"},
-		{ID: 87, Name: "La Liga", Country: "Spain"},
-		{ID: 54, Name: "Bundesliga", Country: "Germany"},
+		{ID: 47, Name: "Premier League", Country: "England", Zones: TableZones{ChampionsLeagueSpots: 4, EuropaSpots: 2, RelegationSpots: 3}},
+		{ID: 87, Name: "La Liga", Country: "Spain", Zones: TableZones{ChampionsLeagueSpots: 4, EuropaSpots: 2, RelegationSpots: 3}},
+		{ID: 54, Name: "Bundesliga", Country: "Germany", Zones: TableZones{ChampionsLeagueSpots: 4, EuropaSpots: 2, RelegationSpots: 2}},
 		{ID: 146, Name: "2. Bundesliga", Country: "Germany"},
 		{ID: 208, Name: "3. Liga", Country: "Germany"},
 		{ID: 512, Name: "Regionalliga", Country: "Germany"},
-		{ID: 55, Name: "Serie A", Country: "Italy"},
+		{ID: 55, Name: "Serie A", Country: "Italy", Zones: TableZones{ChampionsLeagueSpots: 4, EuropaSpots: 2, RelegationSpots: 3}},
 		{ID: 86, Name: "Serie B", Country: "Italy"},
-		{ID: 53, Name: "Ligue 1", Country: "France"},
+		{ID: 53, Name: "Ligue 1", Country: "France", Zones: TableZones{ChampionsLeagueSpots: 3, EuropaSpots: 2, RelegationSpots: 2}},
 		{ID: 110, Name: "Ligue 2", Country: "France"},
 		// Top 5 Women's Leagues
 		{ID: 9227, Name: "Women's Super League", Country: "England"},
@@ -53,10 +94,10 @@ var AllSupportedLeagues = map[string][]LeagueInfo{
 		{ID: 108, Name: "EFL League One", Country: "England"},
 		{ID: 109, Name: "EFL League Two", Country: "England"},
 		{ID: 196, Name: "Ekstraklasa", Country: "Poland"},
-		{ID: 57, Name: "Eredivisie", Country: "Netherlands"},
+		{ID: 57, Name: "Eredivisie", Country: "Netherlands", Zones: TableZones{ChampionsLeagueSpots: 2, EuropaSpots: 2, RelegationSpots: 2}},
 		{ID: 218, Name: "League of Ireland First Division", Country: "Ireland"},
 		{ID: 126, Name: "League of Ireland Premier Division", Country: "Ireland"},
-		{ID: 61, Name: "Primeira Liga", Country: "Portugal"},
+		{ID: 61, Name: "Primeira Liga", Country: "Portugal", Zones: TableZones{ChampionsLeagueSpots: 2, EuropaSpots: 2, RelegationSpots: 2}},
 		{ID: 10215, Name: "Primeira Liga Qualification", Country: "Portugal"},
 		{ID: 185, Name: "Liga Portugal 2", Country: "Portugal"},
 		{ID: 9668, Name: "Liga Portugal 2 Qualification", Country: "Portugal"},
@@ -157,6 +198,157 @@ type Settings struct {
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
 	SelectedLeagues []int `yaml:"selected_leagues"`
+
+	// PriorityLeagues lists league IDs the live view fetches first, so their matches show up
+	// soonest. Leagues that aren't followed are ignored; empty keeps the usual order.
+	PriorityLeagues []int `yaml:"priority_leagues,omitempty"`
+
+	// DisplayTimezone is the IANA time zone (e.g., "Europe/Madrid") used for displayed times.
+	// Empty or "Local" uses the system time zone.
+	DisplayTimezone string `yaml:"display_timezone,omitempty"`
+
+	// AnimateLogo controls the main menu logo reveal animation.
+	// Unset means enabled; use LogoAnimationEnabled to read it.
+	AnimateLogo *bool `yaml:"animate_logo,omitempty"`
+
+	// MaxLiveUpdates caps the live updates kept per match; the oldest are dropped first.
+	// Zero means the default; use LiveUpdatesLimit to read it.
+	MaxLiveUpdates int `yaml:"max_live_updates,omitempty"`
+
+	// LeagueFlags prefixes match titles in lists with the league's flag emoji.
+	// Off by default, as some terminals render emoji poorly.
+	LeagueFlags bool `yaml:"league_flags,omitempty"`
+
+	// CompactScores shows each match in the match lists on a single line
+	// ("ARS 2-1 CHE · Premier League · 78'"), so more matches fit on screen.
+	CompactScores bool `yaml:"compact_scores,omitempty"`
+
+	// SpoilerMode starts with scores, goals and cards hidden until a match is revealed,
+	// for catching up on recorded matches. It can also be toggled in the app.
+	SpoilerMode bool `yaml:"spoiler_mode,omitempty"`
+
+	// StatBarsFillAway fills the percentage bars in the match statistics (e.g. possession)
+	// with the away team's share from the right, instead of the home team's from the left.
+	StatBarsFillAway bool `yaml:"stat_bars_fill_away,omitempty"`
+
+	// WatchInterval is the number of seconds watch mode shows each live match.
+	// Zero means the default; use WatchRotationInterval to read it.
+	WatchInterval int `yaml:"watch_interval,omitempty"`
+
+	// LiveBatchSize is the number of leagues the live view fetches in parallel per batch.
+	// Bigger batches preload faster but are more likely to hit FotMob rate limits.
+	// Zero means the default; use LiveBatching to read it.
+	LiveBatchSize int `yaml:"live_batch_size,omitempty"`
+
+	// LiveBatchDelayMsec pauses between live view batches, in milliseconds.
+	// Raise it if FotMob starts rejecting requests; zero means no pause.
+	LiveBatchDelayMsec int `yaml:"live_batch_delay_ms,omitempty"`
+
+	// StatsDays is how many days of results the stats view fetches, including today.
+	// More days cost more API calls. Zero means the default; use StatsFetchDays to read it.
+	StatsDays int `yaml:"stats_days,omitempty"`
+
+	// PlayerPhotos shows a thumbnail of the scorer next to each goal, in terminals
+	// supporting the Kitty or iTerm2 image protocols. Off by default.
+	PlayerPhotos bool `yaml:"player_photos,omitempty"`
+
+	// GroupStatsMatches groups the stats view's finished matches under region and
+	// competition headers instead of one flat list. Off by default.
+	GroupStatsMatches bool `yaml:"group_stats_matches,omitempty"`
+
+	// FavoriteTeams lists the FotMob IDs of the user's teams. Their matches are marked
+	// in the match lists, and listed first while the favorites sort is on.
+	FavoriteTeams []int `yaml:"favorite_teams,omitempty"`
+
+	// FavoritesFirst starts with the favorites sort on. It can also be toggled in the app.
+	FavoritesFirst bool `yaml:"favorites_first,omitempty"`
+
+	// ListPanelPercent is the match list's share of the live and stats views' width, in percent.
+	// Adjusted in the app with < and >. Zero means the default; use ListPanelShare to read it.
+	ListPanelPercent int `yaml:"list_panel_percent,omitempty"`
+
+	// ResultsTicker starts with the results ticker shown at the bottom of the live view.
+	// It can also be toggled in the app.
+	ResultsTicker bool `yaml:"results_ticker,omitempty"`
+
+	// ReplayRetryMinutes is how long a goal without a replay link waits before Reddit is
+	// searched again. Zero means the default; use ReplayRetryWindow to read it.
+	ReplayRetryMinutes int `yaml:"replay_retry_minutes,omitempty"`
+}
+
+// StatsFetchDays returns how many days the stats view fetches
+// (default DefaultStatsDays, clamped to MinStatsDays-MaxStatsDays).
+func (s *Settings) StatsFetchDays() int {
+	if s.StatsDays == 0 {
+		return DefaultStatsDays
+	}
+	return min(max(s.StatsDays, MinStatsDays), MaxStatsDays)
+}
+
+// LiveBatching returns the live view batch size and the delay between batches,
+// clamped to MinLiveBatchSize-MaxLiveBatchSize leagues and at most MaxLiveBatchDelayMsec.
+func (s *Settings) LiveBatching() (size int, delay time.Duration) {
+	size = DefaultLiveBatchSize
+	if s.LiveBatchSize != 0 {
+		size = min(max(s.LiveBatchSize, MinLiveBatchSize), MaxLiveBatchSize)
+	}
+	delayMsec := min(max(s.LiveBatchDelayMsec, 0), MaxLiveBatchDelayMsec)
+	return size, time.Duration(delayMsec) * time.Millisecond
+}
+
+// WatchRotationInterval returns how long watch mode shows each live match
+// (default DefaultWatchIntervalSeconds, at least 5 seconds).
+func (s *Settings) WatchRotationInterval() time.Duration {
+	seconds := s.WatchInterval
+	if seconds <= 0 {
+		seconds = DefaultWatchIntervalSeconds
+	}
+	return time.Duration(max(seconds, minWatchIntervalSeconds)) * time.Second
+}
+
+// LiveUpdatesLimit returns how many live updates to keep per match (default DefaultMaxLiveUpdates).
+func (s *Settings) LiveUpdatesLimit() int {
+	if s.MaxLiveUpdates <= 0 {
+		return DefaultMaxLiveUpdates
+	}
+	return s.MaxLiveUpdates
+}
+
+// LogoAnimationEnabled reports whether the main menu logo should animate (default true).
+func (s *Settings) LogoAnimationEnabled() bool {
+	return s.AnimateLogo == nil || *s.AnimateLogo
+}
+
+// ReplayRetryWindow returns how long a "not found" replay search is trusted
+// (default DefaultReplayRetryMinutes, clamped to 1-MaxReplayRetryMinutes minutes).
+func (s *Settings) ReplayRetryWindow() time.Duration {
+	minutes := DefaultReplayRetryMinutes
+	if s.ReplayRetryMinutes != 0 {
+		minutes = min(max(s.ReplayRetryMinutes, 1), MaxReplayRetryMinutes)
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// ListPanelShare returns the match list's share of the width in percent
+// (default DefaultListPanelPercent, clamped to MinListPanelPercent-MaxListPanelPercent).
+func (s *Settings) ListPanelShare() int {
+	if s.ListPanelPercent == 0 {
+		return DefaultListPanelPercent
+	}
+	return min(max(s.ListPanelPercent, MinListPanelPercent), MaxListPanelPercent)
+}
+
+// DisplayLocation returns the configured display time zone.
+// Falls back to the system local zone, with an error, if the name is invalid.
+func (s *Settings) DisplayLocation() (*time.Location, error) {
+	if s.DisplayTimezone == "" || s.DisplayTimezone == "Local" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(s.DisplayTimezone)
+	if err != nil {
+		return time.Local, fmt.Errorf("invalid display_timezone %q: %w", s.DisplayTimezone, err)
+	}
+	return loc, nil
 }
 
 // SettingsPath returns the path to the settings file.
@@ -218,26 +410,57 @@ var DefaultLeagueIDs = []int{
 }
 
 // ActiveLeagueIDs returns the league IDs that should be used for API calls.
-// If no leagues are selected in settings, returns the default leagues (not all).
+// If no leagues are selected in settings, returns the default leagues (not all)
+// plus any custom leagues added in leagues.json.
+// Priority leagues come first, so the live view fetches them in its first batch.
 func ActiveLeagueIDs() []int {
 	settings, err := LoadSettings()
 	if err != nil || len(settings.SelectedLeagues) == 0 {
 		// Return default leagues for efficient API usage
-		return DefaultLeagueIDs
+		ids := slices.Clone(DefaultLeagueIDs)
+		for _, id := range CustomLeagueIDs() {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+		return prioritizeLeagues(ids, settings.PriorityLeagues)
 	}
 
-	return settings.SelectedLeagues
+	return prioritizeLeagues(settings.SelectedLeagues, settings.PriorityLeagues)
 }
 
-// AllLeagueIDs returns all supported league IDs (used as fallback).
+// prioritizeLeagues returns ids with the leagues in priority moved to the front, in
+// priority order, and the rest in their original order. Priority leagues not in ids are
+// ignored, so the list never grows.
+func prioritizeLeagues(ids, priority []int) []int {
+	if len(priority) == 0 {
+		return ids
+	}
+	ordered := make([]int, 0, len(ids))
+	for _, id := range priority {
+		if slices.Contains(ids, id) && !slices.Contains(ordered, id) {
+			ordered = append(ordered, id)
+		}
+	}
+	for _, id := range ids {
+		if !slices.Contains(ordered, id) {
+			ordered = append(ordered, id)
+		}
+	}
+	return ordered
+}
+
+// AllLeagueIDs returns all supported league IDs, including leagues.json additions (used as fallback).
 func AllLeagueIDs() []int {
+	tracked, _ := LoadTrackedLeagues()
+
 	totalLeagues := 0
-	for _, leagues := range AllSupportedLeagues {
+	for _, leagues := range tracked {
 		totalLeagues += len(leagues)
 	}
 
 	ids := make([]int, 0, totalLeagues)
-	for _, leagues := range AllSupportedLeagues {
+	for _, leagues := range tracked {
 		for _, league := range leagues {
 			ids = append(ids, league.ID)
 		}
@@ -255,7 +478,8 @@ func GetAllRegions() []string {
 	return []string{RegionEurope, RegionAmerica, RegionGlobal}
 }
 
-// GetLeaguesForRegion returns all leagues for a specific region.
+// GetLeaguesForRegion returns all leagues for a specific region, including leagues.json entries.
 func GetLeaguesForRegion(region string) []LeagueInfo {
-	return AllSupportedLeagues[region]
+	tracked, _ := LoadTrackedLeagues()
+	return tracked[region]
 }
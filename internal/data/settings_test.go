@@ -0,0 +1,169 @@
+package data
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDisplayLocation(t *testing.T) {
+	tests := []struct {
+		zone    string
+		want    string
+		wantErr bool
+	}{
+		{"", time.Local.String(), false},
+		{"Local", time.Local.String(), false},
+		{"UTC", "UTC", false},
+		{"Not/AZone", time.Local.String(), true},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{DisplayTimezone: tt.zone}
+		loc, err := s.DisplayLocation()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("DisplayLocation(%q) error = %v, wantErr %v", tt.zone, err, tt.wantErr)
+		}
+		if loc.String() != tt.want {
+			t.Errorf("DisplayLocation(%q) = %s, want %s", tt.zone, loc, tt.want)
+		}
+	}
+}
+
+func TestLogoAnimationEnabled(t *testing.T) {
+	disabled, enabled := false, true
+	tests := []struct {
+		setting *bool
+		want    bool
+	}{
+		{nil, true},
+		{&enabled, true},
+		{&disabled, false},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{AnimateLogo: tt.setting}
+		if got := s.LogoAnimationEnabled(); got != tt.want {
+			t.Errorf("LogoAnimationEnabled() with %v = %v, want %v", tt.setting, got, tt.want)
+		}
+	}
+}
+
+func TestLiveUpdatesLimit(t *testing.T) {
+	tests := []struct {
+		setting int
+		want    int
+	}{
+		{0, DefaultMaxLiveUpdates},
+		{-5, DefaultMaxLiveUpdates},
+		{20, 20},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{MaxLiveUpdates: tt.setting}
+		if got := s.LiveUpdatesLimit(); got != tt.want {
+			t.Errorf("LiveUpdatesLimit() with %d = %d, want %d", tt.setting, got, tt.want)
+		}
+	}
+}
+
+func TestLiveBatching(t *testing.T) {
+	tests := []struct {
+		size, delayMsec int
+		wantSize        int
+		wantDelay       time.Duration
+	}{
+		{0, 0, DefaultLiveBatchSize, 0},
+		{8, 250, 8, 250 * time.Millisecond},
+		{-3, -100, MinLiveBatchSize, 0},
+		{100, 60000, MaxLiveBatchSize, MaxLiveBatchDelayMsec * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{LiveBatchSize: tt.size, LiveBatchDelayMsec: tt.delayMsec}
+		size, delay := s.LiveBatching()
+		if size != tt.wantSize || delay != tt.wantDelay {
+			t.Errorf("LiveBatching() with %d, %dms = %d, %v, want %d, %v", tt.size, tt.delayMsec, size, delay, tt.wantSize, tt.wantDelay)
+		}
+	}
+}
+
+func TestStatsFetchDays(t *testing.T) {
+	tests := []struct {
+		setting int
+		want    int
+	}{
+		{0, DefaultStatsDays},
+		{7, 7},
+		{1, MinStatsDays},
+		{-2, MinStatsDays},
+		{30, MaxStatsDays},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{StatsDays: tt.setting}
+		if got := s.StatsFetchDays(); got != tt.want {
+			t.Errorf("StatsFetchDays() with %d = %d, want %d", tt.setting, got, tt.want)
+		}
+	}
+}
+
+func TestListPanelShare(t *testing.T) {
+	tests := []struct {
+		setting int
+		want    int
+	}{
+		{0, DefaultListPanelPercent},
+		{45, 45},
+		{10, MinListPanelPercent},
+		{-5, MinListPanelPercent},
+		{80, MaxListPanelPercent},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{ListPanelPercent: tt.setting}
+		if got := s.ListPanelShare(); got != tt.want {
+			t.Errorf("ListPanelShare() with %d = %d, want %d", tt.setting, got, tt.want)
+		}
+	}
+}
+
+func TestReplayRetryWindow(t *testing.T) {
+	tests := []struct {
+		setting int
+		want    time.Duration
+	}{
+		{0, DefaultReplayRetryMinutes * time.Minute},
+		{30, 30 * time.Minute},
+		{-5, time.Minute},
+		{100000, MaxReplayRetryMinutes * time.Minute},
+	}
+
+	for _, tt := range tests {
+		s := &Settings{ReplayRetryMinutes: tt.setting}
+		if got := s.ReplayRetryWindow(); got != tt.want {
+			t.Errorf("ReplayRetryWindow() with %d = %v, want %v", tt.setting, got, tt.want)
+		}
+	}
+}
+
+func TestPrioritizeLeagues(t *testing.T) {
+	ids := []int{87, 54, 47, 55}
+	tests := []struct {
+		priority []int
+		want     []int
+	}{
+		{nil, []int{87, 54, 47, 55}},
+		{[]int{47}, []int{47, 87, 54, 55}},
+		{[]int{55, 47}, []int{55, 47, 87, 54}},
+		{[]int{130, 47, 47}, []int{47, 87, 54, 55}}, // Unfollowed and repeated leagues are ignored
+	}
+	for _, tt := range tests {
+		if got := prioritizeLeagues(ids, tt.priority); !slices.Equal(got, tt.want) {
+			t.Errorf("prioritizeLeagues(%v) = %v, want %v", tt.priority, got, tt.want)
+		}
+	}
+	if !slices.Equal(ids, []int{87, 54, 47, 55}) {
+		t.Errorf("prioritizeLeagues modified its input: %v", ids)
+	}
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Snapshot kinds; each is stored in its own file in CacheDir.
+const (
+	SnapshotLive  = "live"
+	SnapshotStats = "stats"
+)
+
+// Snapshot is the last successfully fetched data of a view ("last known good").
+// It is shown when a fresh fetch fails, e.g. while the network is down.
+type Snapshot struct {
+	SavedAt     time.Time      `json:"saved_at"`
+	LiveMatches []api.Match    `json:"live_matches,omitempty"` // SnapshotLive
+	Stats       *api.StatsData `json:"stats,omitempty"`        // SnapshotStats
+}
+
+// snapshotPath returns the file a snapshot kind is stored in.
+func snapshotPath(kind string) (string, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("snapshot_%s.json", kind)), nil
+}
+
+// SaveSnapshot stores snap as the last known good data of kind, replacing the previous one.
+// SavedAt is set to now when it is zero.
+func SaveSnapshot(kind string, snap Snapshot) error {
+	path, err := snapshotPath(kind)
+	if err != nil {
+		return err
+	}
+
+	if snap.SavedAt.IsZero() {
+		snap.SavedAt = time.Now()
+	}
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	// Write then rename so a crash mid-write never leaves a truncated snapshot behind
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadSnapshot returns the last known good data of kind.
+// Returns nil without an error if none was saved yet.
+func LoadSnapshot(kind string) (*Snapshot, error) {
+	path, err := snapshotPath(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+	return &snap, nil
+}
@@ -0,0 +1,45 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if snap, err := LoadSnapshot(SnapshotLive); snap != nil || err != nil {
+		t.Fatalf("LoadSnapshot() before any save = %v, %v; want nil, nil", snap, err)
+	}
+
+	matches := MockLiveMatches()
+	if err := SaveSnapshot(SnapshotLive, Snapshot{LiveMatches: matches}); err != nil {
+		t.Fatalf("SaveSnapshot() error = %v", err)
+	}
+	stats := &api.StatsData{AllFinished: MockFinishedMatches()}
+	if err := SaveSnapshot(SnapshotStats, Snapshot{Stats: stats}); err != nil {
+		t.Fatalf("SaveSnapshot() error = %v", err)
+	}
+
+	live, err := LoadSnapshot(SnapshotLive)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if len(live.LiveMatches) != len(matches) || live.Stats != nil {
+		t.Errorf("live snapshot has %d matches and stats %v, want %d and none", len(live.LiveMatches), live.Stats, len(matches))
+	}
+	if time.Since(live.SavedAt) > time.Minute {
+		t.Errorf("SavedAt = %v, want about now", live.SavedAt)
+	}
+
+	got, err := LoadSnapshot(SnapshotStats)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+	if got.Stats == nil || len(got.Stats.AllFinished) != len(stats.AllFinished) {
+		t.Errorf("stats snapshot = %+v, want %d finished matches", got.Stats, len(stats.AllFinished))
+	}
+}
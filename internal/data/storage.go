@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -20,32 +21,34 @@ const (
 // ConfigDir returns the path to the golazo config directory.
 // On Linux, follows XDG Base Directory spec (~/.config/golazo).
 // On other systems (macOS, Windows), uses ~/.golazo.
+// Falls back to a temp directory when it can't be resolved or created, see tempFallbackDir.
 func ConfigDir() (string, error) {
-	var configPath string
+	configPath, err := userConfigPath()
+	if err == nil {
+		err = ensureDir(configPath, "config")
+	}
+	if err != nil {
+		return tempFallbackDir("config", err)
+	}
+	return configPath, nil
+}
 
+// userConfigPath returns where the config directory belongs, without creating it.
+func userConfigPath() (string, error) {
 	if runtime.GOOS == "linux" {
 		if xdgConfig := os.Getenv("XDG_CONFIG_HOME"); xdgConfig != "" {
-			configPath = filepath.Join(xdgConfig, "golazo")
-		} else {
-			homeDir, err := os.UserHomeDir()
-			if err != nil {
-				return "", fmt.Errorf("get home directory: %w", err)
-			}
-			configPath = filepath.Join(homeDir, ".config", "golazo")
+			return filepath.Join(xdgConfig, "golazo"), nil
 		}
-	} else {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return "", fmt.Errorf("get home directory: %w", err)
-		}
-		configPath = filepath.Join(homeDir, configDir)
 	}
 
-	if err := os.MkdirAll(configPath, 0755); err != nil {
-		return "", fmt.Errorf("create config directory: %w", err)
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
 	}
-
-	return configPath, nil
+	if runtime.GOOS == "linux" {
+		return filepath.Join(homeDir, ".config", "golazo"), nil
+	}
+	return filepath.Join(homeDir, configDir), nil
 }
 
 // CacheDir returns the path to the golazo cache directory.
@@ -53,20 +56,104 @@ func ConfigDir() (string, error) {
 //   - Linux: ~/.cache/golazo (or $XDG_CACHE_HOME/golazo)
 //   - macOS: ~/Library/Caches/golazo
 //   - Windows: %LocalAppData%/golazo
+//
+// Falls back to a temp directory when it can't be resolved or created, see tempFallbackDir.
 func CacheDir() (string, error) {
 	userCache, err := os.UserCacheDir()
 	if err != nil {
-		return "", fmt.Errorf("get user cache directory: %w", err)
+		return tempFallbackDir("cache", fmt.Errorf("get user cache directory: %w", err))
 	}
 
 	cachePath := filepath.Join(userCache, "golazo")
-	if err := os.MkdirAll(cachePath, 0755); err != nil {
-		return "", fmt.Errorf("create cache directory: %w", err)
+	if err := ensureDir(cachePath, "cache"); err != nil {
+		return tempFallbackDir("cache", err)
 	}
-
 	return cachePath, nil
 }
 
+// ensureDir creates the kind ("config" or "cache") directory at path if it is missing.
+func ensureDir(path, kind string) error {
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return fmt.Errorf("create %s directory: %w", kind, err)
+	}
+	return nil
+}
+
+var (
+	storageMu      sync.Mutex
+	storageWarning func(message string)
+	fallbackDirs   = make(map[string]bool)
+)
+
+// SetStorageWarningHandler sets where a switch to a temp directory is reported, see
+// tempFallbackDir. Each fallback directory is reported once; nil turns reporting off.
+func SetStorageWarningHandler(warn func(message string)) {
+	storageMu.Lock()
+	defer storageMu.Unlock()
+	storageWarning = warn
+}
+
+// IsFallbackDir reports whether dir is a temp directory ConfigDir or CacheDir fell back to.
+func IsFallbackDir(dir string) bool {
+	storageMu.Lock()
+	defer storageMu.Unlock()
+	return fallbackDirs[dir]
+}
+
+// tempFallbackDir returns golazo's kind ("config" or "cache") directory under the system temp
+// directory, for when the usual one is unavailable because of cause, as in some sandboxes.
+// The app keeps running, but what it saves there doesn't outlive the temp directory.
+func tempFallbackDir(kind string, cause error) (string, error) {
+	root, err := privateTempDir()
+	if err != nil {
+		return "", fmt.Errorf("%w (temp directory fallback: %v)", cause, err)
+	}
+	dir := filepath.Join(root, kind)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("%w (temp directory fallback: %v)", cause, err)
+	}
+
+	storageMu.Lock()
+	warn := storageWarning
+	if fallbackDirs[dir] {
+		warn = nil
+	}
+	fallbackDirs[dir] = true
+	storageMu.Unlock()
+
+	if warn != nil {
+		warn(fmt.Sprintf("%s directory unavailable (%v), using %s: nothing saved there is kept for long", kind, cause, dir))
+	}
+	return dir, nil
+}
+
+// privateTempDir returns the current user's golazo directory in the shared temp directory,
+// creating it readable by that user only. One that already exists must be a real directory
+// owned by the user, so nobody else can plant the settings or key bindings golazo loads.
+func privateTempDir() (string, error) {
+	root := filepath.Join(os.TempDir(), tempDirName())
+	if err := os.Mkdir(root, 0700); err != nil && !os.IsExist(err) {
+		return "", err
+	}
+
+	info, err := os.Lstat(root)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", root)
+	}
+	if !ownedByCurrentUser(info) {
+		return "", fmt.Errorf("%s belongs to another user", root)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		if err := os.Chmod(root, 0700); err != nil {
+			return "", err
+		}
+	}
+	return root, nil
+}
+
 // MockDataPath returns the path to the mock data file.
 func MockDataPath() (string, error) {
 	dir, err := ConfigDir()
@@ -84,6 +171,7 @@ type LiveUpdate struct {
 }
 
 // SaveLiveUpdate appends a live update to the storage.
+// Only the most recent max_live_updates entries (see Settings.LiveUpdatesLimit) are kept.
 func SaveLiveUpdate(matchID int, update string) error {
 	dir, err := ConfigDir()
 	if err != nil {
@@ -107,6 +195,11 @@ func SaveLiveUpdate(matchID int, update string) error {
 		Time:    time.Now(),
 	})
 
+	settings, _ := LoadSettings()
+	if limit := settings.LiveUpdatesLimit(); len(updates) > limit {
+		updates = updates[len(updates)-limit:]
+	}
+
 	data, err := json.Marshal(updates)
 	if err != nil {
 		return fmt.Errorf("marshal updates: %w", err)
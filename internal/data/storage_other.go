@@ -0,0 +1,15 @@
+//go:build !unix
+
+package data
+
+import "os"
+
+// tempDirName names the fallback directory in the temp directory, which is per user here.
+func tempDirName() string {
+	return "golazo"
+}
+
+// ownedByCurrentUser reports true: the temp directory itself already belongs to the user.
+func ownedByCurrentUser(os.FileInfo) bool {
+	return true
+}
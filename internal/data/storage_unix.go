@@ -0,0 +1,20 @@
+//go:build unix
+
+package data
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// tempDirName names the fallback directory in the shared temp directory after the user ID.
+func tempDirName() string {
+	return fmt.Sprintf("golazo-%d", os.Getuid())
+}
+
+// ownedByCurrentUser reports whether the file described by info belongs to the current user.
+func ownedByCurrentUser(info os.FileInfo) bool {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	return ok && int(stat.Uid) == os.Getuid()
+}
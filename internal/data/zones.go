@@ -0,0 +1,68 @@
+package data
+
+import "fmt"
+
+// TableZones holds how many places of a league table qualify for European competitions
+// or are relegated. A zero count means the league has no such zone.
+type TableZones struct {
+	ChampionsLeagueSpots int // Top places qualifying for the Champions League
+	EuropaSpots          int // Places after those qualifying for the Europa or Conference League
+	RelegationSpots      int // Bottom places relegated
+}
+
+// TableZone is the zone a league table position falls in.
+type TableZone int
+
+// Table zones, from the top of the table down.
+const (
+	ZoneNone TableZone = iota
+	ZoneChampionsLeague
+	ZoneEuropa
+	ZoneRelegation
+)
+
+// IsZero reports whether the league has no zones.
+func (z TableZones) IsZero() bool {
+	return z == TableZones{}
+}
+
+// Validate checks that no zone is negative and that the zones fit in a table of teams
+// places. Zones that overlap still color the table, with the higher zone winning.
+func (z TableZones) Validate(teams int) error {
+	if z.ChampionsLeagueSpots < 0 || z.EuropaSpots < 0 || z.RelegationSpots < 0 {
+		return fmt.Errorf("negative table zone in %+v", z)
+	}
+	if total := z.ChampionsLeagueSpots + z.EuropaSpots + z.RelegationSpots; teams > 0 && total > teams {
+		return fmt.Errorf("table zones cover %d places but the table has %d teams", total, teams)
+	}
+	return nil
+}
+
+// Zone returns the zone of position (1 = top) in a table of teams places.
+func (z TableZones) Zone(position, teams int) TableZone {
+	switch {
+	case position <= 0:
+		return ZoneNone
+	case position <= z.ChampionsLeagueSpots:
+		return ZoneChampionsLeague
+	case position <= z.ChampionsLeagueSpots+z.EuropaSpots:
+		return ZoneEuropa
+	case z.RelegationSpots > 0 && position > teams-z.RelegationSpots:
+		return ZoneRelegation
+	default:
+		return ZoneNone
+	}
+}
+
+// LeagueZones returns the table zones of a tracked league, or none if it isn't known.
+func LeagueZones(leagueID int) TableZones {
+	tracked, _ := LoadTrackedLeagues()
+	for _, leagues := range tracked {
+		for _, league := range leagues {
+			if league.ID == leagueID {
+				return league.Zones
+			}
+		}
+	}
+	return TableZones{}
+}
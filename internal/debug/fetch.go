@@ -5,6 +5,7 @@ package debug
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -31,7 +32,7 @@ func FetchLeagueData(ctx context.Context, leagueID int, date time.Time, tab stri
 	if err != nil {
 		return nil, nil, fmt.Errorf("create request: %w", err)
 	}
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("User-Agent", fotmob.UserAgent())
 
 	client := &http.Client{Timeout: 15 * time.Second}
 	resp, err := client.Do(req)
@@ -71,7 +72,7 @@ func FetchMatchDetails(ctx context.Context, matchID int) (map[string]any, *api.M
 	if err != nil {
 		return nil, nil, fmt.Errorf("create request: %w", err)
 	}
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("User-Agent", fotmob.UserAgent())
 
 	client := &http.Client{Timeout: 15 * time.Second}
 	resp, err := client.Do(req)
@@ -99,7 +100,7 @@ func FetchMatchDetails(ctx context.Context, matchID int) (map[string]any, *api.M
 	// Use the fotmob client to get properly converted details
 	fotmobClient := fotmob.NewClient()
 	details, err := fotmobClient.MatchDetails(ctx, matchID)
-	if err != nil {
+	if err != nil && !errors.Is(err, api.ErrPartialDetails) {
 		// Return raw response even if conversion fails
 		return rawResponse, nil, nil
 	}
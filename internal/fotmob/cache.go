@@ -88,6 +88,14 @@ func (c *ResponseCache) SetMatches(dateKey string, matches []api.Match) {
 	}
 }
 
+// ClearMatches clears all cached match list results.
+// Use this to force the next date query to hit the API again.
+func (c *ResponseCache) ClearMatches() {
+	c.matchesMu.Lock()
+	defer c.matchesMu.Unlock()
+	c.matchesCache = make(map[string]cachedMatches)
+}
+
 // Details retrieves cached match details, returns nil if not cached or expired.
 func (c *ResponseCache) Details(matchID int) *api.MatchDetails {
 	c.detailsMu.RLock()
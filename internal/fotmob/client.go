@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -15,11 +16,32 @@ import (
 
 const (
 	baseURL = "https://www.fotmob.com/api"
+
+	// DefaultUserAgent is sent to FotMob when no User-Agent is configured.
+	DefaultUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+	// UserAgentEnvVar names the environment variable that overrides the default User-Agent,
+	// an escape hatch for when FotMob starts blocking it.
+	UserAgentEnvVar = "GOLAZO_USER_AGENT"
 )
 
+// PlayerImageURL returns the URL of a player's FotMob photo, a small PNG.
+func PlayerImageURL(playerID int) string {
+	return fmt.Sprintf("https://images.fotmob.com/image_resources/playerimages/%d.png", playerID)
+}
+
+// UserAgent returns the User-Agent for FotMob requests: $GOLAZO_USER_AGENT if set,
+// DefaultUserAgent otherwise.
+func UserAgent() string {
+	if ua := strings.TrimSpace(os.Getenv(UserAgentEnvVar)); ua != "" {
+		return ua
+	}
+	return DefaultUserAgent
+}
+
 // ActiveLeagues returns the league IDs to use for API calls.
 // This respects user settings - if specific leagues are selected, only those are returned.
-// If no selection is made, returns all supported leagues.
+// If no selection is made, returns the default leagues plus any added in leagues.json.
 func ActiveLeagues() []int {
 	return data.ActiveLeagueIDs()
 }
@@ -28,13 +50,26 @@ func ActiveLeagues() []int {
 // Use ActiveLeagues() for dynamic league selection based on user preferences.
 var SupportedLeagues = data.AllLeagueIDs()
 
-// Client implements the api.Client interface for FotMob API
+// Client implements the api.Client and api.MatchService interfaces for FotMob API
 type Client struct {
 	httpClient  *http.Client
 	baseURL     string
 	rateLimiter *RateLimiter
 	cache       *ResponseCache
 	emptyCache  *EmptyResultsCache // Persistent cache for empty league+date combinations
+	userAgent   string
+	// statsConcurrency is how many days StatsData fetches at once.
+	statsConcurrency int
+}
+
+var _ api.MatchService = (*Client)(nil)
+
+// ClientOptions configures a FotMob client. Zero values use the defaults.
+type ClientOptions struct {
+	// UserAgent is sent with every request. Empty uses UserAgent().
+	UserAgent string
+	// StatsConcurrency is how many days StatsData fetches at once. Zero uses DefaultStatsConcurrency.
+	StatsConcurrency int
 }
 
 // NewClient creates a new FotMob API client with default configuration.
@@ -42,6 +77,21 @@ type Client struct {
 // Uses default caching configuration for improved performance.
 // Initializes persistent empty results cache to skip known empty league+date combinations.
 func NewClient() *Client {
+	return NewClientWithOptions(ClientOptions{})
+}
+
+// NewClientWithOptions creates a FotMob API client like NewClient, applying opts.
+func NewClientWithOptions(opts ClientOptions) *Client {
+	userAgent := opts.UserAgent
+	if userAgent == "" {
+		userAgent = UserAgent()
+	}
+
+	statsConcurrency := opts.StatsConcurrency
+	if statsConcurrency <= 0 {
+		statsConcurrency = DefaultStatsConcurrency
+	}
+
 	// Initialize empty results cache (logs error but doesn't fail)
 	emptyCache, err := NewEmptyResultsCache()
 	if err != nil {
@@ -57,6 +107,9 @@ func NewClient() *Client {
 		rateLimiter: NewRateLimiter(200 * time.Millisecond), // Minimal delay for concurrent requests
 		cache:       NewResponseCache(DefaultCacheConfig()),
 		emptyCache:  emptyCache,
+		userAgent:   userAgent,
+
+		statsConcurrency: statsConcurrency,
 	}
 }
 
@@ -147,7 +200,7 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 					return
 				}
 
-				req.Header.Set("User-Agent", "Mozilla/5.0")
+				req.Header.Set("User-Agent", c.userAgent)
 
 				resp, err := c.httpClient.Do(req)
 				if err != nil {
@@ -164,7 +217,7 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 						CountryCode string `json:"countryCode,omitempty"`
 					} `json:"details"`
 					Fixtures struct {
-						AllMatches []fotmobMatch `json:"allMatches"`
+						AllMatches fotmobMatches `json:"allMatches"`
 					} `json:"fixtures"`
 				}
 
@@ -249,7 +302,7 @@ func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date
 		return nil, fmt.Errorf("create request for league %d: %w", leagueID, err)
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("User-Agent", c.userAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -265,7 +318,7 @@ func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date
 			CountryCode string `json:"countryCode,omitempty"`
 		} `json:"details"`
 		Fixtures struct {
-			AllMatches []fotmobMatch `json:"allMatches"`
+			AllMatches fotmobMatches `json:"allMatches"`
 		} `json:"fixtures"`
 	}
 
@@ -321,7 +374,7 @@ func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetai
 		return nil, fmt.Errorf("create request for match %d: %w", matchID, err)
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("User-Agent", c.userAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -339,12 +392,13 @@ func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetai
 		return nil, fmt.Errorf("decode match details response for match %d: %w", matchID, err)
 	}
 
-	details := response.toAPIMatchDetails()
+	// A schema change in one section yields partial details and an api.ErrPartialDetails error
+	details, err := response.toAPIMatchDetails()
 
 	// Cache the result
 	c.cache.SetDetails(matchID, details)
 
-	return details, nil
+	return details, err
 }
 
 // MatchDetailsForceRefresh fetches match details, bypassing the cache.
@@ -367,14 +421,8 @@ func (c *Client) BatchMatchDetails(ctx context.Context, matchIDs []int) map[int]
 		go func(matchID int) {
 			defer wg.Done()
 
-			details, err := c.MatchDetails(ctx, matchID)
-			if err != nil {
-				// Store nil for failed fetches
-				mu.Lock()
-				results[matchID] = nil
-				mu.Unlock()
-				return
-			}
+			// Failed fetches store nil; partial details are kept
+			details, _ := c.MatchDetails(ctx, matchID)
 
 			mu.Lock()
 			results[matchID] = details
@@ -501,7 +549,7 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 		return nil, fmt.Errorf("create request for league %d table: %w", leagueID, err)
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0")
+	req.Header.Set("User-Agent", c.userAgent)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
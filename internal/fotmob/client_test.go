@@ -0,0 +1,251 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestUserAgent(t *testing.T) {
+	t.Setenv(UserAgentEnvVar, "")
+	if got := UserAgent(); got != DefaultUserAgent {
+		t.Errorf("UserAgent() = %q, want the default", got)
+	}
+
+	t.Setenv(UserAgentEnvVar, "golazo-test/1.0")
+	if got := UserAgent(); got != "golazo-test/1.0" {
+		t.Errorf("UserAgent() = %q, want the environment override", got)
+	}
+}
+
+func TestClientSendsConfiguredUserAgent(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(ClientOptions{UserAgent: "golazo-test/2.0"})
+	client.baseURL = server.URL
+
+	if _, err := client.MatchDetails(context.Background(), 1); err != nil {
+		t.Fatalf("MatchDetails() error = %v", err)
+	}
+	if got != "golazo-test/2.0" {
+		t.Errorf("User-Agent header = %q, want %q", got, "golazo-test/2.0")
+	}
+}
+
+func TestCommentary(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var feed string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ltcUrl") == "data.fotmob.com/webcl/ltc/gsm/404_en.json.gz" {
+			http.NotFound(w, r)
+			return
+		}
+		feed = r.URL.Query().Get("ltcUrl")
+		_, _ = w.Write([]byte(`{"events":[
+			{"eventId":3,"elapsed":45,"elapsedPlus":2,"text":"Half-time whistle.\n"},
+			{"eventId":2,"elapsed":44,"text":"  "},
+			{"elapsed":1,"text":"Kick-off!"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+
+	entries, err := client.Commentary(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Commentary() error = %v", err)
+	}
+	if want := "data.fotmob.com/webcl/ltc/gsm/42_en.json.gz"; feed != want {
+		t.Errorf("ltcUrl = %q, want %q", feed, want)
+	}
+	want := []api.CommentaryEntry{
+		{ID: "3", Minute: 45, AddedTime: 2, Text: "Half-time whistle."},
+		{ID: "1+0:Kick-off!", Minute: 1, Text: "Kick-off!"},
+	}
+	if !slices.Equal(entries, want) {
+		t.Errorf("Commentary() = %+v, want %+v", entries, want)
+	}
+	if got := entries[0].MinuteLabel(); got != "45+2" {
+		t.Errorf("MinuteLabel() = %q, want 45+2", got)
+	}
+
+	if entries, err := client.Commentary(context.Background(), 404); err != nil || len(entries) != 0 {
+		t.Errorf("Commentary() without a feed = %v, %v; want no entries and no error", entries, err)
+	}
+}
+
+func TestStatsDataCollectsEveryDay(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Every league reports the same finished match on each of the last three days
+	now := time.Now().UTC()
+	var matches []string
+	for i := range 3 {
+		kickoff := now.AddDate(0, 0, -i).Format(time.RFC3339)
+		matches = append(matches, fmt.Sprintf(`{"id":"%d","status":{"utcTime":%q,"started":true,"finished":true}}`, 100+i, kickoff))
+	}
+	body := fmt.Sprintf(`{"fixtures":{"allMatches":[%s]}}`, strings.Join(matches, ","))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(ClientOptions{StatsConcurrency: 2})
+	client.baseURL = server.URL
+	client.rateLimiter = NewRateLimiter(0)
+
+	stats, err := client.StatsData(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("StatsData() error = %v", err)
+	}
+	var ids []int
+	for _, match := range stats.AllFinished {
+		ids = append(ids, match.ID)
+	}
+	slices.Sort(ids)
+	if want := []int{100, 101, 102}; !slices.Equal(ids, want) {
+		t.Errorf("AllFinished IDs = %v, want %v", ids, want)
+	}
+	if len(stats.TodayFinished) != 1 || stats.TodayFinished[0].ID != 100 {
+		t.Errorf("TodayFinished = %+v, want only match 100", stats.TodayFinished)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.StatsData(ctx, 3); !errors.Is(err, context.Canceled) {
+		t.Errorf("StatsData() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
+
+func TestTeamStats(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("id") {
+		case "8456": // A club in a league table
+			_, _ = w.Write([]byte(`{
+				"details": {"id": 8456, "name": "Manchester City", "shortName": "Man City", "latestSeason": "2025/2026"},
+				"overview": {
+					"table": [{"data": {"leagueName": "Premier League", "table": {"all": [
+						{"id": 9825, "name": "Arsenal", "idx": 1, "played": 16, "wins": 12, "draws": 2, "losses": 2, "scoresStr": "33-10", "goalConDiff": 23, "pts": 38},
+						{"id": 8456, "name": "Manchester City", "idx": 2, "played": 16, "wins": 11, "draws": 3, "losses": 2, "scoresStr": "36-14", "goalConDiff": 22, "pts": 36}
+					]}}}],
+					"teamForm": [{"resultString": "W"}, {"resultString": "D"}, {"resultString": "L"}]
+				}
+			}`))
+		default: // A national team, without a league table
+			_, _ = w.Write([]byte(`{"details": {"id": 8256, "name": "Brazil"}, "overview": {"table": []}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+
+	stats, err := client.TeamStats(context.Background(), 8456)
+	if err != nil {
+		t.Fatalf("TeamStats() error = %v", err)
+	}
+	if stats.Record == nil || stats.Record.Position != 2 || stats.Record.GoalsFor != 36 || stats.Record.Points != 36 {
+		t.Errorf("TeamStats().Record = %+v, want Man City's row", stats.Record)
+	}
+	if stats.LeagueName != "Premier League" || stats.Season != "2025/2026" || !slices.Equal(stats.Form, []string{"W", "D", "L"}) {
+		t.Errorf("TeamStats() = %+v, want the league, season and form", stats)
+	}
+
+	stats, err = client.TeamStats(context.Background(), 8256)
+	if err != nil || stats.Record != nil || stats.Team.Name != "Brazil" {
+		t.Errorf("TeamStats() of a national team = %+v, %v; want no record and no error", stats, err)
+	}
+}
+
+func TestLiveMatchesForLeagueSkipsFinished(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// The live view only lists matches in progress; finished ones drop out on the next refresh
+	kickoff := time.Now().Format(time.RFC3339)
+	body := fmt.Sprintf(`{"fixtures":{"allMatches":[
+		{"id":"1","status":{"utcTime":%[1]q,"started":true,"finished":false}},
+		{"id":"2","status":{"utcTime":%[1]q,"started":true,"finished":true}},
+		{"id":"3","status":{"utcTime":%[1]q,"started":false,"finished":false}}
+	]}}`, kickoff)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+	client.rateLimiter = NewRateLimiter(0)
+
+	matches, err := client.LiveMatchesForLeague(context.Background(), 47)
+	if err != nil {
+		t.Fatalf("LiveMatchesForLeague() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].ID != 1 {
+		t.Errorf("LiveMatchesForLeague() = %+v, want only the match in progress", matches)
+	}
+}
+
+func TestLiveMatchesKeepsOnlyLive(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// A day mixing every status; only the match in progress is live
+	kickoff := time.Now().Format(time.RFC3339)
+	body := fmt.Sprintf(`{"fixtures":{"allMatches":[
+		{"id":"1","status":{"utcTime":%[1]q,"started":true,"finished":false,"liveTime":{"short":"63'"}}},
+		{"id":"2","status":{"utcTime":%[1]q,"started":true,"finished":true}},
+		{"id":"3","status":{"utcTime":%[1]q,"started":false,"finished":false}},
+		{"id":"4","status":{"utcTime":%[1]q,"started":false,"finished":false,"reason":{"short":"PP"}}},
+		{"id":"5","status":{"utcTime":%[1]q,"started":true,"finished":false,"cancelled":true}}
+	]}}`, kickoff)
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("id") != "47" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.baseURL = server.URL
+	client.rateLimiter = NewRateLimiter(0)
+
+	matches, err := client.LiveMatches(context.Background())
+	if err != nil {
+		t.Fatalf("LiveMatches() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].ID != 1 || matches[0].Status != api.MatchStatusLive {
+		t.Fatalf("LiveMatches() = %+v, want only the match in progress", matches)
+	}
+
+	// Polling again within the cache TTL makes no new requests
+	fetched := requests.Load()
+	if _, err := client.LiveMatches(context.Background()); err != nil {
+		t.Fatalf("LiveMatches() error = %v", err)
+	}
+	if got := requests.Load(); got != fetched {
+		t.Errorf("second LiveMatches() made %d requests, want them served from the cache", got-fetched)
+	}
+}
@@ -0,0 +1,101 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// commentaryFeedURL is the English live ticker feed of a match, fetched through the ltc endpoint.
+const commentaryFeedURL = "data.fotmob.com/webcl/ltc/gsm/%d_en.json.gz"
+
+// fotmobCommentary is the live ticker (ltc) response.
+type fotmobCommentary struct {
+	Events []fotmobCommentaryEvent `json:"events"`
+}
+
+// fotmobCommentaryEvent is one line of the live ticker.
+type fotmobCommentaryEvent struct {
+	EventID     json.Number `json:"eventId"`
+	Elapsed     int         `json:"elapsed"`
+	ElapsedPlus int         `json:"elapsedPlus"`
+	Text        string      `json:"text"`
+}
+
+// Commentary retrieves the text commentary of a match, newest first.
+// Matches FotMob has no commentary for return no entries and no error.
+// Commentary changes every poll, so it is never cached.
+func (c *Client) Commentary(ctx context.Context, matchID int) ([]api.CommentaryEntry, error) {
+	c.rateLimiter.Wait()
+
+	feed := fmt.Sprintf(commentaryFeedURL, matchID)
+	requestURL := fmt.Sprintf("%s/ltc?ltcUrl=%s", c.baseURL, url.QueryEscape(feed))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create commentary request for match %d: %w", matchID, err)
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch commentary for match %d: %w", matchID, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for commentary of match %d", resp.StatusCode, matchID)
+	}
+
+	var response fotmobCommentary
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode commentary for match %d: %w", matchID, err)
+	}
+
+	return response.toAPICommentary(), nil
+}
+
+// toAPICommentary converts the live ticker to commentary entries, skipping empty lines.
+// Events without an ID get one from their minute and text, so they still deduplicate.
+func (r fotmobCommentary) toAPICommentary() []api.CommentaryEntry {
+	entries := make([]api.CommentaryEntry, 0, len(r.Events))
+	for _, event := range r.Events {
+		text := strings.Join(strings.Fields(event.Text), " ")
+		if text == "" {
+			continue
+		}
+
+		id := event.EventID.String()
+		if id == "" {
+			id = strconv.Itoa(event.Elapsed) + "+" + strconv.Itoa(event.ElapsedPlus) + ":" + text
+		}
+
+		entries = append(entries, api.CommentaryEntry{
+			ID:        id,
+			Minute:    event.Elapsed,
+			AddedTime: event.ElapsedPlus,
+			Text:      text,
+		})
+	}
+	return entries
+}
+
+// FormatCommentary converts commentary entries into live update strings, keeping their order.
+// Format: SYMBOL TIME' text, with an empty TIME before kickoff.
+func (p *LiveUpdateParser) FormatCommentary(entries []api.CommentaryEntry) []string {
+	updates := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		updates = append(updates, fmt.Sprintf("%s %s' %s", EventPrefixCommentary, entry.MinuteLabel(), entry.Text))
+	}
+	return updates
+}
@@ -106,7 +106,7 @@ func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTe
 	sorted := make([]api.MatchEvent, len(events))
 	copy(sorted, events)
 	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Minute > sorted[j].Minute
+		return sorted[i].SortKey() > sorted[j].SortKey()
 	})
 
 	updates := make([]string, 0, len(sorted))
@@ -122,11 +122,13 @@ func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTe
 
 // Event type prefixes for visual identification (used by UI for coloring)
 const (
-	EventPrefixGoal        = "●" // Solid circle - goals (red)
-	EventPrefixYellowCard  = "▪" // Square - yellow card (cyan)
-	EventPrefixRedCard     = "■" // Filled square - red card (red)
+	EventPrefixGoal         = "●" // Solid circle - goals (red)
+	EventPrefixYellowCard   = "▪" // Square - yellow card (cyan)
+	EventPrefixRedCard      = "■" // Filled square - red card (red)
 	EventPrefixSubstitution = "↔" // Arrow - substitution (dim)
-	EventPrefixOther       = "·" // Small dot - other events (dim)
+	EventPrefixVAR          = "◆" // Diamond - VAR decision (yellow)
+	EventPrefixOther        = "·" // Small dot - other events (dim)
+	EventPrefixCommentary   = "✎" // Pencil - commentary lines (dim)
 )
 
 // formatEvent formats a single event into a readable string with symbol prefix and label.
@@ -152,8 +154,10 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 			player = *event.Player
 		}
 		label := "[GOAL]"
-		if event.OwnGoal != nil && *event.OwnGoal {
+		if event.IsOwnGoal() {
 			label = "[OWN GOAL]"
+		} else if event.IsPenalty() {
+			player += " (pen)"
 		}
 		return fmt.Sprintf("%s %d' %s %s %s", EventPrefixGoal, event.Minute, label, player, teamMarker)
 
@@ -162,15 +166,13 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 		if event.Player != nil {
 			player = *event.Player
 		}
-		cardType := "yellow"
-		if event.EventType != nil {
-			cardType = strings.ToLower(*event.EventType)
-		}
-		prefix := EventPrefixYellowCard
-		if cardType == "red" || cardType == "redcard" || cardType == "secondyellow" {
+		prefix, label := EventPrefixYellowCard, "[CARD]"
+		if event.IsSecondYellow() {
+			prefix, label = EventPrefixRedCard, "[2ND YELLOW]"
+		} else if event.IsRedCard() {
 			prefix = EventPrefixRedCard
 		}
-		return fmt.Sprintf("%s %d' [CARD] %s %s", prefix, event.Minute, player, teamMarker)
+		return fmt.Sprintf("%s %d' %s %s %s", prefix, event.Minute, label, player, teamMarker)
 
 	case "substitution":
 		// Player = player going out, Assist = player coming in (repurposed)
@@ -186,6 +188,9 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 		// Using special markers for UI to color-code: {OUT} and {IN}
 		return fmt.Sprintf("%s %d' [SUB] {OUT}%s {IN}%s %s", EventPrefixSubstitution, event.Minute, playerOut, playerIn, teamMarker)
 
+	case "var":
+		return fmt.Sprintf("%s %d' [VAR] %s %s", EventPrefixVAR, event.Minute, event.VARDecision(), teamMarker)
+
 	case "addedtime":
 		// Skip added time events - not useful
 		return ""
@@ -202,22 +207,66 @@ func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam
 	}
 }
 
-// NewEvents compares two event lists and returns only new events.
-// This is useful for detecting new updates when polling match details.
-func (p *LiveUpdateParser) NewEvents(oldEvents, newEvents []api.MatchEvent) []api.MatchEvent {
-	// Create a map of old event IDs for quick lookup
-	oldEventMap := make(map[int]bool)
-	for _, event := range oldEvents {
-		oldEventMap[event.ID] = true
+// FullTimeUpdate returns the stage marker closing a finished match, e.g. "— Full Time 2-1 —".
+func (p *LiveUpdateParser) FullTimeUpdate(details *api.MatchDetails) string {
+	homeScore, awayScore := 0, 0
+	if details.HomeScore != nil {
+		homeScore = *details.HomeScore
 	}
+	if details.AwayScore != nil {
+		awayScore = *details.AwayScore
+	}
+
+	return fmt.Sprintf("%s Full Time %d-%d %s", EventPrefixStage, homeScore, awayScore, EventPrefixStage)
+}
 
-	// Find events that don't exist in old events
+// NewEvents returns the events whose IDs aren't in seen, ordered by minute.
+// This is useful for detecting new updates when polling match details.
+func (p *LiveUpdateParser) NewEvents(seen map[int]bool, events []api.MatchEvent) []api.MatchEvent {
 	var newOnly []api.MatchEvent
-	for _, event := range newEvents {
-		if !oldEventMap[event.ID] {
+	for _, event := range events {
+		if !seen[event.ID] {
 			newOnly = append(newOnly, event)
 		}
 	}
 
+	sort.SliceStable(newOnly, func(i, j int) bool {
+		return newOnly[i].SortKey() < newOnly[j].SortKey()
+	})
 	return newOnly
 }
+
+// NewEventUpdates formats the events not in seen, skipping those ParseEvents skips.
+// Like ParseEvents, the most recent comes first, so they can be put on top of earlier updates.
+func (p *LiveUpdateParser) NewEventUpdates(seen map[int]bool, events []api.MatchEvent, homeTeam, awayTeam api.Team) []string {
+	newEvents := p.NewEvents(seen, events)
+
+	var updates []string
+	for i := len(newEvents) - 1; i >= 0; i-- {
+		if update := p.formatEvent(newEvents[i], homeTeam, awayTeam); update != "" {
+			updates = append(updates, update)
+		}
+	}
+	return updates
+}
+
+// EventsRemoved reports whether any event in seen is gone from events, as when a goal
+// is cancelled after review. The updates built from seen are stale then.
+func EventsRemoved(seen map[int]bool, events []api.MatchEvent) bool {
+	current := EventIDs(events)
+	for id := range seen {
+		if !current[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// EventIDs returns the set of IDs of events, for NewEvents on the next poll.
+func EventIDs(events []api.MatchEvent) map[int]bool {
+	ids := make(map[int]bool, len(events))
+	for _, event := range events {
+		ids[event.ID] = true
+	}
+	return ids
+}
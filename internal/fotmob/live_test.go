@@ -0,0 +1,124 @@
+package fotmob
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestNewEventUpdates(t *testing.T) {
+	home := api.Team{ID: 1, ShortName: "HOM"}
+	away := api.Team{ID: 2, ShortName: "AWY"}
+	str := func(s string) *string { return &s }
+	yellow := "yellow"
+
+	first := []api.MatchEvent{
+		{ID: 10, Minute: 12, Type: "goal", Player: str("Striker"), Team: home},
+	}
+	second := append(slices.Clone(first),
+		api.MatchEvent{ID: 13, Minute: 71, Type: "substitution", Player: str("Tired"), Assist: str("Fresh"), Team: away},
+		api.MatchEvent{ID: 11, Minute: 55, Type: "card", EventType: &yellow, Player: str("Defender"), Team: away},
+		api.MatchEvent{ID: 12, Minute: 60, Type: "addedtime"},
+	)
+
+	p := NewLiveUpdateParser()
+	if got := p.NewEventUpdates(EventIDs(first), first, home, away); len(got) != 0 {
+		t.Errorf("NewEventUpdates() with nothing new = %q, want none", got)
+	}
+
+	second = append(second, api.MatchEvent{ID: 14, Minute: 80, Type: "penaltymissed", Player: str("Taker"), Team: home})
+	got := p.NewEventUpdates(EventIDs(first), second, home, away)
+	want := []string{
+		"· 80' Taker [H]",
+		"↔ 71' [SUB] {OUT}Tired {IN}Fresh [A]",
+		"▪ 55' [CARD] Defender [A]",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("NewEventUpdates() = %q, want %q", got, want)
+	}
+
+	if EventsRemoved(EventIDs(first), second) {
+		t.Error("EventsRemoved() = true when events were only added")
+	}
+	if !EventsRemoved(EventIDs(second), second[1:]) {
+		t.Error("EventsRemoved() = false after the goal was taken away")
+	}
+}
+
+func TestFormatCardEvents(t *testing.T) {
+	home := api.Team{ID: 1, ShortName: "HOM"}
+	away := api.Team{ID: 2, ShortName: "AWY"}
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		cardType string
+		want     string
+	}{
+		{api.CardYellow, "▪ 30' [CARD] Defender [H]"},
+		{api.CardRed, "■ 30' [CARD] Defender [H]"},
+		{api.CardSecondYellow, "■ 30' [2ND YELLOW] Defender [H]"},
+	}
+	p := NewLiveUpdateParser()
+	for _, tt := range tests {
+		event := api.MatchEvent{Minute: 30, Type: "card", EventType: str(tt.cardType), Player: str("Defender"), Team: home}
+		if got := p.formatEvent(event, home, away); got != tt.want {
+			t.Errorf("formatEvent(%s) = %q, want %q", tt.cardType, got, tt.want)
+		}
+	}
+}
+
+func TestStageOf(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		status   api.MatchStatus
+		liveTime *string
+		want     MatchStage
+	}{
+		{api.MatchStatusNotStarted, nil, StageUnknown},
+		{api.MatchStatusLive, str("34'"), StageFirstHalf},
+		{api.MatchStatusLive, str("45+2'"), StageFirstHalf},
+		{api.MatchStatusLive, str("HT"), StageHalfTime},
+		{api.MatchStatusLive, str("46'"), StageSecondHalf},
+		{api.MatchStatusLive, str("Pause"), StageUnknown},
+		{api.MatchStatusFinished, str("FT"), StageFullTime},
+	}
+	for _, tt := range tests {
+		details := &api.MatchDetails{Match: api.Match{Status: tt.status, LiveTime: tt.liveTime}}
+		if got := StageOf(details); got != tt.want {
+			t.Errorf("StageOf(%s, %v) = %v, want %v", tt.status, tt.liveTime, got, tt.want)
+		}
+	}
+}
+
+func TestAddStageUpdates(t *testing.T) {
+	p := NewLiveUpdateParser()
+	firstHalf := []string{"● 45' [GOAL] Striker [H]", "▪ 12' [CARD] Defender [A]"}
+
+	// A poll going from "45+1'" to "HT" marks half time above the first-half events
+	got := p.AddStageUpdates(firstHalf, StageFirstHalf, StageHalfTime)
+	want := []string{"— Half Time —", "● 45' [GOAL] Striker [H]", "▪ 12' [CARD] Defender [A]"}
+	if !slices.Equal(got, want) {
+		t.Errorf("AddStageUpdates(first half to HT) = %q, want %q", got, want)
+	}
+
+	// A poll missing half time entirely still gets both markers, below second-half events
+	updates := append([]string{"↔ 52' [SUB] {OUT}Tired {IN}Fresh [A]"}, firstHalf...)
+	got = p.AddStageUpdates(updates, StageFirstHalf, StageSecondHalf)
+	want = []string{"↔ 52' [SUB] {OUT}Tired {IN}Fresh [A]", "— Kick Off 2nd Half —", "— Half Time —", "● 45' [GOAL] Striker [H]", "▪ 12' [CARD] Defender [A]"}
+	if !slices.Equal(got, want) {
+		t.Errorf("AddStageUpdates(first to second half) = %q, want %q", got, want)
+	}
+
+	// Polls at half time and then in the second half keep the markers in match order
+	atHalfTime := p.AddStageUpdates(firstHalf, StageFirstHalf, StageHalfTime)
+	got = p.AddStageUpdates(atHalfTime, StageHalfTime, StageSecondHalf)
+	want = []string{"— Kick Off 2nd Half —", "— Half Time —", "● 45' [GOAL] Striker [H]", "▪ 12' [CARD] Defender [A]"}
+	if !slices.Equal(got, want) {
+		t.Errorf("AddStageUpdates(HT then second half) = %q, want %q", got, want)
+	}
+
+	if got := p.AddStageUpdates(firstHalf, StageSecondHalf, StageSecondHalf); !slices.Equal(got, firstHalf) {
+		t.Errorf("AddStageUpdates() without a stage change = %q, want it unchanged", got)
+	}
+}
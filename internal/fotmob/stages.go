@@ -0,0 +1,113 @@
+package fotmob
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// EventPrefixStage marks stage markers among the live updates, e.g. "— Half Time —"
+// (used by UI for styling).
+const EventPrefixStage = "—"
+
+// halfTimeMinute is the last base minute of the first half; stoppage time keeps it (45+2 is 45).
+const halfTimeMinute = 45
+
+// MatchStage is the part of a match being played, derived from its status and live time.
+// Stages are ordered, so a poll can tell which boundaries were crossed since the last one.
+type MatchStage int
+
+const (
+	StageUnknown MatchStage = iota // Not started yet, or the live time can't be read
+	StageFirstHalf
+	StageHalfTime
+	StageSecondHalf // Extra time counts as the second half
+	StageFullTime
+)
+
+// StageOf returns the stage of a match from its status and live time ("34'", "45+2'", "HT").
+func StageOf(details *api.MatchDetails) MatchStage {
+	if details == nil {
+		return StageUnknown
+	}
+	switch details.Status {
+	case api.MatchStatusFinished:
+		return StageFullTime
+	case api.MatchStatusLive:
+	default:
+		return StageUnknown
+	}
+	if details.LiveTime == nil {
+		return StageUnknown
+	}
+
+	liveTime := strings.ToUpper(strings.TrimSpace(*details.LiveTime))
+	switch liveTime {
+	case "HT":
+		return StageHalfTime
+	case "FT":
+		return StageFullTime
+	}
+	base, _, _ := strings.Cut(strings.TrimSuffix(liveTime, "'"), "+")
+	minute, err := strconv.Atoi(base)
+	if err != nil {
+		return StageUnknown
+	}
+	if minute <= halfTimeMinute {
+		return StageFirstHalf
+	}
+	return StageSecondHalf
+}
+
+// stageMarker returns the update marking the start of a stage, or "" for stages without one.
+// Full time is marked by FullTimeUpdate, which also carries the final score.
+func stageMarker(stage MatchStage) string {
+	switch stage {
+	case StageHalfTime:
+		return EventPrefixStage + " Half Time " + EventPrefixStage
+	case StageSecondHalf:
+		return EventPrefixStage + " Kick Off 2nd Half " + EventPrefixStage
+	}
+	return ""
+}
+
+// AddStageUpdates inserts the markers of the stages entered after from, up to to, into
+// updates (newest first). The half-time markers go right above the first-half events,
+// or above markers added by earlier polls, so they stay in match order.
+func (p *LiveUpdateParser) AddStageUpdates(updates []string, from, to MatchStage) []string {
+	var markers []string
+	for stage := to; stage > from; stage-- {
+		if marker := stageMarker(stage); marker != "" {
+			markers = append(markers, marker)
+		}
+	}
+	if len(markers) == 0 {
+		return updates
+	}
+
+	at := len(updates)
+	for i, update := range updates {
+		if strings.HasPrefix(update, EventPrefixStage) {
+			at = i
+			break
+		}
+		if minute, ok := updateMinute(update); ok && minute <= halfTimeMinute {
+			at = i
+			break
+		}
+	}
+	return slices.Concat(updates[:at], markers, updates[at:])
+}
+
+// updateMinute returns the minute of an event update ("● 23' [GOAL] ..."). Stage markers
+// and other updates without a minute return false.
+func updateMinute(update string) (int, bool) {
+	fields := strings.Fields(update)
+	if len(fields) < 2 || !strings.HasSuffix(fields[1], "'") {
+		return 0, false
+	}
+	minute, err := strconv.Atoi(strings.TrimSuffix(fields[1], "'"))
+	return minute, err == nil
+}
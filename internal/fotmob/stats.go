@@ -3,90 +3,88 @@ package fotmob
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
 // StatsData holds all matches data for the stats view.
-// This is returned by StatsData and contains both finished and upcoming matches.
-type StatsData struct {
-	// AllFinished contains finished matches for all fetched days (5 days by default)
-	AllFinished []api.Match
-	// TodayFinished contains only today's finished matches (filtered from AllFinished)
-	TodayFinished []api.Match
-	// TodayUpcoming contains today's upcoming matches
-	TodayUpcoming []api.Match
-}
+// Defined in api so other api.MatchService implementations can return it.
+type StatsData = api.StatsData
+
+// StatsDataDays is the default number of days to fetch for stats view.
+// 5 days ensures we have data even during mid-week breaks; settings can change it.
+const StatsDataDays = data.DefaultStatsDays
 
-// StatsDataDays is the number of days to fetch for stats view.
-// 5 days ensures we have data even during mid-week breaks.
-const StatsDataDays = 5
+// DefaultStatsConcurrency is how many days StatsData fetches at once by default.
+// Each day already queries its leagues concurrently, so a few days in flight is plenty.
+const DefaultStatsConcurrency = 4
 
-// StatsData fetches all stats data in one call: 5 days of finished matches + today's upcoming.
-// This is the primary API for the stats view - always fetches 5 days, then filters client-side.
+// StatsData fetches all stats data in one call: days of finished matches + today's upcoming.
+// This is the primary API for the stats view - fetches every day up front, then filters client-side.
+// days <= 0 means StatsDataDays.
 //
 // OPTIMIZATION: Only queries "fixtures" tab for today (upcoming matches).
 // Past days only need "results" tab (finished matches).
 //
-// API calls breakdown:
+// API calls breakdown (14 leagues, 5 days):
 //   - Today: 14 leagues × 2 tabs = 28 requests (need both fixtures + results)
 //   - Past 4 days: 14 leagues × 1 tab × 4 = 56 requests (only results)
 //   - Total: 84 requests
 //
+// Days are fetched by a pool of workers (see ClientOptions.StatsConcurrency).
+// Cancelling ctx stops handing out days and aborts the requests in flight.
+//
 // Benefits:
-// - Single fetch pattern (always 5 days)
+// - Single fetch pattern (always the whole window)
 // - Covers mid-week breaks when no matches scheduled
-// - Instant switching between Today/5d views after initial load
-func (c *Client) StatsData(ctx context.Context) (*StatsData, error) {
+// - Instant switching between the date ranges after initial load
+func (c *Client) StatsData(ctx context.Context, days int) (*StatsData, error) {
+	if days <= 0 {
+		days = StatsDataDays
+	}
+
 	today := time.Now().UTC()
-	todayStr := today.Format("2006-01-02")
 
 	// Use maps to deduplicate matches by ID
 	allFinishedMap := make(map[int]api.Match)
 	todayFinishedMap := make(map[int]api.Match)
 	todayUpcomingMap := make(map[int]api.Match)
+	todayLiveMap := make(map[int]api.Match)
 	var lastErr error
 	successCount := 0
 
-	// Fetch 5 days of matches (today + last 4 days)
-	for i := range StatsDataDays {
-		date := today.AddDate(0, 0, -i)
-		dateStr := date.Format("2006-01-02")
-		isToday := dateStr == todayStr
-
-		var matches []api.Match
-		var err error
-
-		if isToday {
-			// Today: need both fixtures (upcoming) and results (finished)
-			matches, err = c.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
-		} else {
-			// Past days: only need results (finished matches)
-			matches, err = c.MatchesByDateWithTabs(ctx, date, []string{"results"})
-		}
-
-		if err != nil {
-			lastErr = fmt.Errorf("fetch matches for date %s: %w", dateStr, err)
+	// Fetch the window of matches (today + the days before), collecting each day as it arrives
+	for day := range c.fetchStatsDays(ctx, today, days) {
+		if day.err != nil {
+			lastErr = day.err
 			continue
 		}
 		successCount++
 
 		// Process matches for this day - deduplicate by match ID
-		for _, match := range matches {
-			if match.Status == api.MatchStatusFinished {
+		for _, match := range day.matches {
+			if match.Status == api.MatchStatusFinished || match.Status == api.MatchStatusAbandoned {
 				allFinishedMap[match.ID] = match
 				// Also track today's finished separately
-				if isToday {
+				if day.isToday {
 					todayFinishedMap[match.ID] = match
 				}
-			} else if match.Status == api.MatchStatusNotStarted && isToday {
+			} else if (match.Status == api.MatchStatusNotStarted || match.Status == api.MatchStatusPostponed) && day.isToday {
 				// Only today has upcoming matches
 				todayUpcomingMap[match.ID] = match
+			} else if match.Status == api.MatchStatusLive && day.isToday {
+				todayLiveMap[match.ID] = match
 			}
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Return error only if all days failed
 	if successCount == 0 {
 		return nil, fmt.Errorf("failed to fetch matches for any date: %w", lastErr)
@@ -107,10 +105,111 @@ func (c *Client) StatsData(ctx context.Context) (*StatsData, error) {
 	for _, match := range todayUpcomingMap {
 		todayUpcoming = append(todayUpcoming, match)
 	}
+	api.SortByKickoff(todayUpcoming)
+
+	todayLive := make([]api.Match, 0, len(todayLiveMap))
+	for _, match := range todayLiveMap {
+		todayLive = append(todayLive, match)
+	}
 
 	return &StatsData{
 		AllFinished:   allFinished,
 		TodayFinished: todayFinished,
 		TodayUpcoming: todayUpcoming,
+		TodayLive:     todayLive,
 	}, nil
 }
+
+// statsDay is one day of matches fetched for StatsData.
+type statsDay struct {
+	isToday bool
+	matches []api.Match
+	err     error
+}
+
+// fetchStatsDays fetches days days back from today with at most c.statsConcurrency days
+// in flight, delivering each day as it completes. The channel is closed once every
+// started day is delivered; after ctx is cancelled no further days are started.
+func (c *Client) fetchStatsDays(ctx context.Context, today time.Time, days int) <-chan statsDay {
+	workers := min(max(c.statsConcurrency, 1), days)
+	dayIndexes := make(chan int)
+	results := make(chan statsDay)
+
+	go func() {
+		defer close(dayIndexes)
+		for i := range days {
+			select {
+			case dayIndexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range dayIndexes {
+				results <- c.fetchStatsDay(ctx, today.AddDate(0, 0, -i), i == 0)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+// fetchStatsDay fetches one day for StatsData. Today needs both fixtures (upcoming) and
+// results (finished); past days only need results.
+func (c *Client) fetchStatsDay(ctx context.Context, date time.Time, isToday bool) statsDay {
+	tabs := []string{"results"}
+	if isToday {
+		tabs = []string{"fixtures", "results"}
+	}
+
+	matches, err := c.MatchesByDateWithTabs(ctx, date, tabs)
+	if err != nil {
+		return statsDay{isToday: isToday, err: fmt.Errorf("fetch matches for date %s: %w", date.Format("2006-01-02"), err)}
+	}
+	return statsDay{isToday: isToday, matches: matches}
+}
+
+// NearestMatchDay walks back day by day from from, at most maxBack days, and returns the
+// first day on which the tracked leagues finished any matches.
+// Only the "results" tab is queried, and leagues the empty cache knows had no matches on a
+// day are skipped, so repeated probes over quiet weeks stay cheap.
+func (c *Client) NearestMatchDay(ctx context.Context, from time.Time, maxBack int) (time.Time, error) {
+	for i := 0; i <= maxBack; i++ {
+		day := from.AddDate(0, 0, -i)
+		matches, err := c.MatchesByDateWithTabs(ctx, day, []string{"results"})
+		if ctx.Err() != nil {
+			return time.Time{}, ctx.Err()
+		}
+		if err != nil {
+			continue
+		}
+		for _, match := range matches {
+			if match.Status == api.MatchStatusFinished {
+				return day, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w in the %d days before %s", api.ErrNoMatchDay, maxBack+1, from.Format("2006-01-02"))
+}
+
+// TodaySummary counts today's matches across the tracked leagues by state.
+// Uses MatchesByDate, so the result is served from (and primes) the per-date cache.
+func (c *Client) TodaySummary(ctx context.Context) (live, finished, upcoming int, err error) {
+	matches, err := c.MatchesByDate(ctx, time.Now())
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("fetch today's matches: %w", err)
+	}
+	live, finished, upcoming = api.CountMatchStates(matches)
+	return live, finished, upcoming, nil
+}
@@ -0,0 +1,105 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// fotmobTeam is the team overview response.
+type fotmobTeam struct {
+	Details struct {
+		ID           int    `json:"id"`
+		Name         string `json:"name"`
+		ShortName    string `json:"shortName"`
+		LatestSeason string `json:"latestSeason"`
+	} `json:"details"`
+	Overview struct {
+		// Same formats as the league table, see fetchLeagueTable
+		Table []struct {
+			Data struct {
+				LeagueName string `json:"leagueName"`
+				Table      struct {
+					All []fotmobTableRow `json:"all"`
+				} `json:"table"`
+				Tables []struct {
+					Table struct {
+						All []fotmobTableRow `json:"all"`
+					} `json:"table"`
+				} `json:"tables"`
+			} `json:"data"`
+		} `json:"table"`
+		TeamForm []struct {
+			ResultString string `json:"resultString"` // "W", "D" or "L"
+		} `json:"teamForm"`
+	} `json:"overview"`
+}
+
+// TeamStats retrieves a team's season record from its overview: its row of the league
+// table and its latest results. Teams outside a league table get a nil Record.
+func (c *Client) TeamStats(ctx context.Context, teamID int) (*api.TeamSeasonStats, error) {
+	c.rateLimiter.Wait()
+
+	url := fmt.Sprintf("%s/teams?id=%d", c.baseURL, teamID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request for team %d: %w", teamID, err)
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch team %d: %w", teamID, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for team %d", resp.StatusCode, teamID)
+	}
+
+	var response fotmobTeam
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode team %d: %w", teamID, err)
+	}
+
+	return response.toAPITeamStats(teamID), nil
+}
+
+// toAPITeamStats finds the team's row in the first table that lists it.
+func (t fotmobTeam) toAPITeamStats(teamID int) *api.TeamSeasonStats {
+	stats := &api.TeamSeasonStats{
+		Team: api.Team{
+			ID:        teamID,
+			Name:      t.Details.Name,
+			ShortName: t.Details.ShortName,
+		},
+		Season: t.Details.LatestSeason,
+	}
+
+	for _, form := range t.Overview.TeamForm {
+		if form.ResultString != "" {
+			stats.Form = append(stats.Form, form.ResultString)
+		}
+	}
+
+	for _, table := range t.Overview.Table {
+		rows := table.Data.Table.All
+		for _, sub := range table.Data.Tables {
+			rows = append(rows, sub.Table.All...)
+		}
+		for _, row := range rows {
+			if row.ID == teamID {
+				entry := row.toAPITableEntry()
+				stats.Record = &entry
+				stats.LeagueName = table.Data.LeagueName
+				return stats
+			}
+		}
+	}
+	return stats
+}
@@ -2,7 +2,9 @@ package fotmob
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -11,6 +13,27 @@ import (
 	"github.com/0xjuanma/golazo/internal/api"
 )
 
+// fotmobMatches is a list of FotMob matches that tolerates schema changes:
+// matches that fail to decode are skipped instead of failing the whole list.
+type fotmobMatches []fotmobMatch
+
+// UnmarshalJSON decodes each match on its own, dropping the ones that fail.
+func (ms *fotmobMatches) UnmarshalJSON(b []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	matches := make(fotmobMatches, 0, len(raw))
+	for _, r := range raw {
+		var m fotmobMatch
+		if err := json.Unmarshal(r, &m); err == nil {
+			matches = append(matches, m)
+		}
+	}
+	*ms = matches
+	return nil
+}
+
 // fotmobMatch represents a match in FotMob's API format
 // Note: FotMob uses string IDs in JSON, but we convert them to ints
 type fotmobMatch struct {
@@ -42,6 +65,47 @@ type status struct {
 	Cancelled *bool     `json:"cancelled"` // Can be null
 	LiveTime  *liveTime `json:"liveTime,omitempty"`
 	Score     *score    `json:"score,omitempty"`
+	Reason    *reason   `json:"reason,omitempty"` // e.g. PP (postponed), Ab (abandoned)
+
+	// Two-legged ties only
+	AggregatedStr       string `json:"aggregatedStr,omitempty"`       // e.g. "4 - 3"
+	WhoLostOnAggregated string `json:"whoLostOnAggregated,omitempty"` // Team name, set when decided
+}
+
+type reason struct {
+	Short    string `json:"short"`
+	Long     string `json:"long"`
+	ShortKey string `json:"shortKey"`
+}
+
+// matchStatus maps a FotMob status block to an api.MatchStatus.
+// Postponed and abandoned matches are reported via the reason field and
+// can carry finished/started flags without a score, so they are checked first.
+func (s status) matchStatus() (api.MatchStatus, *string) {
+	if s.Reason != nil {
+		short := strings.ToLower(s.Reason.Short)
+		key := strings.ToLower(s.Reason.ShortKey)
+		switch {
+		case short == "pp" || strings.Contains(key, "postponed"):
+			return api.MatchStatusPostponed, nil
+		case short == "ab" || strings.Contains(key, "abandoned"):
+			return api.MatchStatusAbandoned, nil
+		}
+	}
+
+	switch {
+	case s.Cancelled != nil && *s.Cancelled:
+		return api.MatchStatusCancelled, nil
+	case s.Finished != nil && *s.Finished:
+		return api.MatchStatusFinished, nil
+	case s.Started != nil && *s.Started:
+		if s.LiveTime != nil {
+			return api.MatchStatusLive, &s.LiveTime.Short
+		}
+		return api.MatchStatusLive, nil
+	default:
+		return api.MatchStatusNotStarted, nil
+	}
 }
 
 type liveTime struct {
@@ -78,7 +142,7 @@ func (m fotmobMatch) toAPIMatch() api.Match {
 			Name:      m.Away.Name,
 			ShortName: m.Away.ShortName,
 		},
-		Round: m.Round,
+		Round: roundName("", m.Round),
 	}
 
 	// Parse match time - FotMob uses .000Z format sometimes
@@ -96,18 +160,7 @@ func (m fotmobMatch) toAPIMatch() api.Match {
 	}
 
 	// Determine status - handle null boolean values
-	if m.Status.Cancelled != nil && *m.Status.Cancelled {
-		match.Status = api.MatchStatusCancelled
-	} else if m.Status.Finished != nil && *m.Status.Finished {
-		match.Status = api.MatchStatusFinished
-	} else if m.Status.Started != nil && *m.Status.Started {
-		match.Status = api.MatchStatusLive
-		if m.Status.LiveTime != nil {
-			match.LiveTime = &m.Status.LiveTime.Short
-		}
-	} else {
-		match.Status = api.MatchStatusNotStarted
-	}
+	match.Status, match.LiveTime = m.Status.matchStatus()
 
 	// Set scores if available
 	if m.Status.Score != nil {
@@ -130,9 +183,10 @@ type fotmobMatchDetails struct {
 		Status status `json:"status"`
 	} `json:"header"`
 	General struct {
-		MatchID  string `json:"matchId"`
-		Round    string `json:"matchRound"`
-		HomeTeam struct {
+		MatchID   string `json:"matchId"`
+		Round     string `json:"matchRound"`      // Bare matchday number in leagues, e.g. "12"
+		RoundName string `json:"leagueRoundName"` // Stage name in cups, e.g. "Quarter-final"
+		HomeTeam  struct {
 			ID   int    `json:"id"`
 			Name string `json:"name"`
 		} `json:"homeTeam"`
@@ -144,12 +198,14 @@ type fotmobMatchDetails struct {
 		LeagueName     string `json:"leagueName"`
 		ParentLeagueID int    `json:"parentLeagueId"` // Parent league ID for sub-season leagues
 	} `json:"general"`
+	SEO struct {
+		Path string `json:"path"` // Match page on fotmob.com
+	} `json:"seo"`
+	// Volatile sections (events, stats, momentum, lineups) are kept raw and decoded one by one
+	// in toAPIMatchDetails, so a schema change in one of them doesn't lose the rest.
 	Content struct {
 		MatchFacts struct {
-			Events struct {
-				Events                []fotmobEventDetail `json:"events"`
-				PenaltyShootoutEvents any                 `json:"penaltyShootoutEvents,omitempty"`
-			} `json:"events"`
+			Events     json.RawMessage `json:"events"` // fotmobEvents
 			Highlights *struct {
 				URL    string `json:"url"`
 				Image  string `json:"image,omitempty"`
@@ -165,21 +221,81 @@ type fotmobMatchDetails struct {
 				Attendance json.RawMessage `json:"Attendance,omitempty"` // Can be int or object
 			} `json:"infoBox,omitempty"`
 		} `json:"matchFacts"`
-		Stats struct {
-			Periods struct {
-				All struct {
-					Stats []fotmobStatCategory `json:"stats"`
-				} `json:"all,omitempty"`
-			} `json:"periods,omitempty"`
-		} `json:"stats,omitempty"`
-		Lineup struct {
-			Lineup   []fotmobTeamLineup `json:"lineup"`
-			HomeTeam *fotmobNewLineup   `json:"homeTeam,omitempty"`
-			AwayTeam *fotmobNewLineup   `json:"awayTeam,omitempty"`
-		} `json:"lineup,omitempty"`
+		Stats    json.RawMessage `json:"stats,omitempty"`    // fotmobStats
+		Momentum json.RawMessage `json:"momentum,omitempty"` // fotmobMomentum
+		Lineup   json.RawMessage `json:"lineup,omitempty"`   // fotmobLineups
 	} `json:"content"`
 }
 
+// fotmobEvents is the content.matchFacts.events section of match details.
+// Events are decoded one by one, see decodeEvents.
+type fotmobEvents struct {
+	Events                []json.RawMessage `json:"events"`
+	PenaltyShootoutEvents any               `json:"penaltyShootoutEvents,omitempty"`
+}
+
+// fotmobStats is the content.stats section of match details.
+type fotmobStats struct {
+	Periods struct {
+		All struct {
+			Stats []fotmobStatCategory `json:"stats"`
+		} `json:"all,omitempty"`
+	} `json:"periods,omitempty"`
+}
+
+// fotmobMomentum is the content.momentum section of match details.
+type fotmobMomentum struct {
+	Main struct {
+		Data []fotmobMomentumPoint `json:"data"`
+	} `json:"main"`
+}
+
+// fotmobLineups is the content.lineup section of match details.
+type fotmobLineups struct {
+	Lineup   []fotmobTeamLineup `json:"lineup"`
+	HomeTeam *fotmobNewLineup   `json:"homeTeam,omitempty"`
+	AwayTeam *fotmobNewLineup   `json:"awayTeam,omitempty"`
+}
+
+// decodeSection decodes a raw match details section into v. Missing sections are not an error.
+func decodeSection(name string, raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// decodeEvents decodes match events one by one, skipping the ones that fail.
+// The error reports how many were skipped.
+func decodeEvents(raw []json.RawMessage) ([]fotmobEventDetail, error) {
+	events := make([]fotmobEventDetail, 0, len(raw))
+	var firstErr error
+	for _, r := range raw {
+		var e fotmobEventDetail
+		if err := json.Unmarshal(r, &e); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		events = append(events, e)
+	}
+	if skipped := len(raw) - len(events); skipped > 0 {
+		return events, fmt.Errorf("events: skipped %d of %d: %w", skipped, len(raw), firstErr)
+	}
+	return events, nil
+}
+
+// fotmobMomentumPoint is one minute of FotMob's momentum graph.
+// Value ranges from -100 (away dominating) to 100 (home dominating).
+type fotmobMomentumPoint struct {
+	Minute float64 `json:"minute"`
+	Value  float64 `json:"value"`
+}
+
 // fotmobStatCategory represents a category of match statistics
 type fotmobStatCategory struct {
 	Title string           `json:"title"`
@@ -260,36 +376,88 @@ type fotmobEventDetail struct {
 	NewScore  []int  `json:"newScore,omitempty"`
 	OwnGoal   *bool  `json:"ownGoal,omitempty"`
 	IsPenalty *bool  `json:"isPenalty,omitempty"`
-	Card      string `json:"card,omitempty"` // "Yellow" or "Red"
+	Card      string `json:"card,omitempty"` // "Yellow", "Red" or "YellowRed" (second booking)
 	Swap      []struct {
 		Name string `json:"name"`
 		ID   string `json:"id"`
 	} `json:"swap,omitempty"` // For substitutions
-	AssistStr      string `json:"assistStr,omitempty"`
-	AssistInput    string `json:"assistInput,omitempty"`
-	AssistPlayerID *int   `json:"assistPlayerId,omitempty"`
+	AssistStr      string     `json:"assistStr,omitempty"`
+	AssistInput    string     `json:"assistInput,omitempty"`
+	AssistPlayerID *int       `json:"assistPlayerId,omitempty"`
+	VAR            *fotmobVAR `json:"VAR,omitempty"` // For VAR events
+}
+
+// fotmobVAR is the outcome of a VAR review, e.g. decision
+// {"key": "goal_not_awarded", "value": "Goal not awarded"} and reason {"key": "offside", "value": "Offside"}.
+type fotmobVAR struct {
+	Decision fotmobKeyValue `json:"decision"`
+	Reason   fotmobKeyValue `json:"reason"`
+}
+
+// fotmobKeyValue is a FotMob enum: a stable key with its English label.
+type fotmobKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// varDecisions maps FotMob's VAR decision keys to the api.VAR* decisions.
+var varDecisions = map[string]string{
+	"goal_not_awarded":    api.VARGoalDisallowed,
+	"goal_disallowed":     api.VARGoalDisallowed,
+	"no_goal":             api.VARGoalDisallowed,
+	"penalty_awarded":     api.VARPenaltyAwarded,
+	"penalty_not_awarded": api.VARPenaltyCancelled,
+	"penalty_cancelled":   api.VARPenaltyCancelled,
+	"red_card_given":      api.VARRedCard,
+	"red_card":            api.VARRedCard,
+	"card_upgrade":        api.VARRedCard,
+	"red_card_not_given":  api.VARCardCancelled,
+	"card_cancelled":      api.VARCardCancelled,
+}
+
+// varDecision returns the api.VAR* decision for a FotMob decision, or its key
+// when unknown, lowercased with underscores (e.g. "goal_awarded").
+func varDecision(decision fotmobKeyValue) string {
+	key := strings.TrimPrefix(strings.ToLower(decision.Key), "var_")
+	if key == "" {
+		key = strings.ToLower(strings.ReplaceAll(decision.Value, " ", "_"))
+	}
+	if mapped, ok := varDecisions[key]; ok {
+		return mapped
+	}
+	return key
 }
 
-// toAPIMatchDetails converts fotmobMatchDetails to api.MatchDetails
-func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
+// toAPIMatchDetails converts fotmobMatchDetails to api.MatchDetails.
+// Sections that fail to decode are left empty and reported in an error wrapping
+// api.ErrPartialDetails; the returned details are always usable.
+func (m fotmobMatchDetails) toAPIMatchDetails() (*api.MatchDetails, error) {
 	// Parse match ID from string
 	matchID := parseInt(m.General.MatchID)
 
-	// Determine match status from header
-	var status api.MatchStatus
-	var liveTime *string
-	if m.Header.Status.Cancelled != nil && *m.Header.Status.Cancelled {
-		status = api.MatchStatusCancelled
-	} else if m.Header.Status.Finished != nil && *m.Header.Status.Finished {
-		status = api.MatchStatusFinished
-	} else if m.Header.Status.Started != nil && *m.Header.Status.Started {
-		status = api.MatchStatusLive
-		if m.Header.Status.LiveTime != nil {
-			liveTime = &m.Header.Status.LiveTime.Short
+	// Decode the volatile sections independently
+	var sectionErrs []error
+	var eventsSection fotmobEvents
+	var statsSection fotmobStats
+	var momentumSection *fotmobMomentum
+	var lineupsSection fotmobLineups
+	for _, err := range []error{
+		decodeSection("events", m.Content.MatchFacts.Events, &eventsSection),
+		decodeSection("stats", m.Content.Stats, &statsSection),
+		decodeSection("momentum", m.Content.Momentum, &momentumSection),
+		decodeSection("lineup", m.Content.Lineup, &lineupsSection),
+	} {
+		if err != nil {
+			sectionErrs = append(sectionErrs, err)
 		}
-	} else {
-		status = api.MatchStatusNotStarted
 	}
+	eventDetails, err := decodeEvents(eventsSection.Events)
+	if err != nil {
+		sectionErrs = append(sectionErrs, err)
+	}
+
+	// Determine match status from header
+	status, liveTime := m.Header.Status.matchStatus()
 
 	// Parse match time
 	var matchTime *time.Time
@@ -324,16 +492,17 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		Status:    status,
 		LiveTime:  liveTime,
 		MatchTime: matchTime,
-		Round:     m.General.Round,
+		Round:     roundName(m.General.RoundName, m.General.Round),
 	}
 
 	details := &api.MatchDetails{
-		Match:  baseMatch,
-		Events: make([]api.MatchEvent, 0),
+		Match:    baseMatch,
+		Events:   make([]api.MatchEvent, 0),
+		PagePath: m.SEO.Path,
 	}
 
-	// Populate scores from header.Teams
-	if len(m.Header.Teams) >= 2 {
+	// Populate scores from header.Teams (postponed matches report a meaningless 0-0)
+	if len(m.Header.Teams) >= 2 && status != api.MatchStatusPostponed {
 		homeScore := m.Header.Teams[0].Score
 		awayScore := m.Header.Teams[1].Score
 		details.HomeScore = &homeScore
@@ -351,6 +520,18 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		}
 	}
 
+	// Momentum graph, converted to the home side's share (50 = even). Its latest
+	// point says which side is on top right now while the match is live.
+	if momentumSection != nil {
+		details.MomentumSeries = momentumSeries(momentumSection.Main.Data)
+		if details.Status == api.MatchStatusLive {
+			details.LiveMomentum = liveMomentum(momentumSection.Main.Data)
+		}
+	}
+
+	// Aggregate score for the second leg of a two-legged tie
+	details.Aggregate = m.Header.Status.aggregate(details.HomeScore, details.AwayScore, details.HomeTeam.Name, details.AwayTeam.Name)
+
 	// Populate venue from infoBox
 	if m.Content.MatchFacts.InfoBox.Stadium.Name != "" {
 		details.Venue = m.Content.MatchFacts.InfoBox.Stadium.Name
@@ -382,7 +563,7 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	// Extract half-time score from events (look for "Half" event type)
 	// Also set match duration (default to 90, but can be 120 for extra time)
 	details.MatchDuration = 90
-	for _, e := range m.Content.MatchFacts.Events.Events {
+	for _, e := range eventDetails {
 		if e.Type == "Half" && details.HalfTimeScore == nil {
 			// Found half-time score (first "Half" event only — subsequent ones carry the final score)
 			htHome := e.HomeScore
@@ -400,10 +581,10 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	}
 
 	// Parse match statistics
-	details.Statistics = m.parseStatistics()
+	details.Statistics = parseStatistics(statsSection)
 
 	// Parse lineup information
-	m.parseLineups(details)
+	m.parseLineups(lineupsSection, details)
 
 	// Parse highlight video if available
 	if m.Content.MatchFacts.Highlights != nil {
@@ -415,8 +596,8 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	}
 
 	// Parse penalty shootout results if available
-	if m.Content.MatchFacts.Events.PenaltyShootoutEvents != nil {
-		if penaltyEvents, ok := m.Content.MatchFacts.Events.PenaltyShootoutEvents.([]any); ok && len(penaltyEvents) > 0 {
+	if eventsSection.PenaltyShootoutEvents != nil {
+		if penaltyEvents, ok := eventsSection.PenaltyShootoutEvents.([]any); ok && len(penaltyEvents) > 0 {
 			// Get the final penalty scores from the last event
 			lastEvent := penaltyEvents[len(penaltyEvents)-1]
 			if eventMap, ok := lastEvent.(map[string]any); ok {
@@ -436,9 +617,12 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		}
 	}
 
+	// Who went through a two-legged tie, once this match has settled it
+	details.Advanced = advancedTeam(details, m.Header.Status.WhoLostOnAggregated)
+
 	// Convert events from content.matchFacts.events
-	events := make([]api.MatchEvent, 0, len(m.Content.MatchFacts.Events.Events))
-	for _, e := range m.Content.MatchFacts.Events.Events {
+	events := make([]api.MatchEvent, 0, len(eventDetails))
+	for _, e := range eventDetails {
 		// Skip non-event types like "Half"
 		if e.Type == "Half" {
 			continue
@@ -477,10 +661,18 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		if playerName != "" {
 			event.Player = &playerName
 		}
+		if e.Player != nil && e.Player.ID != 0 {
+			event.PlayerID = e.Player.ID
+		} else if e.PlayerID != nil {
+			event.PlayerID = *e.PlayerID
+		}
 
-		// Extract own goal flag
+		// Extract own goal and penalty flags
 		if e.OwnGoal != nil && *e.OwnGoal {
 			event.OwnGoal = e.OwnGoal
+			event.GoalType = api.GoalTypeOwnGoal
+		} else if e.IsPenalty != nil && *e.IsPenalty {
+			event.GoalType = api.GoalTypePenalty
 		}
 
 		// Extract assist
@@ -492,6 +684,10 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		eventTypeDetail := ""
 		if e.Type == "Card" && e.Card != "" {
 			eventTypeDetail = strings.ToLower(e.Card)
+			if eventTypeDetail == "yellowred" {
+				// FotMob marks a second booking as "YellowRed"
+				eventTypeDetail = api.CardSecondYellow
+			}
 		} else if e.Type == "Substitution" && len(e.Swap) >= 2 {
 			// Substitution: swap[0] is player coming IN, swap[1] is player going OUT
 			// Store player out in Player field, player in in Assist field (repurposed)
@@ -500,6 +696,12 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 			event.Player = &playerOut
 			event.Assist = &playerIn // Repurpose Assist to store player coming in
 			eventTypeDetail = "sub"
+		} else if eventType == "var" && e.VAR != nil {
+			eventTypeDetail = varDecision(e.VAR.Decision)
+			event.Reason = strings.ToLower(e.VAR.Reason.Value)
+			if event.Reason == "" {
+				event.Reason = strings.ReplaceAll(strings.ToLower(e.VAR.Reason.Key), "_", " ")
+			}
 		} else if strings.ToLower(e.Type) == "addedtime" {
 			// Added time event - extract minutes from available fields
 			eventTypeDetail = "addedtime"
@@ -547,20 +749,109 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		events = append(events, event)
 	}
 
-	// Sort events by minute (chronological order)
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].Minute < events[j].Minute
+	// FotMob sometimes lists an event twice; keep the first, drop the goals VAR ruled
+	// out, then sort by minute, stoppage time included (chronological order)
+	scores := make(map[int]int)
+	if details.HomeScore != nil && details.AwayScore != nil {
+		scores[details.HomeTeam.ID] = *details.HomeScore
+		scores[details.AwayTeam.ID] = *details.AwayScore
+	}
+	events = dropDisallowedGoals(dedupeEvents(events), scores)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].SortKey() < events[j].SortKey()
 	})
 
 	details.Events = events
-	return details
+
+	if len(sectionErrs) > 0 {
+		return details, fmt.Errorf("%w for match %d: %w", api.ErrPartialDetails, matchID, errors.Join(sectionErrs...))
+	}
+	return details, nil
+}
+
+// varReviewWindow is how many minutes after a goal VAR may rule it out.
+const varReviewWindow = 5
+
+// dropDisallowedGoals removes the goals VAR ruled out, so they aren't counted or shown
+// as goals; the VAR event itself stays. FotMob may or may not list a ruled out goal, so
+// a goal is only dropped while its team has more goals listed than it scored (scores
+// holds each team's score by team ID): its latest goal in the minutes before the review.
+func dropDisallowedGoals(events []api.MatchEvent, scores map[int]int) []api.MatchEvent {
+	listed := make(map[int]int)
+	for _, event := range events {
+		if event.Type == "goal" {
+			listed[event.Team.ID]++
+		}
+	}
+
+	disallowed := make(map[int]bool)
+	for _, review := range events {
+		if review.Type != "var" || review.EventType == nil || *review.EventType != api.VARGoalDisallowed {
+			continue
+		}
+		if score, ok := scores[review.Team.ID]; !ok || listed[review.Team.ID] <= score {
+			continue
+		}
+		goal := -1
+		for i, event := range events {
+			if event.Type != "goal" || event.Team.ID != review.Team.ID || disallowed[i] ||
+				event.SortKey() > review.SortKey() || event.Minute < review.Minute-varReviewWindow {
+				continue
+			}
+			if goal == -1 || event.SortKey() >= events[goal].SortKey() {
+				goal = i
+			}
+		}
+		if goal != -1 {
+			disallowed[goal] = true
+			listed[review.Team.ID]--
+		}
+	}
+	if len(disallowed) == 0 {
+		return events
+	}
+
+	kept := make([]api.MatchEvent, 0, len(events)-len(disallowed))
+	for i, event := range events {
+		if !disallowed[i] {
+			kept = append(kept, event)
+		}
+	}
+	return kept
+}
+
+// dedupeEvents drops events repeating an earlier one's type, minute, player and team,
+// keeping the order of the rest. Card and substitution details count towards the type,
+// and the minute includes stoppage time, so distinct events at the same minute are kept.
+func dedupeEvents(events []api.MatchEvent) []api.MatchEvent {
+	type eventKey struct {
+		kind, detail, minute, player string
+		teamID                       int
+	}
+	seen := make(map[eventKey]bool, len(events))
+	kept := events[:0]
+	for _, event := range events {
+		k := eventKey{kind: event.Type, minute: event.DisplayMinute, teamID: event.Team.ID}
+		if event.EventType != nil {
+			k.detail = *event.EventType
+		}
+		if event.Player != nil {
+			k.player = *event.Player
+		}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		kept = append(kept, event)
+	}
+	return kept
 }
 
-// parseStatistics extracts match statistics from FotMob response
-func (m fotmobMatchDetails) parseStatistics() []api.MatchStatistic {
+// parseStatistics extracts match statistics from the stats section
+func parseStatistics(section fotmobStats) []api.MatchStatistic {
 	var stats []api.MatchStatistic
 
-	for _, category := range m.Content.Stats.Periods.All.Stats {
+	for _, category := range section.Periods.All.Stats {
 		for _, stat := range category.Stats {
 			if len(stat.Stats) < 2 {
 				continue
@@ -607,22 +898,22 @@ func formatStatValue(val any) string {
 
 // parseLineups extracts lineup information from FotMob response
 // Supports both old format (lineup.lineup[]) and new format (lineup.homeTeam/awayTeam)
-func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
+func (m fotmobMatchDetails) parseLineups(section fotmobLineups, details *api.MatchDetails) {
 	// Try new format first (homeTeam/awayTeam structure)
-	if m.Content.Lineup.HomeTeam != nil {
-		details.HomeFormation = m.Content.Lineup.HomeTeam.Formation
-		details.HomeStarting = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Starters)
-		details.HomeSubstitutes = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Subs)
+	if section.HomeTeam != nil {
+		details.HomeFormation = section.HomeTeam.Formation
+		details.HomeStarting = convertNewLineupPlayers(section.HomeTeam.Starters)
+		details.HomeSubstitutes = convertNewLineupPlayers(section.HomeTeam.Subs)
 	}
-	if m.Content.Lineup.AwayTeam != nil {
-		details.AwayFormation = m.Content.Lineup.AwayTeam.Formation
-		details.AwayStarting = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Starters)
-		details.AwaySubstitutes = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Subs)
+	if section.AwayTeam != nil {
+		details.AwayFormation = section.AwayTeam.Formation
+		details.AwayStarting = convertNewLineupPlayers(section.AwayTeam.Starters)
+		details.AwaySubstitutes = convertNewLineupPlayers(section.AwayTeam.Subs)
 	}
 
 	// If new format didn't provide data, try old format
 	if len(details.HomeStarting) == 0 && len(details.AwayStarting) == 0 {
-		for _, lineup := range m.Content.Lineup.Lineup {
+		for _, lineup := range section.Lineup {
 			isHome := lineup.TeamID == m.General.HomeTeam.ID
 
 			// Set formation
@@ -735,6 +1026,115 @@ func (r fotmobTableRow) toAPITableEntry() api.LeagueTableEntry {
 	}
 }
 
+// momentumSeries converts FotMob momentum points (-100..100) to the home side's share
+// of momentum (0..100), where 50 means neither side is on top.
+func momentumSeries(points []fotmobMomentumPoint) []float64 {
+	if len(points) == 0 {
+		return nil
+	}
+	series := make([]float64, len(points))
+	for i, p := range points {
+		series[i] = 50 + max(min(p.Value, 100), -100)/2
+	}
+	return series
+}
+
+// liveMomentum returns the latest FotMob momentum point scaled to -1 (away on top) .. 1
+// (home on top), or nil without points.
+func liveMomentum(points []fotmobMomentumPoint) *float64 {
+	if len(points) == 0 {
+		return nil
+	}
+	latest := points[0]
+	for _, p := range points[1:] {
+		if p.Minute >= latest.Minute {
+			latest = p
+		}
+	}
+	momentum := max(min(latest.Value, 100), -100) / 100
+	return &momentum
+}
+
+// aggregatePattern matches FotMob's aggregate string, e.g. "4 - 3" or "Agg. 4-3".
+var aggregatePattern = regexp.MustCompile(`(\d+)\s*-\s*(\d+)`)
+
+// aggregate parses the aggregate score of a two-legged tie, or returns nil if there is none.
+// The first leg is derived from this match's score when it is known. The tiebreak note is
+// display text only (e.g. ties decided on away goals), naming the team that went through.
+func (s status) aggregate(homeScore, awayScore *int, homeName, awayName string) *api.AggregateScore {
+	match := aggregatePattern.FindStringSubmatch(s.AggregatedStr)
+	if match == nil {
+		return nil
+	}
+
+	agg := &api.AggregateScore{
+		Home: parseInt(match[1]),
+		Away: parseInt(match[2]),
+	}
+
+	if homeScore != nil && awayScore != nil {
+		first := api.LegScore{Home: agg.Home - *homeScore, Away: agg.Away - *awayScore}
+		if first.Home >= 0 && first.Away >= 0 {
+			agg.FirstLeg = &first
+		}
+	}
+
+	// Level aggregates are settled by away goals, extra time or penalties - name who went through
+	if agg.Home == agg.Away && s.WhoLostOnAggregated != "" {
+		winner := homeName
+		if homeName == s.WhoLostOnAggregated {
+			winner = awayName
+		}
+		agg.Note = winner + " advance"
+	}
+
+	return agg
+}
+
+// advancedTeam returns the ID of the team that went through the two-legged tie this match
+// settled: the one FotMob names when the aggregate was level (away goals, penalties),
+// otherwise the one ahead on aggregate. Single legs and ties still being played have none.
+func advancedTeam(details *api.MatchDetails, whoLost string) *int {
+	agg := details.Aggregate
+	if agg == nil || details.Status != api.MatchStatusFinished {
+		return nil
+	}
+
+	var winner api.Team
+	penalties := details.Penalties
+	switch {
+	case whoLost != "" && whoLost == details.HomeTeam.Name:
+		winner = details.AwayTeam
+	case whoLost != "" && whoLost == details.AwayTeam.Name:
+		winner = details.HomeTeam
+	case agg.Home > agg.Away:
+		winner = details.HomeTeam
+	case agg.Away > agg.Home:
+		winner = details.AwayTeam
+	case penalties != nil && penalties.Home != nil && penalties.Away != nil && *penalties.Home != *penalties.Away:
+		winner = details.AwayTeam
+		if *penalties.Home > *penalties.Away {
+			winner = details.HomeTeam
+		}
+	default:
+		return nil
+	}
+	return &winner.ID
+}
+
+// roundName returns the round of a match for display: FotMob's stage name when it has one
+// ("Quarter-final"), otherwise the matchday ("12" becomes "Matchday 12").
+func roundName(name, round string) string {
+	if name = strings.TrimSpace(name); name != "" {
+		return name
+	}
+	round = strings.TrimSpace(round)
+	if _, err := strconv.Atoi(round); err == nil {
+		return "Matchday " + round
+	}
+	return round
+}
+
 // Helper function to parse int from string
 // Returns 0 if parsing fails (for required fields)
 func parseInt(s string) int {
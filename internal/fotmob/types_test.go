@@ -0,0 +1,407 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// postponedMatchFixture is a trimmed FotMob league fixture for a postponed match.
+// FotMob keeps started/finished false and omits the score, flagging the state via reason.
+const postponedMatchFixture = `{
+	"id": "4506123",
+	"round": "21",
+	"home": {"id": "8456", "name": "Manchester City", "shortName": "Man City"},
+	"away": {"id": "9825", "name": "Arsenal", "shortName": "Arsenal"},
+	"status": {
+		"utcTime": "2026-02-14T15:00:00Z",
+		"started": false,
+		"finished": true,
+		"cancelled": false,
+		"reason": {"short": "PP", "long": "Postponed", "shortKey": "postponed_short"}
+	}
+}`
+
+func TestToAPIMatchStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		fixture       string
+		wantStatus    api.MatchStatus
+		wantNilScores bool
+	}{
+		{
+			name:          "postponed match",
+			fixture:       postponedMatchFixture,
+			wantStatus:    api.MatchStatusPostponed,
+			wantNilScores: true,
+		},
+		{
+			name:          "abandoned match keeps score",
+			fixture:       `{"id": "1", "status": {"started": true, "finished": true, "score": {"home": 1, "away": 0}, "reason": {"short": "Ab", "long": "Abandoned"}}}`,
+			wantStatus:    api.MatchStatusAbandoned,
+			wantNilScores: false,
+		},
+		{
+			name:          "finished match",
+			fixture:       `{"id": "2", "status": {"started": true, "finished": true, "score": {"home": 2, "away": 2}, "reason": {"short": "FT", "long": "Full-Time"}}}`,
+			wantStatus:    api.MatchStatusFinished,
+			wantNilScores: false,
+		},
+		{
+			name:          "not started match",
+			fixture:       `{"id": "3", "status": {"started": false, "finished": false}}`,
+			wantStatus:    api.MatchStatusNotStarted,
+			wantNilScores: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fm fotmobMatch
+			if err := json.Unmarshal([]byte(tt.fixture), &fm); err != nil {
+				t.Fatalf("unmarshal fixture: %v", err)
+			}
+
+			match := fm.toAPIMatch()
+			if match.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", match.Status, tt.wantStatus)
+			}
+			gotNil := match.HomeScore == nil || match.AwayScore == nil
+			if gotNil != tt.wantNilScores {
+				t.Errorf("nil scores = %v, want %v", gotNil, tt.wantNilScores)
+			}
+		})
+	}
+}
+
+func TestStatusAggregate(t *testing.T) {
+	home, away := 3, 1
+
+	agg := status{AggregatedStr: "4 - 3"}.aggregate(&home, &away, "Napoli", "Roma")
+	if agg == nil || agg.Home != 4 || agg.Away != 3 {
+		t.Fatalf("aggregate = %+v, want 4 - 3", agg)
+	}
+	if agg.FirstLeg == nil || agg.FirstLeg.Home != 1 || agg.FirstLeg.Away != 2 {
+		t.Errorf("first leg = %+v, want 1 - 2", agg.FirstLeg)
+	}
+
+	level := status{AggregatedStr: "2-2", WhoLostOnAggregated: "Napoli"}.aggregate(&away, &away, "Napoli", "Roma")
+	if level == nil || level.Note != "Roma advance" {
+		t.Errorf("level aggregate note = %+v, want Roma advance", level)
+	}
+
+	if got := (status{}).aggregate(&home, &away, "Napoli", "Roma"); got != nil {
+		t.Errorf("aggregate without aggregatedStr = %+v, want nil", got)
+	}
+}
+
+// penaltiesTieFixture is a second leg level on aggregate (1-0, after 0-1 away) that the
+// away side won on penalties.
+const penaltiesTieFixture = `{
+	"general": {"matchId": "9", "homeTeam": {"id": 10, "name": "Napoli"}, "awayTeam": {"id": 20, "name": "Roma"}},
+	"header": {
+		"teams": [{"id": 10, "name": "Napoli", "score": 1}, {"id": 20, "name": "Roma", "score": 0}],
+		"status": {"started": true, "finished": true, "aggregatedStr": "1 - 1", "whoLostOnAggregated": "Napoli"}
+	},
+	"content": {"matchFacts": {"events": {"events": [], "penaltyShootoutEvents": [
+		{"type": "Goal", "penShootoutScore": [3, 4]}
+	]}}}
+}`
+
+func TestToAPIMatchDetailsAdvanced(t *testing.T) {
+	var fm fotmobMatchDetails
+	if err := json.Unmarshal([]byte(penaltiesTieFixture), &fm); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+	details, err := fm.toAPIMatchDetails()
+	if err != nil {
+		t.Fatalf("toAPIMatchDetails() error = %v", err)
+	}
+	if details.Advanced == nil || *details.Advanced != 20 {
+		t.Errorf("Advanced = %v, want Roma (20), through on penalties", details.Advanced)
+	}
+
+	// Without FotMob naming the loser, the penalties decide
+	details.Advanced = advancedTeam(details, "")
+	if details.Advanced == nil || *details.Advanced != 20 {
+		t.Errorf("Advanced from the shootout = %v, want Roma (20)", details.Advanced)
+	}
+
+	// Nobody is through while the second leg is being played, or in a single leg
+	details.Status = api.MatchStatusLive
+	if got := advancedTeam(details, ""); got != nil {
+		t.Errorf("Advanced in a tie in progress = %d, want nil", *got)
+	}
+	details.Status, details.Aggregate = api.MatchStatusFinished, nil
+	if got := advancedTeam(details, "Napoli"); got != nil {
+		t.Errorf("Advanced in a single leg = %d, want nil", *got)
+	}
+}
+
+func TestMomentumSeries(t *testing.T) {
+	got := momentumSeries([]fotmobMomentumPoint{
+		{Minute: 1, Value: 0},
+		{Minute: 2, Value: 100},
+		{Minute: 3, Value: -40},
+		{Minute: 4, Value: -250},
+	})
+	want := []float64{50, 100, 30, 0}
+	if len(got) != len(want) {
+		t.Fatalf("momentumSeries() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("momentumSeries()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := momentumSeries(nil); got != nil {
+		t.Errorf("momentumSeries(nil) = %v, want nil", got)
+	}
+}
+
+func TestToAPIMatchDetailsLiveMomentum(t *testing.T) {
+	const fixture = `{
+		"header": {
+			"teams": [{"id": 1, "name": "Arsenal", "score": 0}, {"id": 2, "name": "Chelsea", "score": 0}],
+			"status": {"utcTime": "2026-02-14T15:00:00Z", "started": true, "finished": %t}
+		},
+		"general": {"matchId": "1", "homeTeam": {"id": 1, "name": "Arsenal"}, "awayTeam": {"id": 2, "name": "Chelsea"}},
+		"content": {"momentum": {"main": {"data": [
+			{"minute": 1, "value": 20}, {"minute": 34, "value": -60}, {"minute": 33, "value": 80}
+		]}}}
+	}`
+	parse := func(finished bool) *api.MatchDetails {
+		t.Helper()
+		var fm fotmobMatchDetails
+		if err := json.Unmarshal([]byte(fmt.Sprintf(fixture, finished)), &fm); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		details, err := fm.toAPIMatchDetails()
+		if err != nil {
+			t.Fatalf("toAPIMatchDetails() error = %v", err)
+		}
+		return details
+	}
+
+	// The latest minute says who is on top, whatever the order of the points
+	if details := parse(false); details.LiveMomentum == nil || *details.LiveMomentum != -0.6 {
+		t.Errorf("LiveMomentum of a live match = %v, want -0.6", details.LiveMomentum)
+	}
+	if details := parse(true); details.LiveMomentum != nil || len(details.MomentumSeries) != 3 {
+		t.Errorf("finished match: LiveMomentum set = %t, series %v; want only the series", details.LiveMomentum != nil, details.MomentumSeries)
+	}
+	if liveMomentum(nil) != nil {
+		t.Error("liveMomentum(nil) should be nil")
+	}
+}
+
+// malformedStatsFixture is trimmed match details whose stats block has changed shape
+// (a string where a category object is expected) and with one unreadable event.
+const malformedStatsFixture = `{
+	"header": {
+		"teams": [{"id": 9825, "name": "Arsenal", "score": 2}, {"id": 8455, "name": "Chelsea", "score": 1}],
+		"status": {"utcTime": "2026-02-14T15:00:00Z", "started": true, "finished": true, "reason": {"short": "FT", "long": "Full-Time"}}
+	},
+	"general": {
+		"matchId": "4506124",
+		"homeTeam": {"id": 9825, "name": "Arsenal"},
+		"awayTeam": {"id": 8455, "name": "Chelsea"}
+	},
+	"content": {
+		"matchFacts": {
+			"events": {
+				"events": [
+					{"time": 12, "type": "Goal", "isHome": true, "player": {"id": 1, "name": "Saka"}, "isPenalty": true},
+					{"time": "oops", "type": "Card"},
+					{"time": 67, "type": "Goal", "isHome": false, "player": {"id": 2, "name": "Palmer"}},
+					{"time": 88, "type": "Goal", "isHome": true, "player": {"id": 3, "name": "Colwill"}, "ownGoal": true}
+				]
+			}
+		},
+		"stats": {"periods": {"all": {"stats": ["not a category"]}}}
+	}
+}`
+
+func TestToAPIMatchDetailsToleratesBrokenSections(t *testing.T) {
+	var fm fotmobMatchDetails
+	if err := json.Unmarshal([]byte(malformedStatsFixture), &fm); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+
+	details, err := fm.toAPIMatchDetails()
+	if !errors.Is(err, api.ErrPartialDetails) {
+		t.Fatalf("toAPIMatchDetails() error = %v, want api.ErrPartialDetails", err)
+	}
+	for _, section := range []string{"stats", "events"} {
+		if !strings.Contains(err.Error(), section) {
+			t.Errorf("error %q does not name the %s section", err, section)
+		}
+	}
+
+	if details.HomeScore == nil || *details.HomeScore != 2 || details.AwayScore == nil || *details.AwayScore != 1 {
+		t.Errorf("score = %v-%v, want 2-1", details.HomeScore, details.AwayScore)
+	}
+	if len(details.Statistics) != 0 {
+		t.Errorf("Statistics = %v, want none from the broken block", details.Statistics)
+	}
+
+	var goals []string
+	for _, event := range details.Events {
+		if event.Type == "goal" && event.Player != nil {
+			goals = append(goals, *event.Player+"/"+event.GoalType)
+		}
+	}
+	want := []string{"Saka/" + api.GoalTypePenalty, "Palmer/", "Colwill/" + api.GoalTypeOwnGoal}
+	if !reflect.DeepEqual(goals, want) {
+		t.Errorf("goals = %v, want %v", goals, want)
+	}
+}
+
+func TestFotmobMatchesSkipsMalformed(t *testing.T) {
+	var matches fotmobMatches
+	fixture := `[{"id": "1", "status": {"started": false}}, {"id": 2, "status": "changed"}, {"id": "3"}]`
+	if err := json.Unmarshal([]byte(fixture), &matches); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+	if len(matches) != 2 || matches[0].ID != "1" || matches[1].ID != "3" {
+		t.Errorf("matches = %+v, want matches 1 and 3", matches)
+	}
+}
+
+func TestToAPIMatchDetailsCardTypes(t *testing.T) {
+	fixture := `{
+		"general": {"matchId": "1", "homeTeam": {"id": 1, "name": "Arsenal"}, "awayTeam": {"id": 2, "name": "Chelsea"}},
+		"content": {"matchFacts": {"events": {"events": [
+			{"time": 20, "type": "Card", "card": "Yellow", "isHome": true, "player": {"id": 1, "name": "Rice"}},
+			{"time": 60, "type": "Card", "card": "YellowRed", "isHome": true, "player": {"id": 1, "name": "Rice"}},
+			{"time": 75, "type": "Card", "card": "Red", "isHome": false, "player": {"id": 2, "name": "James"}}
+		]}}}
+	}`
+	var fm fotmobMatchDetails
+	if err := json.Unmarshal([]byte(fixture), &fm); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+
+	details, err := fm.toAPIMatchDetails()
+	if err != nil {
+		t.Fatalf("toAPIMatchDetails() error = %v", err)
+	}
+
+	var cards []string
+	for _, event := range details.Events {
+		if event.Type == "card" && event.EventType != nil {
+			cards = append(cards, *event.EventType)
+		}
+	}
+	want := []string{api.CardYellow, api.CardSecondYellow, api.CardRed}
+	if !slices.Equal(cards, want) {
+		t.Errorf("card types = %q, want %q", cards, want)
+	}
+}
+
+func TestToAPIMatchDetailsDropsDuplicateEvents(t *testing.T) {
+	fixture := `{
+		"general": {"matchId": "1", "homeTeam": {"id": 1, "name": "Arsenal"}, "awayTeam": {"id": 2, "name": "Chelsea"}},
+		"content": {"matchFacts": {"events": {"events": [
+			{"eventId": 10, "time": 12, "type": "Goal", "isHome": true, "player": {"id": 7, "name": "Saka"}},
+			{"eventId": 11, "time": 12, "type": "Goal", "isHome": true, "player": {"id": 7, "name": "Saka"}},
+			{"time": 12, "type": "Card", "card": "Yellow", "isHome": true, "player": {"id": 7, "name": "Saka"}},
+			{"time": 12, "type": "Goal", "isHome": false, "player": {"id": 9, "name": "Palmer"}},
+			{"time": 70, "type": "Goal", "isHome": true, "player": {"id": 7, "name": "Saka"}}
+		]}}}
+	}`
+	var fm fotmobMatchDetails
+	if err := json.Unmarshal([]byte(fixture), &fm); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+
+	details, err := fm.toAPIMatchDetails()
+	if err != nil {
+		t.Fatalf("toAPIMatchDetails() error = %v", err)
+	}
+
+	var events []string
+	for _, event := range details.Events {
+		events = append(events, event.Type+" "+event.DisplayMinute+" "+*event.Player)
+	}
+	want := []string{"goal 12' Saka", "card 12' Saka", "goal 12' Palmer", "goal 70' Saka"}
+	if !slices.Equal(events, want) {
+		t.Errorf("events = %q, want %q", events, want)
+	}
+}
+
+// varReviewsFixture has a home goal VAR ruled out for offside, still listed by FotMob,
+// and an away penalty awarded on review.
+const varReviewsFixture = `{
+	"header": {
+		"teams": [{"id": 1, "name": "Arsenal", "score": 1}, {"id": 2, "name": "Chelsea", "score": 1}],
+		"status": {"utcTime": "2026-02-14T15:00:00Z", "started": true, "finished": true}
+	},
+	"general": {"matchId": "1", "homeTeam": {"id": 1, "name": "Arsenal"}, "awayTeam": {"id": 2, "name": "Chelsea"}},
+	"content": {"matchFacts": {"events": {"events": [
+		{"eventId": 1, "time": 12, "type": "Goal", "isHome": true, "player": {"id": 7, "name": "Saka"}},
+		{"eventId": 2, "time": 40, "type": "Goal", "isHome": true, "player": {"id": 29, "name": "Havertz"}},
+		{"eventId": 3, "time": 41, "type": "VAR", "isHome": true,
+			"VAR": {"decision": {"key": "goal_not_awarded", "value": "Goal not awarded"}, "reason": {"key": "offside", "value": "Offside"}}},
+		{"eventId": 4, "time": 60, "type": "VAR", "isHome": false,
+			"VAR": {"decision": {"key": "penalty_awarded", "value": "Penalty awarded"}, "reason": {"key": "handball", "value": "Handball"}}},
+		{"eventId": 5, "time": 62, "type": "Goal", "isHome": false, "player": {"id": 20, "name": "Palmer"}, "isPenalty": true}
+	]}}}
+}`
+
+func TestToAPIMatchDetailsVARDecisions(t *testing.T) {
+	var fm fotmobMatchDetails
+	if err := json.Unmarshal([]byte(varReviewsFixture), &fm); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+
+	details, err := fm.toAPIMatchDetails()
+	if err != nil {
+		t.Fatalf("toAPIMatchDetails() error = %v", err)
+	}
+
+	// The ruled out goal is gone, so the goals match the 1-1 score
+	p := NewLiveUpdateParser()
+	var updates []string
+	for _, event := range details.Events {
+		updates = append(updates, p.formatEvent(event, details.HomeTeam, details.AwayTeam))
+	}
+	want := []string{
+		"● 12' [GOAL] Saka [H]",
+		"◆ 41' [VAR] goal disallowed (offside) [H]",
+		"◆ 60' [VAR] penalty awarded (handball) [A]",
+		"● 62' [GOAL] Palmer (pen) [A]",
+	}
+	if !slices.Equal(updates, want) {
+		t.Errorf("updates = %q, want %q", updates, want)
+	}
+
+	// A goal FotMob doesn't list isn't replaced by an earlier, valid one
+	unlisted := []api.MatchEvent{details.Events[0], details.Events[1]}
+	if got := dropDisallowedGoals(unlisted, map[int]int{1: 1, 2: 1}); len(got) != 2 {
+		t.Errorf("dropDisallowedGoals() kept %d events, want the valid goal and the review", len(got))
+	}
+}
+
+func TestRoundName(t *testing.T) {
+	tests := []struct {
+		name, round, want string
+	}{
+		{"", "12", "Matchday 12"},
+		{"Quarter-final", "5", "Quarter-final"},
+		{"", "Final", "Final"},
+		{"", "", ""},
+	}
+	for _, tt := range tests {
+		if got := roundName(tt.name, tt.round); got != tt.want {
+			t.Errorf("roundName(%q, %q) = %q, want %q", tt.name, tt.round, got, tt.want)
+		}
+	}
+}
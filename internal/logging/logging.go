@@ -0,0 +1,152 @@
+// Package logging writes leveled diagnostics to a log file users can attach to bug reports.
+// Logging is off unless enabled with --debug or GOLAZO_DEBUG; a disabled logger is a no-op.
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Level is the severity of a log line.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the level as written in the log, e.g. "WARN".
+func (l Level) String() string {
+	switch l {
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return "DEBUG"
+	}
+}
+
+// FileName is the log file in the golazo cache directory.
+const FileName = "golazo.log"
+
+// MaxSize is the size at which the log is rotated to FileName+".1", replacing the previous one.
+const MaxSize = 5 * 1024 * 1024
+
+// EnvVar enables logging without the --debug flag. Set it to 1 (or debug) to log everything,
+// or to info, warn or error to log only lines at or above that level.
+const EnvVar = "GOLAZO_DEBUG"
+
+// Logger appends leveled lines to a file, rotating it at MaxSize.
+// A nil *Logger is valid and discards everything, so callers never check whether logging is on.
+type Logger struct {
+	mu       sync.Mutex
+	path     string
+	minLevel Level
+	maxSize  int64
+}
+
+// New returns a logger writing lines at minLevel and above to path.
+func New(path string, minLevel Level) *Logger {
+	return &Logger{path: path, minLevel: minLevel, maxSize: MaxSize}
+}
+
+// Open returns the session's logger: enabled by the --debug flag (everything is logged) or
+// by EnvVar, writing to FileName in the cache directory. Returns nil when logging is off
+// or the cache directory is unavailable.
+func Open(debugFlag bool) *Logger {
+	minLevel, enabled := LevelDebug, debugFlag
+	if !enabled {
+		minLevel, enabled = envLevel(os.Getenv(EnvVar))
+	}
+	if !enabled {
+		return nil
+	}
+
+	dir, err := data.CacheDir()
+	if err != nil {
+		return nil
+	}
+	return New(filepath.Join(dir, FileName), minLevel)
+}
+
+// envLevel parses EnvVar: empty, "0" and "false" leave logging off.
+func envLevel(value string) (Level, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false":
+		return LevelDebug, false
+	case "info":
+		return LevelInfo, true
+	case "warn", "warning":
+		return LevelWarn, true
+	case "error":
+		return LevelError, true
+	default:
+		return LevelDebug, true
+	}
+}
+
+// Path returns the log file, or "" for a nil logger.
+func (l *Logger) Path() string {
+	if l == nil {
+		return ""
+	}
+	return l.path
+}
+
+// Log writes message at level. Failures to write are ignored: diagnostics never break the app.
+func (l *Logger) Log(level Level, message string) {
+	if l == nil || level < l.minLevel {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rotateIfNeeded()
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer func() { _ = f.Close() }()
+	_, _ = fmt.Fprintf(f, "%s %-5s %s\n", time.Now().Format("2006-01-02 15:04:05.000"), level, message)
+}
+
+// Debugf logs a DEBUG line.
+func (l *Logger) Debugf(format string, args ...any) { l.logf(LevelDebug, format, args...) }
+
+// Infof logs an INFO line.
+func (l *Logger) Infof(format string, args ...any) { l.logf(LevelInfo, format, args...) }
+
+// Warnf logs a WARN line.
+func (l *Logger) Warnf(format string, args ...any) { l.logf(LevelWarn, format, args...) }
+
+// Errorf logs an ERROR line.
+func (l *Logger) Errorf(format string, args ...any) { l.logf(LevelError, format, args...) }
+
+// logf formats only when the line will be written, keeping disabled logging cheap.
+func (l *Logger) logf(level Level, format string, args ...any) {
+	if l == nil || level < l.minLevel {
+		return
+	}
+	l.Log(level, fmt.Sprintf(format, args...))
+}
+
+// rotateIfNeeded moves a log grown past maxSize aside to path+".1". Called with mu held.
+func (l *Logger) rotateIfNeeded() {
+	info, err := os.Stat(l.path)
+	if err != nil || info.Size() < l.maxSize {
+		return
+	}
+	_ = os.Rename(l.path, l.path+".1")
+}
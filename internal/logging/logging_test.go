@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoggerLevels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	l := New(path, LevelWarn)
+
+	l.Debugf("debug %d", 1)
+	l.Infof("info %d", 2)
+	l.Warnf("warn %d", 3)
+	l.Errorf("error %d", 4)
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(got)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2 (WARN and ERROR):\n%s", len(lines), got)
+	}
+	if !strings.Contains(lines[0], "WARN  warn 3") || !strings.Contains(lines[1], "ERROR error 4") {
+		t.Errorf("unexpected lines:\n%s", got)
+	}
+}
+
+func TestNilLoggerIsNoop(t *testing.T) {
+	var l *Logger
+	l.Debugf("ignored")
+	l.Log(LevelError, "ignored")
+	if l.Path() != "" {
+		t.Errorf("Path() = %q, want empty", l.Path())
+	}
+}
+
+func TestLoggerRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	l := New(path, LevelDebug)
+	l.maxSize = 64
+
+	for range 4 {
+		l.Infof("%s", strings.Repeat("x", 40))
+	}
+
+	rotated, err := os.Stat(path + ".1")
+	if err != nil {
+		t.Fatalf("rotated log missing: %v", err)
+	}
+	current, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("log missing: %v", err)
+	}
+	if rotated.Size() < l.maxSize || current.Size() >= 2*l.maxSize {
+		t.Errorf("rotated %d bytes, current %d bytes; want the log rotated at %d", rotated.Size(), current.Size(), l.maxSize)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	tests := []struct {
+		env      string
+		flag     bool
+		wantNil  bool
+		minLevel Level
+	}{
+		{env: "", wantNil: true},
+		{env: "0", wantNil: true},
+		{env: "", flag: true, minLevel: LevelDebug},
+		{env: "1", minLevel: LevelDebug},
+		{env: "warn", minLevel: LevelWarn},
+		{env: "ERROR", minLevel: LevelError},
+		{env: "error", flag: true, minLevel: LevelDebug},
+	}
+	for _, tt := range tests {
+		t.Setenv(EnvVar, tt.env)
+		l := Open(tt.flag)
+		if (l == nil) != tt.wantNil {
+			t.Errorf("Open(%v) with %s=%q: nil = %v, want %v", tt.flag, EnvVar, tt.env, l == nil, tt.wantNil)
+			continue
+		}
+		if l != nil && (l.minLevel != tt.minLevel || filepath.Base(l.Path()) != FileName) {
+			t.Errorf("Open(%v) with %s=%q: level %v at %s", tt.flag, EnvVar, tt.env, l.minLevel, l.Path())
+		}
+	}
+}
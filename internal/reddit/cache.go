@@ -16,30 +16,37 @@ const (
 	// CacheTTL defines how long goal links are stored.
 	// 7 days keeps the cache file small while covering recent matches.
 	CacheTTL = 7 * 24 * time.Hour // 7 days
-	// NotFoundTTL defines how long to cache "not found" results.
-	// Shorter than CacheTTL since links might appear later.
-	NotFoundTTL = 5 * time.Minute // 5 minutes
+	// DefaultNotFoundTTL defines how long "not found" results are trusted before re-searching.
+	// Short, since links are often posted after the goal (or the search was rate-limited).
+	DefaultNotFoundTTL = 5 * time.Minute
 	// NotFoundMarker is a special URL indicating "searched but not found"
 	NotFoundMarker = "__NOT_FOUND__"
 )
 
 // GoalLinkCache provides persistent storage for goal replay links.
 type GoalLinkCache struct {
-	mu       sync.RWMutex
-	links    map[string]GoalLink // key: "matchID:minute"
-	filePath string
+	mu          sync.RWMutex
+	links       map[string]GoalLink // key: "matchID:minute"
+	filePath    string
+	notFoundTTL time.Duration // Age after which "not found" markers are treated as misses
 }
 
-// NewGoalLinkCache creates a new cache, loading existing data from disk.
+// NewGoalLinkCache creates a new cache in the golazo config directory, loading existing data from disk.
 func NewGoalLinkCache() (*GoalLinkCache, error) {
 	dir, err := data.ConfigDir()
 	if err != nil {
 		return nil, fmt.Errorf("get config dir: %w", err)
 	}
+	return NewGoalLinkCacheAt(dir), nil
+}
 
+// NewGoalLinkCacheAt creates a new cache stored in dir, loading existing data from disk.
+// Tests use it to keep away from the user's cache.
+func NewGoalLinkCacheAt(dir string) *GoalLinkCache {
 	cache := &GoalLinkCache{
-		links:    make(map[string]GoalLink),
-		filePath: filepath.Join(dir, goalLinksFileName),
+		links:       make(map[string]GoalLink),
+		filePath:    filepath.Join(dir, goalLinksFileName),
+		notFoundTTL: DefaultNotFoundTTL,
 	}
 
 	// Load existing cache from disk (silently ignore errors - start with empty cache)
@@ -48,7 +55,28 @@ func NewGoalLinkCache() (*GoalLinkCache, error) {
 	// Clean expired entries on startup to keep file size manageable
 	_ = cache.CleanExpired()
 
-	return cache, nil
+	return cache
+}
+
+// SetNotFoundTTL sets how long "not found" markers are trusted before the goal is searched again.
+// Non-positive values reset it to DefaultNotFoundTTL.
+func (c *GoalLinkCache) SetNotFoundTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultNotFoundTTL
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notFoundTTL = ttl
+}
+
+// notFoundExpired reports whether a "not found" marker is older than the retry window.
+// Markers written before NotFoundAt existed fall back to FetchedAt.
+func (c *GoalLinkCache) notFoundExpired(link GoalLink) bool {
+	since := link.FetchedAt
+	if link.NotFoundAt != nil {
+		since = *link.NotFoundAt
+	}
+	return time.Since(since) > c.notFoundTTL
 }
 
 // makeKey creates a cache key from matchID and minute.
@@ -57,8 +85,9 @@ func makeKey(key GoalLinkKey) string {
 }
 
 // Get retrieves a goal link from cache if it exists and is not expired.
-// Returns nil if not cached or expired.
-// To distinguish "not found" from "not cached", use Exists().
+// Returns nil if not cached or expired. A "not found" marker older than the retry
+// window is also a miss, so callers search again; use IsNotFound on the result
+// to distinguish a fresh "not found" from a link.
 func (c *GoalLinkCache) Get(key GoalLinkKey) *GoalLink {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -71,8 +100,8 @@ func (c *GoalLinkCache) Get(key GoalLinkKey) *GoalLink {
 
 	// Check if this is a "not found" marker
 	if link.URL == NotFoundMarker {
-		// Check TTL for not-found entries (shorter)
-		if time.Since(link.FetchedAt) > NotFoundTTL {
+		// Check retry window for not-found entries (shorter)
+		if c.notFoundExpired(link) {
 			return nil // Expired, allow retry
 		}
 		return &link // Return marker to indicate "searched but not found"
@@ -87,6 +116,7 @@ func (c *GoalLinkCache) Get(key GoalLinkKey) *GoalLink {
 }
 
 // IsNotFound returns true if the cached entry is a "not found" marker.
+// Get never returns expired markers, so a true result means "searched recently, not found".
 func IsNotFound(link *GoalLink) bool {
 	return link != nil && link.URL == NotFoundMarker
 }
@@ -94,11 +124,13 @@ func IsNotFound(link *GoalLink) bool {
 // SetNotFound stores a "not found" marker in the cache.
 // This prevents re-fetching goals that weren't found on Reddit.
 func (c *GoalLinkCache) SetNotFound(matchID, minute int) error {
+	now := time.Now()
 	return c.Set(GoalLink{
-		MatchID:   matchID,
-		Minute:    minute,
-		URL:       NotFoundMarker,
-		FetchedAt: time.Now(),
+		MatchID:    matchID,
+		Minute:     minute,
+		URL:        NotFoundMarker,
+		FetchedAt:  now,
+		NotFoundAt: &now,
 	})
 }
 
@@ -120,9 +152,13 @@ func (c *GoalLinkCache) All(matchID int) []GoalLink {
 
 	var result []GoalLink
 	for _, link := range c.links {
-		if link.MatchID == matchID && time.Since(link.FetchedAt) <= CacheTTL {
-			result = append(result, link)
+		if link.MatchID != matchID || time.Since(link.FetchedAt) > CacheTTL {
+			continue
+		}
+		if link.URL == NotFoundMarker && c.notFoundExpired(link) {
+			continue
 		}
+		result = append(result, link)
 	}
 	return result
 }
@@ -136,6 +172,25 @@ func (c *GoalLinkCache) Clear() error {
 	return c.saveLocked()
 }
 
+// ClearNotFound removes the "not found" markers of a match, so its goals are searched
+// again on the next lookup. Returns how many markers were removed.
+func (c *GoalLinkCache) ClearNotFound(matchID int) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, link := range c.links {
+		if link.MatchID == matchID && link.URL == NotFoundMarker {
+			delete(c.links, key)
+			removed++
+		}
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, c.saveLocked()
+}
+
 // CleanExpired removes expired entries from the cache.
 // Uses different TTLs for regular links vs "not found" markers.
 func (c *GoalLinkCache) CleanExpired() error {
@@ -144,16 +199,14 @@ func (c *GoalLinkCache) CleanExpired() error {
 
 	cleaned := false
 	for key, link := range c.links {
-		age := time.Since(link.FetchedAt)
-
-		// Use shorter TTL for "not found" entries
+		// Use the retry window for "not found" entries
 		if link.URL == NotFoundMarker {
-			if age > NotFoundTTL {
+			if c.notFoundExpired(link) {
 				delete(c.links, key)
 				cleaned = true
 			}
 		} else {
-			if age > CacheTTL {
+			if time.Since(link.FetchedAt) > CacheTTL {
 				delete(c.links, key)
 				cleaned = true
 			}
@@ -0,0 +1,106 @@
+package reddit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGoalLinkCacheNotFoundExpiry(t *testing.T) {
+	cache := NewGoalLinkCacheAt(t.TempDir())
+	cache.SetNotFoundTTL(time.Hour)
+	key := GoalLinkKey{MatchID: 1, Minute: 18}
+
+	if err := cache.SetNotFound(key.MatchID, key.Minute); err != nil {
+		t.Fatalf("SetNotFound: %v", err)
+	}
+	if link := cache.Get(key); !IsNotFound(link) {
+		t.Fatalf("fresh marker should be a definitive negative, got %+v", link)
+	}
+
+	// Age the marker past the retry window
+	stale := time.Now().Add(-2 * time.Hour)
+	link := cache.links[makeKey(key)]
+	link.NotFoundAt = &stale
+	cache.links[makeKey(key)] = link
+
+	if got := cache.Get(key); got != nil {
+		t.Errorf("expired marker should be a cache miss, got %+v", got)
+	}
+	if got := cache.All(key.MatchID); len(got) != 0 {
+		t.Errorf("All should skip expired markers, got %+v", got)
+	}
+
+	// Widening the window makes the marker valid again
+	cache.SetNotFoundTTL(3 * time.Hour)
+	if link := cache.Get(key); !IsNotFound(link) {
+		t.Errorf("marker within widened window should be a negative, got %+v", link)
+	}
+}
+
+func TestGoalLinkCacheRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewGoalLinkCacheAt(dir)
+	link := GoalLink{
+		MatchID:   4506123,
+		Minute:    23,
+		URL:       "https://streamin.one/v/abc123",
+		Title:     "Arsenal [1] - 0 Chelsea - Saka 23'",
+		FetchedAt: time.Now(),
+	}
+	if err := cache.Set(link); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := cache.SetNotFound(link.MatchID, 67); err != nil {
+		t.Fatalf("SetNotFound: %v", err)
+	}
+
+	// A new cache on the same directory loads both from disk
+	reloaded := NewGoalLinkCacheAt(dir)
+	got := reloaded.Get(GoalLinkKey{MatchID: link.MatchID, Minute: 23})
+	if got == nil || got.URL != link.URL || got.Title != link.Title {
+		t.Errorf("reloaded link = %+v, want %+v", got, link)
+	}
+	if marker := reloaded.Get(GoalLinkKey{MatchID: link.MatchID, Minute: 67}); !IsNotFound(marker) {
+		t.Errorf("reloaded marker = %+v, want not found", marker)
+	}
+	if IsNotFound(got) {
+		t.Error("a link must not read as not found")
+	}
+
+	if err := reloaded.Clear(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+	if size := NewGoalLinkCacheAt(dir).Size(); size != 0 {
+		t.Errorf("cache size after Clear = %d, want 0", size)
+	}
+}
+
+func TestGoalLinkCacheClearNotFound(t *testing.T) {
+	cache := NewGoalLinkCacheAt(t.TempDir())
+	link := GoalLink{MatchID: 1, Minute: 10, URL: "https://streamin.one/v/abc123", FetchedAt: time.Now()}
+	if err := cache.Set(link); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	for _, marker := range []GoalLinkKey{{MatchID: 1, Minute: 55}, {MatchID: 2, Minute: 30}} {
+		if err := cache.SetNotFound(marker.MatchID, marker.Minute); err != nil {
+			t.Fatalf("SetNotFound: %v", err)
+		}
+	}
+
+	removed, err := cache.ClearNotFound(1)
+	if err != nil {
+		t.Fatalf("ClearNotFound: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("ClearNotFound removed %d markers, want 1", removed)
+	}
+	if got := cache.Get(GoalLinkKey{MatchID: 1, Minute: 55}); got != nil {
+		t.Errorf("cleared marker should be a cache miss, got %+v", got)
+	}
+	if got := cache.Get(GoalLinkKey{MatchID: 1, Minute: 10}); got == nil || got.URL != link.URL {
+		t.Errorf("link of the same match = %+v, want it kept", got)
+	}
+	if got := cache.Get(GoalLinkKey{MatchID: 2, Minute: 30}); !IsNotFound(got) {
+		t.Errorf("marker of another match = %+v, want it kept", got)
+	}
+}
@@ -1,7 +1,10 @@
 package reddit
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,8 +29,22 @@ type PublicJSONFetcher struct {
 	httpClient  *http.Client
 	userAgent   string
 	rateLimiter *rateLimiter
+	subreddits  []string // Subreddits to search, in order (without "r/" prefix)
 }
 
+// ErrBlocked is returned when Reddit rate limits a search or answers with a CAPTCHA page.
+var ErrBlocked = errors.New("reddit is rate limiting or blocking requests")
+
+// ErrCaptcha is returned when Reddit answers a search with a CAPTCHA page instead of results.
+// It wraps ErrBlocked, so checks for blocking match it too.
+var ErrCaptcha = fmt.Errorf("%w: got HTML instead of JSON", ErrBlocked)
+
+// BlockCooldown is how long after Reddit blocks a search Client.BlockedUntil reports it as blocking.
+const BlockCooldown = 5 * time.Minute
+
+// DefaultSubreddits is the subreddit list used when none is configured.
+var DefaultSubreddits = []string{"soccer"}
+
 // rateLimiter implements simple rate limiting for Reddit API.
 type rateLimiter struct {
 	mu          sync.Mutex
@@ -54,7 +71,25 @@ func (r *rateLimiter) wait() {
 }
 
 // NewPublicJSONFetcher creates a new fetcher using public Reddit JSON API.
+// Searches DefaultSubreddits (r/soccer).
 func NewPublicJSONFetcher() *PublicJSONFetcher {
+	return NewPublicJSONFetcherWithSubs()
+}
+
+// NewPublicJSONFetcherWithSubs creates a new fetcher that searches the given subreddits in order.
+// Names may be given with or without the "r/" prefix. Falls back to DefaultSubreddits if none are given.
+func NewPublicJSONFetcherWithSubs(subs ...string) *PublicJSONFetcher {
+	subreddits := make([]string, 0, len(subs))
+	for _, sub := range subs {
+		sub = strings.TrimPrefix(strings.TrimSpace(sub), "r/")
+		if sub != "" {
+			subreddits = append(subreddits, sub)
+		}
+	}
+	if len(subreddits) == 0 {
+		subreddits = append(subreddits, DefaultSubreddits...)
+	}
+
 	return &PublicJSONFetcher{
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
@@ -62,13 +97,44 @@ func NewPublicJSONFetcher() *PublicJSONFetcher {
 		// Reddit requires a descriptive User-Agent
 		userAgent:   "golazo:v1.0.0 (by /u/golazo_app)",
 		rateLimiter: newRateLimiter(10), // 10 requests per minute for public API
+		subreddits:  subreddits,
 	}
 }
 
-// Search performs a search on r/soccer for Media posts matching the query.
+// Search performs a search on each configured subreddit for Media posts matching the query.
+// Subreddits are queried in sequence (each request goes through the rate limiter) and
+// results are merged, de-duplicated by URL. An error is returned only if every subreddit failed.
 // matchTime is used to filter results to posts created around the match date.
 // sort controls the result ordering (e.g., "relevance", "top", "new", "hot").
 func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	var results []SearchResult
+	var lastErr error
+	seen := make(map[string]bool)
+	failed := 0
+
+	for _, sub := range f.subreddits {
+		subResults, err := f.searchSubreddit(sub, query, limit, matchTime, sort)
+		if err != nil {
+			lastErr = err
+			failed++
+			continue
+		}
+		for _, result := range subResults {
+			if !seen[result.URL] {
+				seen[result.URL] = true
+				results = append(results, result)
+			}
+		}
+	}
+
+	if failed > 0 && failed == len(f.subreddits) {
+		return nil, lastErr
+	}
+	return results, nil
+}
+
+// searchSubreddit performs a single Media-flair search on one subreddit.
+func (f *PublicJSONFetcher) searchSubreddit(subreddit, query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
 	f.rateLimiter.wait()
 
 	// Build timestamp range for filtering (match day only ±12 hours)
@@ -81,10 +147,11 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 		sort = "relevance"
 	}
 
-	// Build search URL for r/soccer with Media flair filter and timestamp
+	// Build search URL for the subreddit with Media flair filter and timestamp
 	// Reddit CloudSearch supports timestamp:START..END syntax
 	searchURL := fmt.Sprintf(
-		"https://www.reddit.com/r/soccer/search.json?q=%s+flair:Media+timestamp:%d..%d&restrict_sr=on&sort=%s&limit=%d",
+		"https://www.reddit.com/r/%s/search.json?q=%s+flair:Media+timestamp:%d..%d&restrict_sr=on&sort=%s&limit=%d",
+		url.PathEscape(subreddit),
 		url.QueryEscape(query),
 		startTime,
 		endTime,
@@ -104,6 +171,9 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusForbidden {
+		return nil, fmt.Errorf("%w: status %d", ErrBlocked, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("reddit API error: status %d, body: %s", resp.StatusCode, string(body))
@@ -113,6 +183,10 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 	if err != nil {
 		return nil, fmt.Errorf("read response: %w", err)
 	}
+	// A CAPTCHA page comes back as HTML with status 200
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("<")) {
+		return nil, ErrCaptcha
+	}
 
 	var searchResp redditSearchResponse
 	if err := json.Unmarshal(body, &searchResp); err != nil {
@@ -131,12 +205,15 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 	return results, nil
 }
 
-// Client provides goal replay link fetching from Reddit r/soccer.
+// Client provides goal replay link fetching from Reddit (r/soccer by default).
 // Uses Reddit's public JSON API for goal link retrieval.
 type Client struct {
 	fetcher     Fetcher // Reddit public API fetcher
 	cache       *GoalLinkCache
 	debugLogger DebugLogger // Optional debug logger function
+
+	mu        sync.Mutex
+	blockedAt time.Time // When Reddit last blocked a search
 }
 
 // debugLog is a helper method to safely call the debug logger if it exists
@@ -146,6 +223,40 @@ func (c *Client) debugLog(message string) {
 	}
 }
 
+// BlockedUntil returns when the cooldown after Reddit last blocked a search ends.
+// Returns the zero time if no search has been blocked.
+func (c *Client) BlockedUntil() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.blockedAt.IsZero() {
+		return time.Time{}
+	}
+	return c.blockedAt.Add(BlockCooldown)
+}
+
+// search runs a fetcher search, noting when Reddit blocks it.
+func (c *Client) search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
+	results, err := c.fetcher.Search(query, limit, matchTime, sort)
+	if isBlocked(err) {
+		c.mu.Lock()
+		c.blockedAt = time.Now()
+		c.mu.Unlock()
+	}
+	return results, err
+}
+
+// isBlocked reports whether err means Reddit is rate limiting or showing a CAPTCHA.
+func isBlocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, ErrBlocked) ||
+		strings.Contains(err.Error(), "CAPTCHA") ||
+		strings.Contains(err.Error(), "blocking requests") ||
+		strings.Contains(err.Error(), "rate limit") ||
+		strings.Contains(err.Error(), "HTML instead of JSON")
+}
+
 // NewClient creates a new Reddit client with the default public JSON fetcher.
 func NewClient() (*Client, error) {
 	cache, err := NewGoalLinkCache()
@@ -185,14 +296,20 @@ func NewClientWithFetcher(fetcher Fetcher, cache *GoalLinkCache) *Client {
 	}
 }
 
+// SetNotFoundRetryWindow sets how long a "not found" result is trusted before
+// GoalLink searches Reddit again (default DefaultNotFoundTTL).
+func (c *Client) SetNotFoundRetryWindow(window time.Duration) {
+	c.cache.SetNotFoundTTL(window)
+}
+
 // GoalLink retrieves a cached goal link or fetches from Reddit if not cached.
-// Returns nil if the goal link was previously searched but not found.
+// Returns nil if the goal link was searched but not found within the retry window.
 func (c *Client) GoalLink(goal GoalInfo) (*GoalLink, error) {
 	key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
 
 	// Check cache first (includes "not found" markers)
 	if link := c.cache.Get(key); link != nil {
-		// If this is a "not found" marker, return nil (don't re-search)
+		// If this is a recent "not found" marker, return nil (don't re-search yet)
 		if IsNotFound(link) {
 			return nil, nil
 		}
@@ -277,6 +394,77 @@ func (c *Client) GoalLinks(goals []GoalInfo) map[GoalLinkKey]*GoalLink {
 	return results
 }
 
+// PrefetchAsync resolves goal links in a background goroutine, so callers are never blocked by
+// batch delays. Each goal's key is sent on the returned channel as soon as its lookup completes
+// (found or not found); read the result with Cache().Get. Already-cached goals are emitted first,
+// uncached goals are searched in batches of BatchSize with BatchDelay between batches.
+// The channel is buffered for every goal and closed when all goals are processed or ctx is cancelled,
+// so the goroutine exits even if the reader goes away. Cancellation is checked between searches.
+func (c *Client) PrefetchAsync(ctx context.Context, goals []GoalInfo) <-chan GoalLinkKey {
+	out := make(chan GoalLinkKey, len(goals))
+
+	go func() {
+		defer close(out)
+
+		emit := func(key GoalLinkKey) bool {
+			select {
+			case out <- key:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// De-duplicate goals by key and emit already-cached goals right away
+		seen := make(map[GoalLinkKey]bool)
+		var uncachedGoals []GoalInfo
+		for _, goal := range goals {
+			key := GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			if c.cache.Get(key) != nil {
+				if !emit(key) {
+					return
+				}
+				continue
+			}
+			uncachedGoals = append(uncachedGoals, goal)
+		}
+
+		// Fetch uncached goals in batches with conservative delays
+		for i := 0; i < len(uncachedGoals); i += BatchSize {
+			// Add delay between batches (not before first batch)
+			if i > 0 {
+				select {
+				case <-time.After(BatchDelay):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			end := min(i+BatchSize, len(uncachedGoals))
+			for _, goal := range uncachedGoals[i:end] {
+				if ctx.Err() != nil {
+					return
+				}
+				if _, err := c.GoalLink(goal); err != nil {
+					// Errors are not cached - the goal will be retried on the next prefetch
+					c.debugLog(fmt.Sprintf("Prefetch failed for goal %d:%d: %v", goal.MatchID, goal.Minute, err))
+					continue
+				}
+				if !emit(GoalLinkKey{MatchID: goal.MatchID, Minute: goal.Minute}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
 // searchForGoal searches Reddit for a specific goal with conservative retry logic.
 func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
 	// Conservative retry logic - Reddit is very aggressive with CAPTCHA detection
@@ -299,10 +487,7 @@ func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
 		lastErr = err
 
 		// Check if this is a CAPTCHA/rate limit error
-		if strings.Contains(err.Error(), "CAPTCHA") ||
-			strings.Contains(err.Error(), "blocking requests") ||
-			strings.Contains(err.Error(), "rate limit") ||
-			strings.Contains(err.Error(), "HTML instead of JSON") {
+		if isBlocked(err) {
 			// Don't retry CAPTCHA errors - Reddit is very aggressive, just give up
 			c.debugLog(fmt.Sprintf("Reddit blocking goal %d:%d: giving up immediately", goal.MatchID, goal.Minute))
 			return nil, err
@@ -320,10 +505,11 @@ func (c *Client) searchForGoal(goal GoalInfo) (*GoalLink, error) {
 // searchForGoalOnce performs a single search attempt for a goal.
 func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 	// Strategy 1: Both teams + minute (most specific, try first)
-	query1 := fmt.Sprintf("%s %s %d'", goal.HomeTeam, goal.AwayTeam, goal.Minute)
+	// Canonical names expand abbreviations and drop accents ("Man Utd" -> "manchester united")
+	query1 := fmt.Sprintf("%s %s %d'", canonicalTeamName(goal.HomeTeam), canonicalTeamName(goal.AwayTeam), goal.Minute)
 	c.debugLog(fmt.Sprintf("Reddit search query: '%s' for goal %d:%d (%s vs %s)",
 		query1, goal.MatchID, goal.Minute, goal.HomeTeam, goal.AwayTeam))
-	results1, err := c.fetcher.Search(query1, 15, goal.MatchTime, "relevance")
+	results1, err := c.search(query1, 15, goal.MatchTime, "relevance")
 	if err != nil {
 		c.debugLog(fmt.Sprintf("Reddit search failed for query '%s': %v", query1, err))
 	} else {
@@ -366,9 +552,9 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 	if goal.IsHomeTeam {
 		scoringTeam = goal.HomeTeam
 	}
-	query2 := fmt.Sprintf("%s %d'", scoringTeam, goal.Minute)
+	query2 := fmt.Sprintf("%s %d'", canonicalTeamName(scoringTeam), goal.Minute)
 	c.debugLog(fmt.Sprintf("Reddit search query (strategy 2): '%s' for goal %d:%d", query2, goal.MatchID, goal.Minute))
-	results2, err := c.fetcher.Search(query2, 15, goal.MatchTime, "relevance")
+	results2, err := c.search(query2, 15, goal.MatchTime, "relevance")
 	if err != nil {
 		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 2 query '%s': %v", query2, err))
 	} else {
@@ -427,7 +613,7 @@ func (c *Client) searchForGoalOnce(goal GoalInfo) (*GoalLink, error) {
 
 	query3 := fmt.Sprintf("%s %s %d'", homeQuery, awayQuery, goal.Minute)
 	c.debugLog(fmt.Sprintf("Reddit search query (strategy 3): '%s' for goal %d:%d", query3, goal.MatchID, goal.Minute))
-	results3, err := c.fetcher.Search(query3, 15, goal.MatchTime, "top")
+	results3, err := c.search(query3, 15, goal.MatchTime, "top")
 	if err != nil {
 		c.debugLog(fmt.Sprintf("Reddit search failed for strategy 3 query '%s': %v", query3, err))
 	} else {
@@ -0,0 +1,53 @@
+package reddit
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// blockingFetcher fails every search with err.
+type blockingFetcher struct{ err error }
+
+func (f blockingFetcher) Search(string, int, time.Time, string) ([]SearchResult, error) {
+	return nil, f.err
+}
+
+func TestBlockedUntil(t *testing.T) {
+	newCache := func() *GoalLinkCache {
+		return &GoalLinkCache{
+			links:       make(map[string]GoalLink),
+			filePath:    filepath.Join(t.TempDir(), goalLinksFileName),
+			notFoundTTL: time.Hour,
+		}
+	}
+	goal := GoalInfo{MatchID: 1, Minute: 18, HomeTeam: "Arsenal", AwayTeam: "Chelsea", IsHomeTeam: true}
+
+	client := NewClientWithFetcher(blockingFetcher{err: fmt.Errorf("%w: status 429", ErrBlocked)}, newCache())
+	if until := client.BlockedUntil(); !until.IsZero() {
+		t.Fatalf("BlockedUntil() before any search = %v, want zero", until)
+	}
+	before := time.Now()
+	_, _ = client.GoalLink(goal)
+	if until := client.BlockedUntil(); until.Before(before.Add(BlockCooldown)) {
+		t.Errorf("BlockedUntil() after a blocked search = %v, want at least %v", until, before.Add(BlockCooldown))
+	}
+
+	client = NewClientWithFetcher(blockingFetcher{err: fmt.Errorf("fetch from reddit: timeout")}, newCache())
+	_, _ = client.GoalLink(goal)
+	if until := client.BlockedUntil(); !until.IsZero() {
+		t.Errorf("BlockedUntil() after a failed search = %v, want zero", until)
+	}
+}
+
+func TestErrCaptchaIsBlocked(t *testing.T) {
+	err := fmt.Errorf("search r/soccer: %w", ErrCaptcha)
+	if !errors.Is(err, ErrBlocked) || !isBlocked(err) {
+		t.Errorf("a CAPTCHA error should count as blocked: %v", err)
+	}
+	if errors.Is(fmt.Errorf("%w: status 429", ErrBlocked), ErrCaptcha) {
+		t.Error("rate limiting should not count as a CAPTCHA")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/0xjuanma/golazo/internal/textfold"
 )
 
 // Matcher provides loose matching for Reddit goal post titles.
@@ -14,107 +16,155 @@ import (
 //   - "Manchester United [2] - 1 Liverpool - Marcus Rashford 67'"
 //   - "Barcelona 0 - [1] Real Madrid - Vinicius Jr 89'"
 
+// Scoring weights used by scoreResult.
+const (
+	scoreRecentPost    = 5   // Post created close to kick-off
+	scoreTeamFound     = 10  // Per team name found in the title
+	scoreExactMinute   = 30  // Title minute equals the goal minute
+	scoreNearMinute    = 15  // Title minute is one off the goal minute
+	scoreScoreMatch    = 20  // Title shows the score after the goal
+	scoreScoreMismatch = -15 // Title shows a different score
+	scoreScorerFound   = 15  // Scorer name found in the title
+	scoreThirdTeam     = -50 // Title is about a fixture involving another team
+	maxUpvoteBonus     = 5   // Max points from Reddit upvotes (1 per 100)
+
+	// minMatchScore is the minimum score for findBestMatch to accept a result.
+	minMatchScore = 45
+)
+
 // findBestMatch finds the best matching search result for a goal.
-// Uses loose matching: checks for team names, minute, and date proximity.
+// Each result is scored by scoreResult; the highest scoring result is returned
+// if it reaches minMatchScore.
 func findBestMatch(results []SearchResult, goal GoalInfo) *SearchResult {
 	if len(results) == 0 {
 		return nil
 	}
 
-	// Normalize team names for comparison
-	homeNorm := normalizeTeamName(goal.HomeTeam)
-	awayNorm := normalizeTeamName(goal.AwayTeam)
-	minutePattern := buildMinutePattern(goal)
-
-	// Build score pattern for validation (e.g., "1-0", "2-1", etc.)
-	scorePattern := buildScorePattern(goal.HomeScore, goal.AwayScore)
-
 	var bestMatch *SearchResult
 	bestScore := 0
 
 	for i := range results {
-		result := &results[i]
-		titleLower := strings.ToLower(result.Title)
-
-		score := 0
-
-		// Filter by date: post must be within reasonable time of match
-		// Allow posts from 1 day before to 2 days after the match
-		if !goal.MatchTime.IsZero() {
-			postDate := result.CreatedAt
-			matchStart := goal.MatchTime.Add(-24 * time.Hour)
-			matchEnd := goal.MatchTime.Add(48 * time.Hour)
-
-			if postDate.Before(matchStart) || postDate.After(matchEnd) {
-				continue // Post is outside the valid date range
-			}
-
-			// Bonus for posts very close to match time (within 12 hours)
-			if postDate.After(goal.MatchTime.Add(-6*time.Hour)) && postDate.Before(goal.MatchTime.Add(12*time.Hour)) {
-				score += 5
-			}
+		score := scoreResult(results[i], goal)
+		if score > bestScore {
+			bestScore = score
+			bestMatch = &results[i]
 		}
+	}
 
-		// Check for team names (required)
-		homeFound := containsTeamName(titleLower, homeNorm)
-		awayFound := containsTeamName(titleLower, awayNorm)
+	if bestScore < minMatchScore {
+		return nil
+	}
 
-		if !homeFound && !awayFound {
-			continue // Must have at least one team name
-		}
+	return bestMatch
+}
 
-		if homeFound {
-			score += 10
-		}
-		if awayFound {
-			score += 10
+// scoreResult scores how well a Reddit search result matches a goal.
+// Hard requirements (result scores 0 if any fails):
+//   - post created between 1 day before and 2 days after kick-off
+//   - title mentions at least one of the two teams (accent-insensitive)
+//   - title contains a minute within ±1 of the goal minute
+//
+// On top of that, exact minutes, the score after the goal and the scorer name
+// add points, while a different score or a third team in the title subtract.
+func scoreResult(result SearchResult, goal GoalInfo) int {
+	score := 0
+
+	// Filter by date: post must be within reasonable time of match
+	// Allow posts from 1 day before to 2 days after the match
+	if !goal.MatchTime.IsZero() {
+		postDate := result.CreatedAt
+		matchStart := goal.MatchTime.Add(-24 * time.Hour)
+		matchEnd := goal.MatchTime.Add(48 * time.Hour)
+
+		if postDate.Before(matchStart) || postDate.After(matchEnd) {
+			return 0 // Post is outside the valid date range
 		}
 
-		// Check for minute (highly valuable, but strict)
-		minuteFound := minutePattern.MatchString(result.Title)
-		if minuteFound {
-			score += 25
+		// Bonus for posts very close to match time
+		if postDate.After(goal.MatchTime.Add(-6*time.Hour)) && postDate.Before(goal.MatchTime.Add(12*time.Hour)) {
+			score += scoreRecentPost
 		}
+	}
 
-		// Check for score match (required for high confidence)
-		scoreMatch := scorePattern.MatchString(result.Title)
-		if scoreMatch {
-			score += 20 // High bonus for score match
-		} else {
-			// If score doesn't match, heavily penalize this result
-			score -= 15
-		}
+	titleNorm := normalizeText(result.Title)
 
-		// Check for scorer name if available
-		if goal.ScorerName != "" {
-			scorerNorm := normalizeName(goal.ScorerName)
-			if containsName(titleLower, scorerNorm) {
-				score += 15
-			}
-		}
+	// Check for team names (at least one required)
+	homeFound := teamMentioned(titleNorm, goal.HomeTeam, goal.HomeTeamShort)
+	awayFound := teamMentioned(titleNorm, goal.AwayTeam, goal.AwayTeamShort)
+	if !homeFound && !awayFound {
+		return 0
+	}
+	if homeFound {
+		score += scoreTeamFound
+	}
+	if awayFound {
+		score += scoreTeamFound
+	}
 
-		// Prefer higher Reddit score (upvotes) as tiebreaker
-		score += min(result.Score/100, 5) // Max 5 points from upvotes
+	// Check for minute (required, ±1 tolerance)
+	switch minuteDistance(result.Title, goal) {
+	case 0:
+		score += scoreExactMinute
+	case 1:
+		score += scoreNearMinute
+	default:
+		return 0
+	}
 
-		if score > bestScore {
-			bestScore = score
-			bestMatch = result
+	// Check for score match
+	if scoreMatches(result.Title, goal) {
+		score += scoreScoreMatch
+	} else {
+		score += scoreScoreMismatch
+	}
+
+	// Check for scorer name if available
+	if goal.ScorerName != "" {
+		if containsName(titleNorm, normalizeName(goal.ScorerName)) {
+			score += scoreScorerFound
 		}
 	}
 
-	// Require minimum score for a match, with higher requirement for score matches
-	minScore := 45 // Require score match + minute match + team names
-	if bestScore < minScore {
-		return nil
+	// Penalize titles whose fixture involves a team that isn't playing in this match
+	if mentionsThirdTeam(result.Title, goal) {
+		score += scoreThirdTeam
 	}
 
-	return bestMatch
+	// Prefer higher Reddit score (upvotes) as tiebreaker
+	score += min(result.Score/100, maxUpvoteBonus)
+
+	return score
+}
+
+var nonAlphaNum = regexp.MustCompile(`[^a-z0-9\s]`)
+
+// normalizeText lowercases, folds accents and strips punctuation.
+// Whitespace runs are collapsed to single spaces.
+func normalizeText(s string) string {
+	norm := textfold.Fold(s)
+	norm = nonAlphaNum.ReplaceAllString(norm, " ")
+	return strings.Join(strings.Fields(norm), " ")
+}
+
+// teamQualifiers are words that distinguish clubs sharing a city name
+// (e.g., "Manchester City" vs "Manchester United").
+var teamQualifiers = map[string]bool{
+	"city": true, "united": true, "utd": true, "town": true, "county": true,
+	"rovers": true, "wanderers": true, "athletic": true, "albion": true,
+	"hotspur": true, "villa": true, "forest": true, "wednesday": true,
+}
+
+// canonicalTeamName normalizes a team name and expands known abbreviations
+// (e.g., "Man Utd" -> "manchester united", "Atlético" -> "atletico madrid").
+func canonicalTeamName(name string) string {
+	return teamAliases.expand(normalizeText(name))
 }
 
 // normalizeTeamName converts a team name to a normalized form for matching.
+// Expands abbreviations via canonicalTeamName, then drops common club prefixes/suffixes
+// (e.g., "FC Barcelona" -> "barcelona", "Leeds United" -> "leeds").
 func normalizeTeamName(name string) string {
-	// Convert to lowercase
-	norm := strings.ToLower(name)
+	norm := canonicalTeamName(name)
 
 	// Remove common prefixes (e.g., "fc barcelona" -> "barcelona")
 	prefixes := []string{"fc ", "cf ", "sc ", "afc ", "ac ", "as "}
@@ -128,58 +178,178 @@ func normalizeTeamName(name string) string {
 		norm = strings.TrimSuffix(norm, suffix)
 	}
 
-	// Remove special characters
-	norm = regexp.MustCompile(`[^a-z0-9\s]`).ReplaceAllString(norm, "")
-
 	return strings.TrimSpace(norm)
 }
 
-// normalizeName converts a player name to a normalized form for matching.
-func normalizeName(name string) string {
-	norm := strings.ToLower(name)
-	// Remove special characters but keep spaces
-	norm = regexp.MustCompile(`[^a-z\s]`).ReplaceAllString(norm, "")
-	return strings.TrimSpace(norm)
-}
+// teamMentioned reports whether a normalized title mentions a team by its full,
+// short, aliased or core name. A core-name hit immediately followed by a different club
+// qualifier (e.g., "manchester united" when looking for "Manchester City") is rejected.
+func teamMentioned(titleNorm, name, shortName string) bool {
+	for _, n := range []string{name, shortName} {
+		norm := normalizeText(n)
+		if norm == "" {
+			continue
+		}
+		for _, variant := range teamAliases.namesFor(norm) {
+			if containsWords(titleNorm, variant) {
+				return true
+			}
+		}
+	}
 
-// containsTeamName checks if a title contains a team name (or part of it).
-// Normalizes the title first to handle variations like "FC Barcelona" vs "Barcelona".
-func containsTeamName(title, teamNorm string) bool {
-	// Normalize the title for comparison (handles "FC Barcelona" -> "barcelona")
-	titleNorm := normalizeTeamName(title)
+	core := normalizeTeamName(name)
+	if core == "" {
+		return false
+	}
+
+	fullWords := make(map[string]bool)
+	for _, w := range strings.Fields(normalizeText(name) + " " + canonicalTeamName(name)) {
+		fullWords[w] = true
+	}
 
-	// First try exact match on normalized title
-	if strings.Contains(titleNorm, teamNorm) {
+	titleWords := strings.Fields(titleNorm)
+	coreWords := strings.Fields(core)
+	for i := 0; i+len(coreWords) <= len(titleWords); i++ {
+		if !wordsEqual(titleWords[i:i+len(coreWords)], coreWords) {
+			continue
+		}
+		next := i + len(coreWords)
+		if next < len(titleWords) && teamQualifiers[titleWords[next]] && !fullWords[titleWords[next]] {
+			continue // Same city, different club
+		}
 		return true
 	}
 
-	// Try matching individual words (for multi-word team names)
-	words := strings.Fields(teamNorm)
-	if len(words) > 1 {
-		// Check if significant words are present
-		for _, word := range words {
-			if len(word) > 3 && strings.Contains(titleNorm, word) {
-				return true
+	return false
+}
+
+// containsWords reports whether text contains phrase on word boundaries.
+func containsWords(text, phrase string) bool {
+	return strings.Contains(" "+text+" ", " "+phrase+" ")
+}
+
+// wordsEqual reports whether two word slices are identical.
+func wordsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// titleMinutePattern matches minutes in titles, e.g. "41'", "45+2'", "90+4’".
+var titleMinutePattern = regexp.MustCompile(`\b(\d{1,3})(?:\s*\+\s*(\d{1,2}))?\s*['’′]`)
+
+// minuteDistance returns the smallest distance between a minute in the title and the goal minute.
+// Stoppage time is compared both as written ("45+2'") and as total time ("47'").
+// Returns -1 if the title contains no minute.
+func minuteDistance(title string, goal GoalInfo) int {
+	targets := []int{goal.Minute}
+	if total := stoppageTotal(goal.DisplayMinute); total > 0 && total != goal.Minute {
+		targets = append(targets, total)
+	}
+
+	best := -1
+	for _, m := range titleMinutePattern.FindAllStringSubmatch(title, -1) {
+		base, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		candidates := []int{base}
+		if m[2] != "" {
+			if added, err := strconv.Atoi(m[2]); err == nil {
+				candidates = append(candidates, base+added)
+			}
+		}
+		for _, c := range candidates {
+			for _, t := range targets {
+				d := c - t
+				if d < 0 {
+					d = -d
+				}
+				if best == -1 || d < best {
+					best = d
+				}
 			}
 		}
 	}
+	return best
+}
 
-	// Also check original title (case-insensitive) for better coverage
-	titleLower := strings.ToLower(title)
-	if strings.Contains(titleLower, teamNorm) {
-		return true
+// stoppageTotal converts a display minute like "45+2'" to total time (47).
+// Returns 0 if the display minute has no stoppage time.
+func stoppageTotal(displayMinute string) int {
+	base, added, ok := strings.Cut(strings.TrimSuffix(displayMinute, "'"), "+")
+	if !ok {
+		return 0
 	}
+	b, err1 := strconv.Atoi(strings.TrimSpace(base))
+	a, err2 := strconv.Atoi(strings.TrimSpace(added))
+	if err1 != nil || err2 != nil {
+		return 0
+	}
+	return b + a
+}
+
+// fixturePattern splits r/soccer goal titles into sides and score,
+// e.g. "Wolves [3] - 0 West Ham - Mateus Mane 41'".
+var fixturePattern = regexp.MustCompile(`^\s*(.+?)\s*\[?(\d+)\]?\s*-\s*\[?(\d+)\]?\s*(.+?)\s+-\s+`)
+
+// titleFixture is the fixture part of a goal post title.
+type titleFixture struct {
+	home, away           string
+	homeScore, awayScore int
+}
 
-	// Check individual words in original title too
-	for _, word := range words {
-		if len(word) > 3 && strings.Contains(titleLower, word) {
+// parseTitleFixture extracts the fixture from a "Home X - Y Away - Scorer" title.
+func parseTitleFixture(title string) (titleFixture, bool) {
+	m := fixturePattern.FindStringSubmatch(title)
+	if m == nil {
+		return titleFixture{}, false
+	}
+	homeScore, err1 := strconv.Atoi(m[2])
+	awayScore, err2 := strconv.Atoi(m[3])
+	if err1 != nil || err2 != nil {
+		return titleFixture{}, false
+	}
+	return titleFixture{home: m[1], away: m[4], homeScore: homeScore, awayScore: awayScore}, true
+}
+
+// scoreMatches reports whether the title shows the score after the goal.
+// Uses the parsed fixture when the title follows the usual layout, else a loose pattern.
+func scoreMatches(title string, goal GoalInfo) bool {
+	if fixture, ok := parseTitleFixture(title); ok {
+		return fixture.homeScore == goal.HomeScore && fixture.awayScore == goal.AwayScore
+	}
+	return buildScorePattern(goal.HomeScore, goal.AwayScore).MatchString(title)
+}
+
+// mentionsThirdTeam reports whether a title's fixture names a side that is neither team of the goal.
+// Titles that don't follow the "Home X - Y Away - Scorer" layout are not penalized.
+func mentionsThirdTeam(title string, goal GoalInfo) bool {
+	fixture, ok := parseTitleFixture(title)
+	if !ok {
+		return false
+	}
+	for _, side := range []string{fixture.home, fixture.away} {
+		sideNorm := normalizeText(side)
+		if !teamMentioned(sideNorm, goal.HomeTeam, goal.HomeTeamShort) &&
+			!teamMentioned(sideNorm, goal.AwayTeam, goal.AwayTeamShort) {
 			return true
 		}
 	}
-
 	return false
 }
 
+// normalizeName converts a player name to a normalized form for matching.
+func normalizeName(name string) string {
+	return normalizeText(name)
+}
+
 // containsName checks if a title contains a player name.
 func containsName(title, nameNorm string) bool {
 	// First try full name
@@ -199,57 +369,6 @@ func containsName(title, nameNorm string) bool {
 	return false
 }
 
-// buildMinutePattern creates a regex to match a minute in various formats.
-// Matches: "41'", "41" (at word boundary), "41+2'" etc.
-// Also checks +/-2 minute tolerance for better matching.
-// If DisplayMinute contains stoppage time (e.g., "45+2'"), also searches for total time (47').
-func buildMinutePattern(goal GoalInfo) *regexp.Regexp {
-	minute := goal.Minute
-	// Create patterns for minute ±2 tolerance
-	var patterns []string
-	for offset := -2; offset <= 2; offset++ {
-		targetMinute := minute + offset
-		if targetMinute >= 0 {
-			patterns = append(patterns, `\b`+strconv.Itoa(targetMinute)+`(\+\d+)?'?\b`)
-		}
-	}
-
-	// If DisplayMinute contains stoppage time, also search for total time
-	// e.g., "45+2'" should also match "47'" (45 + 2 = 47)
-	if goal.DisplayMinute != "" {
-		// Parse stoppage time like "45+2'" to find total time
-		if plusIndex := strings.Index(goal.DisplayMinute, "+"); plusIndex > 0 {
-			baseMinuteStr := goal.DisplayMinute[:plusIndex]
-			if baseMinute, err := strconv.Atoi(baseMinuteStr); err == nil {
-				// Look for patterns after + (like +2, +3, etc.)
-				plusPart := goal.DisplayMinute[plusIndex+1:]
-				if quoteIndex := strings.Index(plusPart, "'"); quoteIndex > 0 {
-					addedTimeStr := plusPart[:quoteIndex]
-					if addedTime, err := strconv.Atoi(addedTimeStr); err == nil {
-						totalTime := baseMinute + addedTime
-						// Add patterns for the total time ±1 tolerance
-						for offset := -1; offset <= 1; offset++ {
-							targetTotal := totalTime + offset
-							if targetTotal >= 0 && targetTotal != baseMinute { // Avoid duplicate with base minute
-								patterns = append(patterns, `\b`+strconv.Itoa(targetTotal)+`'?\b`)
-							}
-						}
-					}
-				}
-			}
-		}
-	}
-
-	// Join with OR operator
-	patternStr := strings.Join(patterns, "|")
-	compiled, err := regexp.Compile(patternStr)
-	if err != nil {
-		// Fallback to original single minute pattern
-		return regexp.MustCompile(`\b` + strconv.Itoa(minute) + `(\+\d+)?'?\b`)
-	}
-	return compiled
-}
-
 // buildScorePattern creates a regex to match the score at the time of goal.
 // Matches various score formats like "1-0", "2-1", "[1-0]", etc.
 func buildScorePattern(homeScore, awayScore int) *regexp.Regexp {
@@ -276,13 +395,12 @@ const (
 
 // CalculateConfidence returns the confidence level for a match.
 func CalculateConfidence(result SearchResult, goal GoalInfo) MatchConfidence {
-	titleLower := strings.ToLower(result.Title)
-	homeNorm := normalizeTeamName(goal.HomeTeam)
-	awayNorm := normalizeTeamName(goal.AwayTeam)
+	titleNorm := normalizeText(result.Title)
 
-	hasHome := containsTeamName(titleLower, homeNorm)
-	hasAway := containsTeamName(titleLower, awayNorm)
-	hasMinute := buildMinutePattern(goal).MatchString(result.Title)
+	hasHome := teamMentioned(titleNorm, goal.HomeTeam, goal.HomeTeamShort)
+	hasAway := teamMentioned(titleNorm, goal.AwayTeam, goal.AwayTeamShort)
+	dist := minuteDistance(result.Title, goal)
+	hasMinute := dist >= 0 && dist <= 1
 
 	if hasHome && hasAway && hasMinute {
 		return ConfidenceHigh
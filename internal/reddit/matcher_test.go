@@ -0,0 +1,168 @@
+package reddit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScoreResult(t *testing.T) {
+	matchTime := time.Date(2026, 2, 14, 15, 0, 0, 0, time.UTC)
+	postTime := matchTime.Add(time.Hour)
+
+	cityGoal := GoalInfo{
+		MatchID:       1,
+		HomeTeam:      "Manchester City",
+		AwayTeam:      "Arsenal",
+		HomeTeamShort: "Man City",
+		AwayTeamShort: "Arsenal",
+		ScorerName:    "Erling Haaland",
+		Minute:        41,
+		HomeScore:     1,
+		AwayScore:     0,
+		IsHomeTeam:    true,
+		MatchTime:     matchTime,
+	}
+
+	tests := []struct {
+		name      string
+		title     string
+		goal      GoalInfo
+		wantMatch bool
+	}{
+		{
+			name:      "exact minute, score and scorer",
+			title:     "Manchester City [1] - 0 Arsenal - Erling Haaland 41'",
+			goal:      cityGoal,
+			wantMatch: true,
+		},
+		{
+			name:      "minute off by one still matches",
+			title:     "Man City [1] - 0 Arsenal - Erling Haaland 42'",
+			goal:      cityGoal,
+			wantMatch: true,
+		},
+		{
+			name:      "minute off by two is rejected",
+			title:     "Manchester City [1] - 0 Arsenal - Erling Haaland 43'",
+			goal:      cityGoal,
+			wantMatch: false,
+		},
+		{
+			name:      "similarly named team is rejected",
+			title:     "Manchester United [1] - 0 Fulham - Bruno Fernandes 41'",
+			goal:      cityGoal,
+			wantMatch: false,
+		},
+		{
+			name:      "third team in fixture is rejected",
+			title:     "Manchester City [1] - 0 Chelsea - Erling Haaland 41'",
+			goal:      cityGoal,
+			wantMatch: false,
+		},
+		{
+			name:  "accent-insensitive team names",
+			title: "Atletico Madrid 0 - [1] Barcelona - Lamine Yamal 78'",
+			goal: GoalInfo{
+				HomeTeam:   "Atlético Madrid",
+				AwayTeam:   "Barcelona",
+				ScorerName: "Lamine Yamal",
+				Minute:     78,
+				HomeScore:  0,
+				AwayScore:  1,
+				MatchTime:  matchTime,
+			},
+			wantMatch: true,
+		},
+		{
+			name:  "stoppage time as total minutes",
+			title: "Manchester City [1] - 0 Arsenal - Erling Haaland 47'",
+			goal: func() GoalInfo {
+				g := cityGoal
+				g.Minute = 45
+				g.DisplayMinute = "45+2'"
+				return g
+			}(),
+			wantMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SearchResult{Title: tt.title, URL: "https://example.com/v", CreatedAt: postTime}
+			score := scoreResult(result, tt.goal)
+			if got := score >= minMatchScore; got != tt.wantMatch {
+				t.Errorf("scoreResult(%q) = %d, want match %v", tt.title, score, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestScoreResultPrefersExactMinute(t *testing.T) {
+	matchTime := time.Date(2026, 2, 14, 15, 0, 0, 0, time.UTC)
+	goal := GoalInfo{
+		HomeTeam:  "Liverpool",
+		AwayTeam:  "Everton",
+		Minute:    67,
+		HomeScore: 2,
+		AwayScore: 1,
+		MatchTime: matchTime,
+	}
+
+	exact := SearchResult{Title: "Liverpool [2] - 1 Everton - Mohamed Salah 67'", CreatedAt: matchTime}
+	near := SearchResult{Title: "Liverpool [2] - 1 Everton - Mohamed Salah 68'", CreatedAt: matchTime}
+
+	if exactScore, nearScore := scoreResult(exact, goal), scoreResult(near, goal); exactScore <= nearScore {
+		t.Errorf("exact minute score %d should beat near minute score %d", exactScore, nearScore)
+	}
+
+	best := findBestMatch([]SearchResult{near, exact}, goal)
+	if best == nil || best.Title != exact.Title {
+		t.Errorf("findBestMatch picked %v, want exact-minute result", best)
+	}
+}
+
+func TestCanonicalTeamName(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"Man Utd", "Manchester United"},
+		{"Atletico", "Atlético Madrid"},
+		{"Atlético", "Atletico Madrid"},
+		{"PSG", "Paris Saint-Germain"},
+	}
+
+	for _, tt := range tests {
+		if got, want := canonicalTeamName(tt.a), canonicalTeamName(tt.b); got != want {
+			t.Errorf("canonicalTeamName(%q) = %q, want %q (as for %q)", tt.a, got, want, tt.b)
+		}
+	}
+
+	if got := canonicalTeamName("Man City"); got == canonicalTeamName("Man Utd") {
+		t.Errorf("Man City and Man Utd both expand to %q", got)
+	}
+}
+
+func TestTeamMentionedWithAliases(t *testing.T) {
+	tests := []struct {
+		title, name, short string
+		want               bool
+	}{
+		{"Manchester United [1] - 0 Fulham - Bruno Fernandes 41'", "Man Utd", "", true},
+		{"Man Utd [1] - 0 Fulham - Bruno Fernandes 41'", "Manchester United", "", true},
+		{"Atletico [1] - 0 Sevilla - Julian Alvarez 12'", "Atlético Madrid", "Atlético", true},
+		{"Manchester City [1] - 0 Fulham - Erling Haaland 41'", "Man Utd", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := teamMentioned(normalizeText(tt.title), tt.name, tt.short); got != tt.want {
+			t.Errorf("teamMentioned(%q, %q) = %v, want %v", tt.title, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseTeamAliasesRejectsSharedAlias(t *testing.T) {
+	_, err := parseTeamAliases([]byte(`{"manchester united": ["man u"], "manchester city": ["Man U"]}`))
+	if err == nil {
+		t.Error("parseTeamAliases accepted an alias used by two teams")
+	}
+}
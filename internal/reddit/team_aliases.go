@@ -0,0 +1,85 @@
+package reddit
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+// teamAliasesJSON maps a canonical team name to the abbreviations and alternative
+// names it appears under (e.g. "manchester united": ["man utd", "man united"]).
+// Contributors can extend team_aliases.json; names are normalized when loaded.
+//
+//go:embed team_aliases.json
+var teamAliasesJSON []byte
+
+// teamAliases is the alias table built from team_aliases.json.
+var teamAliases = mustParseTeamAliases(teamAliasesJSON)
+
+// aliasTable resolves team name variants to a canonical name and back.
+type aliasTable struct {
+	canonical map[string]string   // normalized alias or canonical name -> canonical name
+	variants  map[string][]string // canonical name -> canonical name followed by its aliases
+}
+
+// parseTeamAliases builds an alias table from canonical -> aliases JSON.
+// Rejects aliases claimed by two different teams, since expansion would be ambiguous.
+func parseTeamAliases(data []byte) (*aliasTable, error) {
+	var raw map[string][]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse team aliases: %w", err)
+	}
+
+	table := &aliasTable{
+		canonical: make(map[string]string),
+		variants:  make(map[string][]string),
+	}
+	for name, aliases := range raw {
+		canonical := normalizeText(name)
+		if canonical == "" {
+			return nil, fmt.Errorf("team aliases: empty team name")
+		}
+		table.variants[canonical] = append(table.variants[canonical], canonical)
+
+		for _, alias := range append([]string{name}, aliases...) {
+			norm := normalizeText(alias)
+			if norm == "" {
+				continue
+			}
+			if existing, ok := table.canonical[norm]; ok && existing != canonical {
+				return nil, fmt.Errorf("team aliases: %q is used by both %q and %q", norm, existing, canonical)
+			}
+			if norm != canonical {
+				table.variants[canonical] = append(table.variants[canonical], norm)
+			}
+			table.canonical[norm] = canonical
+		}
+	}
+	return table, nil
+}
+
+// mustParseTeamAliases parses the bundled alias table, panicking on malformed data.
+func mustParseTeamAliases(data []byte) *aliasTable {
+	table, err := parseTeamAliases(data)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+// expand returns the canonical name for a normalized team name, or the name itself if unknown.
+func (t *aliasTable) expand(norm string) string {
+	if canonical, ok := t.canonical[norm]; ok {
+		return canonical
+	}
+	return norm
+}
+
+// namesFor returns every normalized name a team may appear under: the canonical name and its aliases.
+// Unknown teams only have their own name.
+func (t *aliasTable) namesFor(norm string) []string {
+	if variants, ok := t.variants[t.expand(norm)]; ok {
+		return variants
+	}
+	return []string{norm}
+}
@@ -11,6 +11,8 @@ type GoalLink struct {
 	Title     string    `json:"title"`
 	PostURL   string    `json:"post_url"`
 	FetchedAt time.Time `json:"fetched_at"`
+	// NotFoundAt records when a search last came up empty (set on "not found" markers only).
+	NotFoundAt *time.Time `json:"not_found_at,omitempty"`
 }
 
 // GoalLinkKey creates a unique key for a goal (matchID + minute).
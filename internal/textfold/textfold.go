@@ -0,0 +1,30 @@
+// Package textfold provides case- and accent-insensitive text comparison.
+package textfold
+
+import "strings"
+
+// accentFolder maps common accented Latin characters to their ASCII equivalent.
+var accentFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ā", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ę", "e", "ě", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ñ", "n", "ń", "n", "ň", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ő", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ű", "u", "ů", "u",
+	"ý", "y", "ÿ", "y",
+	"ś", "s", "š", "s", "ş", "s", "ß", "ss",
+	"ź", "z", "ż", "z", "ž", "z",
+	"ł", "l", "ř", "r", "ğ", "g", "đ", "d", "æ", "ae", "œ", "oe",
+)
+
+// Fold lowercases s and maps common accented Latin characters to ASCII,
+// e.g. "Atlético" -> "atletico".
+func Fold(s string) string {
+	return accentFolder.Replace(strings.ToLower(s))
+}
+
+// Contains reports whether substr is within s, ignoring case and accents.
+func Contains(s, substr string) bool {
+	return strings.Contains(Fold(s), Fold(substr))
+}
@@ -1,5 +1,13 @@
 package ui
 
+import (
+	"sync"
+
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
 // Consolidated color palette for all views - Red & Cyan theme
 // These aliases reference the main color definitions in neon_styles.go
 var (
@@ -9,3 +17,21 @@ var (
 	dimColor       = neonDim      // Gray
 	highlightColor = neonCyan     // Cyan highlight (same as accent)
 )
+
+var (
+	colorProfileOnce     sync.Once
+	detectedColorProfile termenv.Profile
+)
+
+// SetColorProfile switches the whole UI between color and plain text, e.g. for NO_COLOR.
+// Without color, layout, borders and box drawing stay; only ANSI colors and text styles go.
+func SetColorProfile(noColor bool) {
+	colorProfileOnce.Do(func() { detectedColorProfile = lipgloss.ColorProfile() })
+
+	profile := detectedColorProfile
+	if noColor {
+		profile = termenv.Ascii
+	}
+	lipgloss.SetColorProfile(profile)
+	design.SetNoColor(noColor)
+}
@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestSetColorProfileNoColor(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(profile)
+
+	SetColorProfile(true)
+	defer SetColorProfile(false)
+
+	spinner := NewRandomCharSpinner()
+	spinner.SetWidth(8)
+	rendered := map[string]string{
+		"gradient text": design.ApplyGradientToText("GOAL"),
+		"spinner":       spinner.View(),
+		"progress":      renderFetchProgress(spinner, "Loading day %d/%d", 2, 5),
+		"styled":        neonDimStyle.Render("dim"),
+	}
+	for name, out := range rendered {
+		if strings.Contains(out, "\x1b") {
+			t.Errorf("%s with NO_COLOR = %q, want plain text", name, out)
+		}
+	}
+	if got := design.ApplyGradientToText("GOAL"); got != "GOAL" {
+		t.Errorf("ApplyGradientToText() = %q, want the text as is", got)
+	}
+}
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+)
+
+// KickoffCountdown returns the time left until kickoff, e.g. "in 2h 15m", rounded up to the
+// minute. Once kickoff has passed it returns constants.KickingOff, as the status can lag behind.
+func KickoffCountdown(kickoff, now time.Time) string {
+	left := kickoff.Sub(now)
+	if left <= 0 {
+		return constants.KickingOff
+	}
+
+	minutes := int((left + time.Minute - 1) / time.Minute)
+	days, hours, minutes := minutes/(24*60), minutes/60%24, minutes%60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("in %dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("in %dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("in %dm", minutes)
+	}
+}
+
+// matchCountdown returns the kickoff countdown of a match that hasn't started, or "".
+func matchCountdown(match api.Match) string {
+	if match.Status != api.MatchStatusNotStarted || match.MatchTime == nil {
+		return ""
+	}
+	return KickoffCountdown(*match.MatchTime, time.Now())
+}
+
+// AwaitingKickoff reports whether a match is past its kickoff time but not reported
+// as started yet, as FotMob can take a few minutes to flip the status.
+func AwaitingKickoff(match api.Match, now time.Time) bool {
+	return match.Status == api.MatchStatusNotStarted && match.MatchTime != nil && !match.MatchTime.After(now)
+}
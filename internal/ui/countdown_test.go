@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+)
+
+func TestKickoffCountdown(t *testing.T) {
+	now := time.Date(2026, 3, 14, 13, 0, 0, 0, time.UTC)
+	tests := []struct {
+		kickoff time.Time
+		want    string
+	}{
+		{now.Add(2*time.Hour + 15*time.Minute), "in 2h 15m"},
+		{now.Add(45 * time.Minute), "in 45m"},
+		{now.Add(30 * time.Second), "in 1m"},
+		{now.Add(26 * time.Hour), "in 1d 2h"},
+		{now, constants.KickingOff},
+		{now.Add(-5 * time.Minute), constants.KickingOff},
+	}
+
+	for _, tt := range tests {
+		if got := KickoffCountdown(tt.kickoff, now); got != tt.want {
+			t.Errorf("KickoffCountdown(%v) = %q, want %q", tt.kickoff.Sub(now), got, tt.want)
+		}
+	}
+}
+
+func TestAwaitingKickoff(t *testing.T) {
+	now := time.Date(2026, 3, 14, 13, 0, 0, 0, time.UTC)
+	past, future := now.Add(-3*time.Minute), now.Add(time.Hour)
+	tests := []struct {
+		match api.Match
+		want  bool
+	}{
+		{api.Match{Status: api.MatchStatusNotStarted, MatchTime: &past}, true},
+		{api.Match{Status: api.MatchStatusNotStarted, MatchTime: &future}, false},
+		{api.Match{Status: api.MatchStatusNotStarted}, false},
+		{api.Match{Status: api.MatchStatusPostponed, MatchTime: &past}, false},
+	}
+
+	for _, tt := range tests {
+		if got := AwaitingKickoff(tt.match, now); got != tt.want {
+			t.Errorf("AwaitingKickoff(%s, kickoff %v) = %v, want %v", tt.match.Status, tt.match.MatchTime, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DebugViewportSize returns the viewport dimensions for the debug log view.
+func DebugViewportSize(width, height int) (int, int) {
+	const (
+		titleHeight = 3 // Title + margin
+		helpHeight  = 2 // Help text
+		padding     = 2
+	)
+	return max(width-padding*2, 20), max(height-titleHeight-helpHeight-padding, 5)
+}
+
+// DebugLogContent joins debug lines for the viewport, or returns the empty-state message.
+func DebugLogContent(lines []string) string {
+	if len(lines) == 0 {
+		return neonDimStyle.Render(constants.EmptyNoDebugLines)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RenderDebugView renders the in-app debug log viewer.
+// The viewport must already hold the content and size from DebugLogContent/DebugViewportSize.
+func RenderDebugView(width, height int, vp viewport.Model, lineCount int, bannerType constants.StatusBannerType) string {
+	vpWidth, _ := DebugViewportSize(width, height)
+
+	statusBanner := renderStatusBanner(bannerType, vpWidth)
+	if statusBanner != "" {
+		statusBanner += "\n"
+	}
+
+	title := design.RenderHeader(fmt.Sprintf("%s (%d)", constants.PanelDebugLog, lineCount), vpWidth)
+	help := neonDimStyle.Width(vpWidth).Align(lipgloss.Center).Render(constants.HelpDebugView)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, statusBanner, title, "", vp.View(), help)
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Top, content)
+}
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/lucasb-eyer/go-colorful"
 )
 
 // GradientBarConfig configures how a gradient comparison bar is rendered.
@@ -85,14 +84,7 @@ func RenderGradientBar(cfg GradientBarConfig) string {
 	}
 
 	// Parse colors
-	startColor, err1 := colorful.Hex(cfg.StartColor)
-	endColor, err2 := colorful.Hex(cfg.EndColor)
-	if err1 != nil || err2 != nil {
-		// Fallback to simple bars without gradient
-		homeBar := strings.Repeat(cfg.FilledChar, homeFilledWidth) + strings.Repeat(cfg.EmptyChar, halfWidth-homeFilledWidth)
-		awayBar := strings.Repeat(cfg.FilledChar, awayFilledWidth) + strings.Repeat(cfg.EmptyChar, halfWidth-awayFilledWidth)
-		return homeBar + "│" + awayBar
-	}
+	startColor, endColor := GradientColors(cfg.StartColor, cfg.EndColor)
 
 	// Build home side bar with gradient (left to center)
 	var homeBar strings.Builder
@@ -134,8 +126,7 @@ func RenderGradientBar(cfg GradientBarConfig) string {
 // Useful for percentage displays like possession.
 func RenderSimpleGradientBar(value float64, width int) string {
 	startHex, endHex := AdaptiveGradientColors()
-	startColor, _ := colorful.Hex(startHex)
-	endColor, _ := colorful.Hex(endHex)
+	startColor, endColor := GradientColors(startHex, endHex)
 
 	filledWidth := int(value * float64(width))
 	filledWidth = min(filledWidth, width)
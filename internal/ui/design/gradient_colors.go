@@ -0,0 +1,58 @@
+package design
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// Built-in neon cyan and red, used for a gradient end whose color can't be parsed.
+const (
+	fallbackStartHex = "#00FFFF"
+	fallbackEndHex   = "#FF0000"
+)
+
+var (
+	colorWarningMu sync.Mutex
+	colorWarning   func(message string)
+	warnedColors   = make(map[string]bool)
+)
+
+// SetColorWarningHandler sets where invalid gradient colors are reported.
+// Each invalid value is reported once per handler; nil turns reporting off.
+func SetColorWarningHandler(warn func(message string)) {
+	colorWarningMu.Lock()
+	defer colorWarningMu.Unlock()
+	colorWarning = warn
+	clear(warnedColors)
+}
+
+// GradientColors parses the two ends of a gradient. An end that isn't a valid hex color
+// falls back to the built-in neon cyan (start) or red (end), so text never turns invisible.
+func GradientColors(startHex, endHex string) (start, end colorful.Color) {
+	return parseGradientColor(startHex, fallbackStartHex), parseGradientColor(endHex, fallbackEndHex)
+}
+
+// parseGradientColor parses hex, reporting it and returning fallback if it is invalid.
+func parseGradientColor(hex, fallback string) colorful.Color {
+	if color, err := colorful.Hex(hex); err == nil {
+		return color
+	}
+
+	colorWarningMu.Lock()
+	warn := colorWarning
+	if warnedColors[hex] || warn == nil {
+		warn = nil
+	} else {
+		warnedColors[hex] = true
+	}
+	colorWarningMu.Unlock()
+
+	if warn != nil {
+		warn(fmt.Sprintf("Invalid gradient color %q, using %s instead", hex, fallback))
+	}
+
+	color, _ := colorful.Hex(fallback)
+	return color
+}
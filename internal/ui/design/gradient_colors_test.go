@@ -0,0 +1,24 @@
+package design
+
+import "testing"
+
+func TestGradientColorsFallBack(t *testing.T) {
+	var warnings []string
+	SetColorWarningHandler(func(message string) { warnings = append(warnings, message) })
+	t.Cleanup(func() { SetColorWarningHandler(nil) })
+
+	start, end := GradientColors("#123456", "#ABCDEF")
+	if start.Hex() != "#123456" || end.Hex() != "#abcdef" {
+		t.Errorf("GradientColors() = %s, %s; want the given colors", start.Hex(), end.Hex())
+	}
+
+	for range 2 {
+		start, end = GradientColors("not-a-color", "#GGGGGG")
+	}
+	if start.Hex() != "#00ffff" || end.Hex() != "#ff0000" {
+		t.Errorf("GradientColors() with invalid colors = %s, %s; want neon cyan and red", start.Hex(), end.Hex())
+	}
+	if len(warnings) != 2 {
+		t.Errorf("got %d warnings %q, want one per invalid value", len(warnings), warnings)
+	}
+}
@@ -4,17 +4,15 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/lucasb-eyer/go-colorful"
 )
 
 // ApplyGradientToText applies a gradient color to text, character by character.
 func ApplyGradientToText(text string) string {
-	startHex, endHex := AdaptiveGradientColors()
-	startColor, err1 := colorful.Hex(startHex)
-	endColor, err2 := colorful.Hex(endHex)
-	if err1 != nil || err2 != nil {
+	if NoColor() {
 		return text
 	}
+	startHex, endHex := AdaptiveGradientColors()
+	startColor, endColor := GradientColors(startHex, endHex)
 
 	runes := []rune(text)
 	if len(runes) == 0 {
@@ -36,17 +34,16 @@ func ApplyGradientToText(text string) string {
 // ApplyGradientToMultilineText applies a gradient color to multi-line text.
 // Each line gets a color based on its position in the text (line-by-line gradient).
 func ApplyGradientToMultilineText(text string) string {
+	if NoColor() {
+		return text
+	}
 	lines := strings.Split(text, "\n")
 	if len(lines) == 0 {
 		return text
 	}
 
 	startHex, endHex := AdaptiveGradientColors()
-	startColor, err1 := colorful.Hex(startHex)
-	endColor, err2 := colorful.Hex(endHex)
-	if err1 != nil || err2 != nil {
-		return text
-	}
+	startColor, endColor := GradientColors(startHex, endHex)
 
 	var result strings.Builder
 	for i, line := range lines {
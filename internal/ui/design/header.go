@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/lucasb-eyer/go-colorful"
 )
 
 const diag = `╱`
@@ -79,11 +78,7 @@ func RenderHeaderCentered(text string, width int) string {
 
 // applyHeaderGradient applies a gradient to a single line of text.
 func applyHeaderGradient(text string, startHex, endHex string) string {
-	startColor, err1 := colorful.Hex(startHex)
-	endColor, err2 := colorful.Hex(endHex)
-	if err1 != nil || err2 != nil {
-		return text
-	}
+	startColor, endColor := GradientColors(startHex, endHex)
 
 	runes := []rune(text)
 	if len(runes) == 0 {
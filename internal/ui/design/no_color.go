@@ -0,0 +1,17 @@
+package design
+
+import "sync/atomic"
+
+// noColor is set while rendering without color (NO_COLOR), see ui.SetColorProfile.
+var noColor atomic.Bool
+
+// SetNoColor turns plain-text rendering of the gradient helpers on or off.
+func SetNoColor(on bool) {
+	noColor.Store(on)
+}
+
+// NoColor reports whether output is rendered without color: gradients return their text
+// as is and no ANSI sequences are written.
+func NoColor() bool {
+	return noColor.Load()
+}
@@ -149,11 +149,7 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 
 	// Player name (truncated if needed)
 	nameWidth := width - 14 // Account for number, position, rating badge, spacing
-	name := player.Name
-	if len(name) > nameWidth {
-		name = name[:nameWidth-1] + "…"
-	}
-	name = fmt.Sprintf("%-*s", nameWidth, name)
+	name := padRight(truncateString(player.Name, nameWidth), nameWidth)
 
 	// Apply styles
 	var numStyle, posStyle, nameStyle lipgloss.Style
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const helpDialogID = "help"
+
+// helpKeyColumnWidth is the width of the keys column in the help dialog.
+const helpKeyColumnWidth = 16
+
+// HelpSection is a titled group of key bindings shown in the help dialog.
+type HelpSection struct {
+	Title    string
+	Bindings []key.Binding
+}
+
+// HelpDialog lists the key bindings of the current view, scrolling when they don't fit.
+type HelpDialog struct {
+	sections    []HelpSection
+	scrollIndex int
+	maxVisible  int // Lines that fit, from the last render
+}
+
+// NewHelpDialog creates a help dialog for the given sections. Disabled bindings are left out.
+func NewHelpDialog(sections []HelpSection) *HelpDialog {
+	return &HelpDialog{
+		sections:   sections,
+		maxVisible: DefaultDialogMaxHeight,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *HelpDialog) ID() string {
+	return helpDialogID
+}
+
+// Update handles input for the help dialog.
+func (d *HelpDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc", "?", "q":
+			return d, DialogActionClose{}
+		case "j", "down":
+			maxScroll := max(len(d.lines())-d.maxVisible, 0)
+			if d.scrollIndex < maxScroll {
+				d.scrollIndex++
+			}
+		case "k", "up":
+			if d.scrollIndex > 0 {
+				d.scrollIndex--
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the visible part of the key bindings list.
+func (d *HelpDialog) View(width, height int) string {
+	lines := d.lines()
+
+	// Padding, title bar, spacing, scroll info and help take 10 lines
+	dialogWidth, dialogHeight := DialogSize(width, height, 64, len(lines)+10)
+	d.maxVisible = max(dialogHeight-10, 1)
+	d.scrollIndex = min(d.scrollIndex, max(len(lines)-d.maxVisible, 0))
+
+	end := min(d.scrollIndex+d.maxVisible, len(lines))
+	visible := lines[d.scrollIndex:end]
+	if len(lines) > d.maxVisible {
+		scrollInfo := fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, end, len(lines))
+		visible = append(visible, "", dialogDimStyle.Render(scrollInfo))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, visible...)
+	return RenderDialogFrameWithHelp(constants.PanelKeyboardShortcuts, content, constants.HelpHelpDialog, dialogWidth, dialogHeight)
+}
+
+// lines renders every section as a header followed by one line per enabled binding.
+func (d *HelpDialog) lines() []string {
+	keyStyle := dialogHeaderStyle.Width(helpKeyColumnWidth)
+
+	var lines []string
+	for _, section := range d.sections {
+		var bindings []string
+		for _, binding := range section.Bindings {
+			if !binding.Enabled() {
+				continue
+			}
+			help := binding.Help()
+			bindings = append(bindings, keyStyle.Render(help.Key)+dialogContentStyle.Render(help.Desc))
+		}
+		if len(bindings) == 0 {
+			continue
+		}
+
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, dialogDimStyle.Render(strings.ToUpper(section.Title)))
+		lines = append(lines, bindings...)
+	}
+	return lines
+}
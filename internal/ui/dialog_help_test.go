@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHelpDialog(t *testing.T) {
+	var bindings []key.Binding
+	for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		bindings = append(bindings, key.NewBinding(key.WithKeys(k), key.WithHelp(k, "action "+k)))
+	}
+	disabled := key.NewBinding(key.WithKeys("z"), key.WithHelp("z", "hidden"), key.WithDisabled())
+	d := NewHelpDialog([]HelpSection{{Title: "Test", Bindings: append(bindings, disabled)}})
+
+	// 11 lines (title + 10 bindings) in a 16 row screen leaves room for only some of them
+	view := d.View(80, 16)
+	if !strings.Contains(view, "action a") || strings.Contains(view, "action j") {
+		t.Errorf("first render should start at the top and not fit everything:\n%s", view)
+	}
+	if strings.Contains(view, "hidden") {
+		t.Error("disabled bindings should not be listed")
+	}
+
+	for range 20 {
+		d.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	view = d.View(80, 16)
+	if !strings.Contains(view, "action j") || strings.Contains(view, "action a") {
+		t.Errorf("scrolling down should reach the last binding:\n%s", view)
+	}
+
+	for _, msg := range []tea.KeyMsg{
+		{Type: tea.KeyEsc},
+		{Type: tea.KeyRunes, Runes: []rune{'?'}},
+	} {
+		if _, action := d.Update(msg); action == nil {
+			t.Errorf("%q should close the dialog", msg.String())
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const rawJSONDialogID = "raw-json"
+
+// RawJSONDialog shows match details as golazo parsed them, pretty-printed as JSON,
+// so mapping bugs can be reported with exactly what the app sees.
+type RawJSONDialog struct {
+	lines       []string
+	scrollIndex int
+	maxVisible  int // Lines that fit, from the last render
+
+	searching bool   // Typing a search query
+	query     string // Last search, matched case-insensitively
+	matches   []int  // Indexes of the lines containing query
+	current   int    // Index into matches of the line scrolled to
+}
+
+// NewRawJSONDialog creates a dialog showing details as indented JSON.
+func NewRawJSONDialog(details *api.MatchDetails) *RawJSONDialog {
+	raw, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		raw = []byte(fmt.Sprintf("encode match details: %v", err))
+	}
+	return &RawJSONDialog{
+		lines:      strings.Split(string(raw), "\n"),
+		maxVisible: DefaultDialogMaxHeight,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *RawJSONDialog) ID() string {
+	return rawJSONDialogID
+}
+
+// Update handles scrolling and searching. / starts a search, Enter runs it,
+// and n/N move between the matching lines.
+func (d *RawJSONDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	if d.searching {
+		switch keyMsg.Type {
+		case tea.KeyEsc:
+			d.searching = false
+			d.query, d.matches = "", nil
+		case tea.KeyEnter:
+			d.searching = false
+			d.search()
+		case tea.KeyBackspace:
+			if d.query != "" {
+				runes := []rune(d.query)
+				d.query = string(runes[:len(runes)-1])
+			}
+		case tea.KeyRunes, tea.KeySpace:
+			d.query += string(keyMsg.Runes)
+		}
+		return d, nil
+	}
+
+	switch keyMsg.String() {
+	case "esc", "q":
+		return d, DialogActionClose{}
+	case "/":
+		d.searching = true
+		d.query = ""
+	case "n":
+		d.jumpToMatch(1)
+	case "N":
+		d.jumpToMatch(-1)
+	case "j", "down":
+		d.scrollTo(d.scrollIndex + 1)
+	case "k", "up":
+		d.scrollTo(d.scrollIndex - 1)
+	case "pgdown", "ctrl+d":
+		d.scrollTo(d.scrollIndex + d.maxVisible)
+	case "pgup", "ctrl+u":
+		d.scrollTo(d.scrollIndex - d.maxVisible)
+	case "g", "home":
+		d.scrollTo(0)
+	case "G", "end":
+		d.scrollTo(len(d.lines))
+	}
+	return d, nil
+}
+
+// scrollTo scrolls to line, clamped so the last page stays full.
+func (d *RawJSONDialog) scrollTo(line int) {
+	d.scrollIndex = min(max(line, 0), max(len(d.lines)-d.maxVisible, 0))
+}
+
+// search finds the lines containing the query and scrolls to the first one.
+func (d *RawJSONDialog) search() {
+	d.matches = nil
+	d.current = 0
+	if d.query == "" {
+		return
+	}
+	query := strings.ToLower(d.query)
+	for i, line := range d.lines {
+		if strings.Contains(strings.ToLower(line), query) {
+			d.matches = append(d.matches, i)
+		}
+	}
+	if len(d.matches) > 0 {
+		d.scrollTo(d.matches[0])
+	}
+}
+
+// jumpToMatch scrolls to the next (delta 1) or previous (delta -1) matching line, wrapping around.
+func (d *RawJSONDialog) jumpToMatch(delta int) {
+	if len(d.matches) == 0 {
+		return
+	}
+	d.current = (d.current + delta + len(d.matches)) % len(d.matches)
+	d.scrollTo(d.matches[d.current])
+}
+
+// View renders the visible lines, with the search prompt or result below them.
+func (d *RawJSONDialog) View(width, height int) string {
+	// Padding, title bar, spacing, status line and help take 10 lines
+	dialogWidth, dialogHeight := DialogSize(width, height, DefaultDialogMaxWidth, len(d.lines)+10)
+	d.maxVisible = max(dialogHeight-10, 1)
+	d.scrollTo(d.scrollIndex)
+
+	matched := make(map[int]bool, len(d.matches))
+	for _, line := range d.matches {
+		matched[line] = true
+	}
+
+	end := min(d.scrollIndex+d.maxVisible, len(d.lines))
+	var visible []string
+	for i := d.scrollIndex; i < end; i++ {
+		line := truncateString(d.lines[i], dialogWidth-6)
+		if matched[i] {
+			visible = append(visible, dialogHeaderStyle.Render(line))
+		} else {
+			visible = append(visible, dialogContentStyle.Render(line))
+		}
+	}
+
+	var status string
+	switch {
+	case d.searching:
+		status = "/" + d.query + "█"
+	case d.query != "" && len(d.matches) == 0:
+		status = fmt.Sprintf("%q not found", d.query)
+	case d.query != "":
+		status = fmt.Sprintf("%q %d of %d", d.query, d.current+1, len(d.matches))
+	default:
+		status = fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, end, len(d.lines))
+	}
+	visible = append(visible, "", dialogDimStyle.Render(status))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, visible...)
+	return RenderDialogFrameWithHelp(constants.PanelMatchJSON, content, constants.HelpRawJSONDialog, dialogWidth, dialogHeight)
+}
@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRawJSONDialogSearch(t *testing.T) {
+	details, _ := data.MockFinishedMatchDetails(1001)
+	d := NewRawJSONDialog(details)
+	view := d.View(120, 24)
+	if !strings.Contains(view, `"id": 1001`) {
+		t.Fatalf("first render should start with the match JSON:\n%s", view)
+	}
+
+	press := func(keys ...tea.KeyMsg) {
+		for _, k := range keys {
+			d.Update(k)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("/"), runes("\"statistics\""), tea.KeyMsg{Type: tea.KeyEnter})
+	if len(d.matches) != 1 || d.scrollIndex == 0 {
+		t.Fatalf("search found lines %v, scrolled to %d; want one match scrolled into view", d.matches, d.scrollIndex)
+	}
+	if view := d.View(120, 24); !strings.Contains(view, `"statistics"`) || !strings.Contains(view, "1 of 1") {
+		t.Errorf("view should show the matching line and search status:\n%s", view)
+	}
+
+	if _, action := d.Update(tea.KeyMsg{Type: tea.KeyEsc}); action == nil {
+		t.Error("Esc should close the dialog outside of a search")
+	}
+}
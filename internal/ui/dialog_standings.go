@@ -2,10 +2,12 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -18,16 +20,19 @@ type StandingsDialog struct {
 	standings   []api.LeagueTableEntry
 	homeTeamID  int
 	awayTeamID  int
+	zones       data.TableZones
 	scrollIndex int
 }
 
 // NewStandingsDialog creates a new standings dialog.
-func NewStandingsDialog(leagueName string, standings []api.LeagueTableEntry, homeTeamID, awayTeamID int) *StandingsDialog {
+// Rows are colored by the league's qualification and relegation zones, if it has any.
+func NewStandingsDialog(leagueName string, standings []api.LeagueTableEntry, homeTeamID, awayTeamID int, zones data.TableZones) *StandingsDialog {
 	return &StandingsDialog{
 		leagueName:  leagueName,
 		standings:   standings,
 		homeTeamID:  homeTeamID,
 		awayTeamID:  awayTeamID,
+		zones:       zones,
 		scrollIndex: 0,
 	}
 }
@@ -74,23 +79,67 @@ func (d *StandingsDialog) renderTable(width int) string {
 		return dialogDimStyle.Render("No standings data available")
 	}
 
-	var lines []string
+	lines := renderStandingsRows(d.standings, width, d.zones, d.homeTeamID, d.awayTeamID)
+	if legend := renderZoneLegend(d.zones); legend != "" {
+		lines = append(lines, "", legend)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// Zone colors for league table rows
+var (
+	zoneChampionsLeagueColor = lipgloss.AdaptiveColor{Light: "25", Dark: "39"}   // Blue
+	zoneEuropaColor          = lipgloss.AdaptiveColor{Light: "130", Dark: "214"} // Orange
+	zoneRelegationColor      = neonRed
+)
+
+// zoneStyle returns the row style of a table zone.
+func zoneStyle(zone data.TableZone) lipgloss.Style {
+	switch zone {
+	case data.ZoneChampionsLeague:
+		return lipgloss.NewStyle().Foreground(zoneChampionsLeagueColor)
+	case data.ZoneEuropa:
+		return lipgloss.NewStyle().Foreground(zoneEuropaColor)
+	case data.ZoneRelegation:
+		return lipgloss.NewStyle().Foreground(zoneRelegationColor)
+	default:
+		return dialogValueStyle
+	}
+}
+
+// renderZoneLegend renders a key to the zones colored in a league table, or "" if it has none.
+func renderZoneLegend(zones data.TableZones) string {
+	var entries []string
+	if zones.ChampionsLeagueSpots > 0 {
+		entries = append(entries, zoneStyle(data.ZoneChampionsLeague).Render("■ Champions League"))
+	}
+	if zones.EuropaSpots > 0 {
+		entries = append(entries, zoneStyle(data.ZoneEuropa).Render("■ Europa/Conference League"))
+	}
+	if zones.RelegationSpots > 0 {
+		entries = append(entries, zoneStyle(data.ZoneRelegation).Render("■ Relegation"))
+	}
+	return strings.Join(entries, "   ")
+}
+
+// renderStandingsRows renders the header, separator and one row per team.
+// Rows are colored by their zone; teams in highlightIDs get a background highlight instead.
+func renderStandingsRows(standings []api.LeagueTableEntry, width int, zones data.TableZones, highlightIDs ...int) []string {
+	lines := make([]string, 0, len(standings)+2)
 
 	// Header row
-	header := d.renderHeaderRow(width)
-	lines = append(lines, header)
+	lines = append(lines, renderStandingsHeaderRow(width))
 
 	// Separator
-	separator := dialogSeparatorStyle.Render(strings.Repeat("─", width))
-	lines = append(lines, separator)
+	lines = append(lines, dialogSeparatorStyle.Render(strings.Repeat("─", width)))
 
 	// Data rows
-	for _, entry := range d.standings {
-		row := d.renderTeamRow(entry, width)
-		lines = append(lines, row)
+	for _, entry := range standings {
+		zone := zones.Zone(entry.Position, len(standings))
+		lines = append(lines, renderStandingsTeamRow(entry, width, slices.Contains(highlightIDs, entry.Team.ID), zone))
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lines
 }
 
 // Column widths for consistent alignment
@@ -101,8 +150,8 @@ const (
 	standingsColPts  = 5 // Points column
 )
 
-// renderHeaderRow renders the table header.
-func (d *StandingsDialog) renderHeaderRow(width int) string {
+// renderStandingsHeaderRow renders the table header.
+func renderStandingsHeaderRow(width int) string {
 	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 4
 
 	return lipgloss.JoinHorizontal(lipgloss.Top,
@@ -118,10 +167,8 @@ func (d *StandingsDialog) renderHeaderRow(width int) string {
 	)
 }
 
-// renderTeamRow renders a single team row.
-func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) string {
-	isHighlighted := entry.Team.ID == d.homeTeamID || entry.Team.ID == d.awayTeamID
-
+// renderStandingsTeamRow renders a single team row.
+func renderStandingsTeamRow(entry api.LeagueTableEntry, width int, isHighlighted bool, zone data.TableZone) string {
 	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 4
 
 	// Truncate team name if needed
@@ -160,7 +207,7 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 			Render(rowContent)
 	}
 
-	return dialogValueStyle.Render(rowContent)
+	return zoneStyle(zone).Render(rowContent)
 }
 
 // formatGoalDifference formats goal difference with +/- sign.
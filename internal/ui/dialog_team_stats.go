@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const teamStatsDialogID = "team_stats"
+
+// TeamStatsDialog displays a team's season record: league position, results, goals and form.
+type TeamStatsDialog struct {
+	teamName string
+	stats    *api.TeamSeasonStats
+}
+
+// NewTeamStatsDialog creates a new team stats dialog. teamName is shown when the stats
+// don't name the team; nil stats show the "no season data" state.
+func NewTeamStatsDialog(teamName string, stats *api.TeamSeasonStats) *TeamStatsDialog {
+	if stats != nil && stats.Team.Name != "" {
+		teamName = stats.Team.Name
+	}
+	return &TeamStatsDialog{teamName: teamName, stats: stats}
+}
+
+// ID returns the dialog identifier.
+func (d *TeamStatsDialog) ID() string {
+	return teamStatsDialogID
+}
+
+// Update handles input for the team stats dialog.
+func (d *TeamStatsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc", "q", "H", "A":
+			return d, DialogActionClose{}
+		}
+	}
+	return d, nil
+}
+
+// View renders the team's season record.
+func (d *TeamStatsDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 60, 20)
+	content := d.renderRecord()
+	return RenderDialogFrameWithHelp(d.teamName+" Season", content, constants.HelpTeamStatsDialog, dialogWidth, dialogHeight)
+}
+
+// renderRecord renders one labeled line per figure, or the "no season data" state.
+func (d *TeamStatsDialog) renderRecord() string {
+	if d.stats == nil || d.stats.Record == nil {
+		lines := []string{dialogDimStyle.Render("No season data (not in a league table)")}
+		if d.stats != nil && len(d.stats.Form) > 0 {
+			lines = append(lines, "", d.renderLine("Form:", renderForm(d.stats.Form)))
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	r := d.stats.Record
+	league := strings.TrimSpace(d.stats.LeagueName + " " + d.stats.Season)
+	lines := []string{
+		d.renderLine("League:", dialogValueStyle.Render(league)),
+		d.renderLine("Position:", dialogTeamStyle.Render(ordinal(r.Position))),
+		d.renderLine("Played:", dialogValueStyle.Render(fmt.Sprintf("%d", r.Played))),
+		d.renderLine("Record:", dialogValueStyle.Render(fmt.Sprintf("%dW %dD %dL", r.Won, r.Drawn, r.Lost))),
+		d.renderLine("Goals:", dialogValueStyle.Render(fmt.Sprintf("%d scored, %d conceded (%+d)", r.GoalsFor, r.GoalsAgainst, r.GoalDifference))),
+		d.renderLine("Points:", dialogValueStyle.Render(fmt.Sprintf("%d", r.Points))),
+	}
+	if len(d.stats.Form) > 0 {
+		lines = append(lines, d.renderLine("Form:", renderForm(d.stats.Form)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderLine renders a label and its value.
+func (d *TeamStatsDialog) renderLine(label, value string) string {
+	return dialogLabelStyle.Render(label) + value
+}
+
+// renderForm renders the latest results, oldest first: wins cyan, losses red, draws dim.
+func renderForm(form []string) string {
+	results := make([]string, len(form))
+	for i, result := range form {
+		style := dialogDimStyle
+		switch result {
+		case "W":
+			style = lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+		case "L":
+			style = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+		}
+		results[i] = style.Render(result)
+	}
+	return strings.Join(results, " ")
+}
+
+// ordinal formats a table position, e.g. 1st, 2nd, 3rd, 11th.
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
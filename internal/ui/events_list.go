@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// EventFilter selects which event types are shown in the events list.
+type EventFilter int
+
+const (
+	EventFilterAll EventFilter = iota
+	EventFilterGoals
+	EventFilterCards
+	EventFilterSubs
+)
+
+// eventFilterLabels are the tab labels for each filter, in key order (1-4).
+var eventFilterLabels = []string{"All", "Goals", "Cards", "Subs"}
+
+// Matches reports whether the event passes the filter.
+// Only goals, cards and substitutions are listed, whatever the filter.
+func (f EventFilter) Matches(event api.MatchEvent) bool {
+	switch f {
+	case EventFilterGoals:
+		return event.Type == "goal"
+	case EventFilterCards:
+		return event.Type == "card"
+	case EventFilterSubs:
+		return event.Type == "substitution"
+	default:
+		return event.Type == "goal" || event.Type == "card" || event.Type == "substitution"
+	}
+}
+
+// FilterEvents returns the events passing the filter, preserving order.
+func FilterEvents(events []api.MatchEvent, filter EventFilter) []api.MatchEvent {
+	var filtered []api.MatchEvent
+	for _, event := range events {
+		if filter.Matches(event) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// EventListItem implements the list.Item interface for match events.
+type EventListItem struct {
+	Event api.MatchEvent
+}
+
+// Title returns the minute, event glyph and player.
+func (e EventListItem) Title() string {
+	return eventMinuteString(e.Event) + " " + eventGlyph(e.Event) + " " + eventPlayer(e.Event)
+}
+
+// Description returns the team name.
+func (e EventListItem) Description() string {
+	if e.Event.Team.ShortName != "" {
+		return e.Event.Team.ShortName
+	}
+	return e.Event.Team.Name
+}
+
+// FilterValue returns the player and team for filtering.
+func (e EventListItem) FilterValue() string {
+	return eventPlayer(e.Event) + " " + e.Description()
+}
+
+// ToEventListItems converts the events passing the filter and within the minute range to list items.
+func ToEventListItems(events []api.MatchEvent, filter EventFilter, minutes MinuteRange) []list.Item {
+	filtered := filterMinutes(FilterEvents(events, filter), minutes)
+	items := make([]list.Item, len(filtered))
+	for i, event := range filtered {
+		items[i] = EventListItem{Event: event}
+	}
+	return items
+}
+
+// NewEventListDelegate creates a compact delegate for the events list.
+func NewEventListDelegate() list.DefaultDelegate {
+	d := NewMatchListDelegate()
+	d.SetHeight(2)
+	d.SetSpacing(0)
+	return d.DefaultDelegate
+}
+
+// eventGlyph returns the symbol used for an event type.
+func eventGlyph(event api.MatchEvent) string {
+	switch event.Type {
+	case "goal":
+		return "●"
+	case "card":
+		if event.IsSecondYellow() {
+			return CardSymbolSecondYellow
+		}
+		if event.IsRedCard() {
+			return CardSymbolRed
+		}
+		return CardSymbolYellow
+	case "substitution":
+		return "↔"
+	default:
+		return "•"
+	}
+}
+
+// eventPlayer returns the player name for an event.
+// Substitutions show the player coming on and the player going off.
+func eventPlayer(event api.MatchEvent) string {
+	player := "Unknown"
+	if event.Player != nil {
+		player = *event.Player
+	}
+	if event.Type == "substitution" && event.Assist != nil {
+		return *event.Assist + " ← " + player
+	}
+	return player
+}
+
+// renderEventFilterTabs renders the filter selector, highlighting the active filter,
+// followed by the minute range when one is set.
+func renderEventFilterTabs(width int, active EventFilter, minutes MinuteRange) string {
+	var tabs []string
+	for i, label := range eventFilterLabels {
+		text := string(rune('1'+i)) + " " + label
+		if EventFilter(i) == active {
+			tabs = append(tabs, neonDateSelectedStyle.Render(text))
+		} else {
+			tabs = append(tabs, neonDateUnselectedStyle.Render(text))
+		}
+	}
+	if label := minutes.Label(); label != "" {
+		tabs = append(tabs, neonLiveOnlyStyle.Render(label))
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(strings.Join(tabs, "  "))
+}
+
+// renderEventsListContent renders the filter tabs and the events list sized to the given area.
+func renderEventsListContent(width, height int, eventsList list.Model, filter EventFilter, minutes MinuteRange) string {
+	tabs := renderEventFilterTabs(width, filter, minutes)
+	if len(eventsList.Items()) == 0 {
+		empty := neonDimStyle.Width(width).Align(lipgloss.Center).PaddingTop(1).Render(constants.EmptyNoEvents)
+		return lipgloss.JoinVertical(lipgloss.Left, tabs, empty)
+	}
+	eventsList.SetSize(width, max(height-2, 1))
+	return lipgloss.JoinVertical(lipgloss.Left, tabs, "", eventsList.View())
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fetchErrorWidth is the width of the fetch error panel.
+const fetchErrorWidth = 60
+
+// RenderFetchErrorView renders a centered panel explaining that a view's matches could not be
+// loaded, with a retry prompt. network selects the "check your connection" message over the
+// generic one; the error itself is shown below it in dim text.
+func RenderFetchErrorView(width, height int, title string, err error, network bool, bannerType constants.StatusBannerType) string {
+	boxWidth := min(max(width-4, 30), fetchErrorWidth)
+
+	statusBanner := renderStatusBanner(bannerType, boxWidth)
+	if statusBanner != "" {
+		statusBanner += "\n"
+	}
+
+	message := constants.FetchErrorFailed
+	if network {
+		message = constants.FetchErrorNetwork
+	}
+
+	var detail string
+	if err != nil {
+		detail = truncateString(err.Error(), boxWidth*2)
+	}
+
+	centered := lipgloss.NewStyle().Width(boxWidth).Align(lipgloss.Center)
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		statusBanner,
+		design.RenderHeader(title, boxWidth),
+		"",
+		centered.Foreground(neonRed).Bold(true).Render(message),
+		"",
+		neonDimStyle.Width(boxWidth).Align(lipgloss.Center).Render(detail),
+		"",
+		neonDimStyle.Width(boxWidth).Align(lipgloss.Center).Render(constants.FetchErrorRetry),
+	)
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/muesli/termenv"
+)
+
+// fetchProgressWidth is the width of the progress bar of a progressive fetch.
+const fetchProgressWidth = 24
+
+// renderFetchProgress renders the loading indicator of a progressive fetch of total steps,
+// done of which have finished. labelFormat is formatted with the step being fetched and the
+// total, e.g. "Loading day 3/5".
+// Once some steps are done the indicator is a progress bar; before that, or when the total
+// isn't known, it falls back to the random spinner as there is no progress to show yet.
+func renderFetchProgress(randomSpinner *RandomCharSpinner, labelFormat string, done, total int) string {
+	var label string
+	if total > 0 && done < total {
+		label = fmt.Sprintf(labelFormat, done+1, total)
+	}
+
+	if done > 0 && label != "" {
+		startColor, endColor := design.GradientColors(design.AdaptiveGradientColors())
+		opts := []progress.Option{
+			progress.WithScaledGradient(startColor.Hex(), endColor.Hex()),
+			progress.WithWidth(fetchProgressWidth),
+			progress.WithoutPercentage(),
+		}
+		if design.NoColor() {
+			// The progress bar picks its colors independently of lipgloss
+			opts = append(opts, progress.WithColorProfile(termenv.Ascii))
+		}
+		bar := progress.New(opts...)
+		return bar.ViewAs(float64(done)/float64(total)) + "  " + neonDimStyle.Render(label)
+	}
+
+	spinnerView := "Loading..."
+	if randomSpinner != nil && randomSpinner.View() != "" {
+		spinnerView = randomSpinner.View()
+	}
+	if label == "" {
+		return spinnerView
+	}
+	return spinnerView + "  " + label + "..."
+}
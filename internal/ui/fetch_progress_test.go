@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+)
+
+func TestRenderFetchProgress(t *testing.T) {
+	// Nothing fetched yet: the spinner fallback, with the step being fetched
+	got := renderFetchProgress(nil, constants.LoadingDayProgress, 0, 5)
+	if !strings.HasPrefix(got, "Loading...") || !strings.Contains(got, "Loading day 1/5") {
+		t.Errorf("no progress yet = %q, want the spinner fallback for day 1/5", got)
+	}
+
+	// Some days fetched: a progress bar
+	got = renderFetchProgress(nil, constants.LoadingDayProgress, 2, 5)
+	if strings.HasPrefix(got, "Loading...") || !strings.Contains(got, "Loading day 3/5") {
+		t.Errorf("2 of 5 days = %q, want a progress bar for day 3/5", got)
+	}
+
+	// Unknown total: only the spinner
+	if got = renderFetchProgress(nil, constants.LoadingBatchProgress, 2, 0); got != "Loading..." {
+		t.Errorf("unknown total = %q, want just the spinner", got)
+	}
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/images"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FocusedMatchConfig holds the state rendered by the focused match view.
+type FocusedMatchConfig struct {
+	Details          *api.MatchDetails
+	ScoreHidden      bool
+	LiveUpdates      []string
+	UpdatesCollapsed bool
+	LiveClockSince   time.Time
+	PollingSpinner   *RandomCharSpinner
+	IsPolling        bool
+	Loading          bool
+	GoalLinks        GoalLinksMap
+	PlayerPhotos     *images.Cache
+	ScrollOffset     int  // Lines scrolled past, see FocusedMatchScrollSize
+	FillAway         bool // Stat bars filled with the away share, see MatchDetailsConfig
+	BannerType       constants.StatusBannerType
+	ReplaysFound     int // Reported by StatusBannerReplaysFound
+}
+
+// RenderFocusedMatchView renders a single match across the whole screen, with no list:
+// the match details with an enlarged score, then the live updates and the statistics.
+// Header and updates scroll together; the status banner and help stay put.
+func RenderFocusedMatchView(width, height int, cfg FocusedMatchConfig) string {
+	width, height = focusedMatchSize(width, height)
+
+	statusBanner := renderReplaysStatusBanner(cfg.BannerType, cfg.ReplaysFound, width)
+	help := neonDimStyle.Width(width - 6).Align(lipgloss.Center).Render(constants.HelpFocusedMatch)
+
+	lines, pageLines := focusedMatchLines(width, height, cfg)
+	offset := ClampScroll(cfg.ScrollOffset, len(lines), pageLines)
+	end := min(offset+pageLines, len(lines))
+
+	body := lipgloss.NewStyle().
+		Height(pageLines).
+		MaxHeight(pageLines).
+		Render(strings.Join(lines[offset:end], "\n"))
+
+	panel := lipgloss.NewStyle().
+		Padding(0, 1).
+		Width(width).
+		Render(lipgloss.JoinVertical(lipgloss.Left, body, "", help))
+
+	return lipgloss.JoinVertical(lipgloss.Left, statusBanner, panel)
+}
+
+// FocusedMatchScrollSize returns how many lines the focused match view scrolls through and
+// how many of them fit on screen, laid out as RenderFocusedMatchView does for the same size.
+func FocusedMatchScrollSize(width, height int, cfg FocusedMatchConfig) (contentLines, pageLines int) {
+	width, height = focusedMatchSize(width, height)
+	lines, pageLines := focusedMatchLines(width, height, cfg)
+	return len(lines), pageLines
+}
+
+// focusedMatchSize applies the fallback size used before the first window size message.
+func focusedMatchSize(width, height int) (int, int) {
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+	return width, height
+}
+
+// focusedMatchLines renders the scrolling part of the focused match view, the details header
+// included, and returns its lines with how many fit above the help.
+func focusedMatchLines(width, height int, cfg FocusedMatchConfig) (lines []string, pageLines int) {
+	headerContent, scrollableContent := RenderMatchDetails(MatchDetailsConfig{
+		Width:            width,
+		Height:           height,
+		Details:          cfg.Details,
+		ScoreHidden:      cfg.ScoreHidden,
+		GoalLinks:        cfg.GoalLinks,
+		PlayerPhotos:     cfg.PlayerPhotos,
+		ShowStatistics:   true,
+		StatBarsFillAway: cfg.FillAway,
+		LiveUpdates:      cfg.LiveUpdates,
+		UpdatesCollapsed: cfg.UpdatesCollapsed,
+		LiveClockSince:   cfg.LiveClockSince,
+		PollingSpinner:   cfg.PollingSpinner,
+		IsPolling:        cfg.IsPolling,
+		Loading:          cfg.Loading,
+		Enlarged:         true,
+	})
+	lines = strings.Split(lipgloss.JoinVertical(lipgloss.Left, headerContent, scrollableContent), "\n")
+
+	// The status banner line(s), and the blank line and help below the content
+	chrome := lipgloss.Height(renderReplaysStatusBanner(cfg.BannerType, cfg.ReplaysFound, width)) + 2
+	return lines, max(height-chrome, minScrollableArea)
+}
@@ -0,0 +1,185 @@
+// Package images draws small inline images in terminals supporting the Kitty or iTerm2
+// image protocols. Everywhere else it renders nothing.
+package images
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Protocol is a terminal inline image protocol.
+type Protocol int
+
+const (
+	// ProtocolNone means the terminal can't show inline images
+	ProtocolNone Protocol = iota
+	// ProtocolKitty is the Kitty graphics protocol (Kitty, Ghostty)
+	ProtocolKitty
+	// ProtocolITerm is the iTerm2 inline images protocol (iTerm2, WezTerm)
+	ProtocolITerm
+)
+
+// maxImageBytes caps a downloaded image; thumbnails are a few KB.
+const maxImageBytes = 512 * 1024
+
+// kittyChunkSize is the largest base64 payload the Kitty protocol accepts per escape.
+const kittyChunkSize = 4096
+
+// Detect returns the inline image protocol of the terminal, judged from its environment.
+// Inside tmux or screen, which don't pass image escapes through, it returns ProtocolNone.
+func Detect() Protocol {
+	return detect(os.Getenv)
+}
+
+func detect(getenv func(string) string) Protocol {
+	if getenv("TMUX") != "" || strings.HasPrefix(getenv("TERM"), "screen") {
+		return ProtocolNone
+	}
+
+	switch {
+	case getenv("KITTY_WINDOW_ID") != "", getenv("TERM") == "xterm-kitty", getenv("TERM_PROGRAM") == "ghostty":
+		return ProtocolKitty
+	case getenv("TERM_PROGRAM") == "iTerm.app", getenv("LC_TERMINAL") == "iTerm2", getenv("TERM_PROGRAM") == "WezTerm":
+		return ProtocolITerm
+	}
+	return ProtocolNone
+}
+
+// Inline returns the escape sequence drawing a PNG image cols cells wide and one row high
+// at the cursor, or "" for ProtocolNone.
+func Inline(p Protocol, png []byte, cols int) string {
+	if len(png) == 0 || cols <= 0 {
+		return ""
+	}
+	encoded := base64.StdEncoding.EncodeToString(png)
+
+	switch p {
+	case ProtocolKitty:
+		// q=2 keeps the terminal from answering on stdin, where it would read as key presses
+		var b strings.Builder
+		for i := 0; i < len(encoded); i += kittyChunkSize {
+			chunk := encoded[i:min(i+kittyChunkSize, len(encoded))]
+			more := 0
+			if i+kittyChunkSize < len(encoded) {
+				more = 1
+			}
+			if i == 0 {
+				fmt.Fprintf(&b, "\x1b_Gf=100,a=T,q=2,c=%d,r=1,m=%d;%s\x1b\\", cols, more, chunk)
+			} else {
+				fmt.Fprintf(&b, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+			}
+		}
+		return b.String()
+	case ProtocolITerm:
+		return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=1;preserveAspectRatio=1:%s\a", len(png), cols, encoded)
+	}
+	return ""
+}
+
+// Cache downloads images once and renders them inline.
+// A nil Cache, or one for ProtocolNone, downloads and renders nothing.
+type Cache struct {
+	protocol Protocol
+	client   *http.Client
+
+	mu     sync.Mutex
+	images map[string][]byte // Downloaded images by URL
+	failed map[string]bool   // URLs that failed to download; not retried
+}
+
+// NewCache returns an image cache rendering with protocol p.
+func NewCache(p Protocol) *Cache {
+	return &Cache{
+		protocol: p,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		images:   make(map[string][]byte),
+		failed:   make(map[string]bool),
+	}
+}
+
+// Enabled reports whether the cache renders images at all.
+func (c *Cache) Enabled() bool {
+	return c != nil && c.protocol != ProtocolNone
+}
+
+// Fetch downloads the image at url unless it is cached or already failed.
+// It reports whether a new image was stored; failures are remembered and returned.
+func (c *Cache) Fetch(ctx context.Context, url string) (bool, error) {
+	if !c.Enabled() || url == "" {
+		return false, nil
+	}
+
+	c.mu.Lock()
+	_, cached := c.images[url]
+	failed := c.failed[url]
+	c.mu.Unlock()
+	if cached || failed {
+		return false, nil
+	}
+
+	img, err := c.download(ctx, url)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		// A cancelled fetch may succeed next time
+		if ctx.Err() == nil {
+			c.failed[url] = true
+		}
+		return false, err
+	}
+	c.images[url] = img
+	return true, nil
+}
+
+func (c *Cache) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download %s: status %d", url, resp.StatusCode)
+	}
+
+	img, err := io.ReadAll(io.LimitReader(resp.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", url, err)
+	}
+	if len(img) > maxImageBytes {
+		return nil, fmt.Errorf("download %s: image larger than %d bytes", url, maxImageBytes)
+	}
+	return img, nil
+}
+
+// Thumbnail returns the cached image at url drawn cols cells wide, or "" if it isn't cached.
+// The result measures cols cells for layout code: the cells are first filled with spaces and
+// the cursor moved back over them, so the image lands where the spaces were.
+func (c *Cache) Thumbnail(url string, cols int) string {
+	if !c.Enabled() {
+		return ""
+	}
+
+	c.mu.Lock()
+	img := c.images[url]
+	c.mu.Unlock()
+
+	inline := Inline(c.protocol, img, cols)
+	if inline == "" {
+		return ""
+	}
+	return strings.Repeat(" ", cols) + fmt.Sprintf("\x1b[%dD", cols) + inline
+}
@@ -0,0 +1,89 @@
+package images
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Protocol
+	}{
+		{"plain terminal", map[string]string{"TERM": "xterm-256color"}, ProtocolNone},
+		{"kitty", map[string]string{"TERM": "xterm-kitty", "KITTY_WINDOW_ID": "1"}, ProtocolKitty},
+		{"iterm", map[string]string{"TERM_PROGRAM": "iTerm.app"}, ProtocolITerm},
+		{"wezterm", map[string]string{"TERM_PROGRAM": "WezTerm"}, ProtocolITerm},
+		{"kitty inside tmux", map[string]string{"KITTY_WINDOW_ID": "1", "TMUX": "/tmp/tmux-1000/default,1,0"}, ProtocolNone},
+	}
+
+	for _, tt := range tests {
+		getenv := func(key string) string { return tt.env[key] }
+		if got := detect(getenv); got != tt.want {
+			t.Errorf("%s: detect() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInlineKittyChunks(t *testing.T) {
+	png := make([]byte, kittyChunkSize) // Encodes to more than one chunk
+	seq := Inline(ProtocolKitty, png, 2)
+
+	if !strings.HasPrefix(seq, "\x1b_Gf=100,a=T,q=2,c=2,r=1,m=1;") {
+		t.Errorf("first chunk = %.40q, want a transmit-and-display header with more chunks to come", seq)
+	}
+	if !strings.Contains(seq, "\x1b_Gm=0;") {
+		t.Error("last chunk is not marked final")
+	}
+	if got := Inline(ProtocolNone, png, 2); got != "" {
+		t.Errorf("Inline(ProtocolNone) = %q, want \"\"", got)
+	}
+}
+
+func TestCacheFetch(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/missing.png" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("png"))
+	}))
+	defer server.Close()
+
+	cache := NewCache(ProtocolITerm)
+	ctx := context.Background()
+
+	if ok, err := cache.Fetch(ctx, server.URL+"/player.png"); !ok || err != nil {
+		t.Fatalf("Fetch() = %v, %v, want a new image", ok, err)
+	}
+	if got := cache.Thumbnail(server.URL+"/player.png", 2); !strings.HasPrefix(got, "  \x1b[2D\x1b]1337;File=") {
+		t.Errorf("Thumbnail() = %q, want reserved cells followed by an inline image", got)
+	}
+
+	// Failed downloads are skipped without a thumbnail, and not retried
+	if _, err := cache.Fetch(ctx, server.URL+"/missing.png"); err == nil {
+		t.Error("Fetch() of a missing image returned no error")
+	}
+	_, _ = cache.Fetch(ctx, server.URL+"/missing.png")
+	if got := cache.Thumbnail(server.URL+"/missing.png", 2); got != "" {
+		t.Errorf("Thumbnail() of a failed download = %q, want \"\"", got)
+	}
+	if requests != 2 {
+		t.Errorf("server saw %d requests, want 2", requests)
+	}
+
+	// Unsupported terminals never download
+	var none *Cache
+	if ok, err := none.Fetch(ctx, server.URL+"/player.png"); ok || err != nil {
+		t.Errorf("nil Cache Fetch() = %v, %v, want a no-op", ok, err)
+	}
+	if ok, _ := NewCache(ProtocolNone).Fetch(ctx, server.URL+"/other.png"); ok {
+		t.Error("ProtocolNone cache downloaded an image")
+	}
+}
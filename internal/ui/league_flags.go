@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// neutralLeagueGlyph prefixes matches of leagues without a known flag.
+const neutralLeagueGlyph = "⚽"
+
+// countryFlags maps the league countries used in data.AllSupportedLeagues to flag emoji.
+// Continental and international competitions get a globe or the EU flag.
+var countryFlags = map[string]string{
+	"Argentina":     "🇦🇷",
+	"Australia":     "🇦🇺",
+	"Austria":       "🇦🇹",
+	"Belgium":       "🇧🇪",
+	"Brazil":        "🇧🇷",
+	"Chile":         "🇨🇱",
+	"China":         "🇨🇳",
+	"Colombia":      "🇨🇴",
+	"Denmark":       "🇩🇰",
+	"Ecuador":       "🇪🇨",
+	"Egypt":         "🇪🇬",
+	"England":       "🏴󠁧󠁢󠁥󠁮󠁧󠁿",
+	"France":        "🇫🇷",
+	"Germany":       "🇩🇪",
+	"Greece":        "🇬🇷",
+	"India":         "🇮🇳",
+	"Ireland":       "🇮🇪",
+	"Italy":         "🇮🇹",
+	"Japan":         "🇯🇵",
+	"Mexico":        "🇲🇽",
+	"Morocco":       "🇲🇦",
+	"Netherlands":   "🇳🇱",
+	"Norway":        "🇳🇴",
+	"Peru":          "🇵🇪",
+	"Poland":        "🇵🇱",
+	"Portugal":      "🇵🇹",
+	"Qatar":         "🇶🇦",
+	"Russia":        "🇷🇺",
+	"Saudi Arabia":  "🇸🇦",
+	"Scotland":      "🏴󠁧󠁢󠁳󠁣󠁴󠁿",
+	"South Africa":  "🇿🇦",
+	"South Korea":   "🇰🇷",
+	"Spain":         "🇪🇸",
+	"Sweden":        "🇸🇪",
+	"Switzerland":   "🇨🇭",
+	"Turkey":        "🇹🇷",
+	"USA":           "🇺🇸",
+	"Ukraine":       "🇺🇦",
+	"Uruguay":       "🇺🇾",
+	"Europe":        "🇪🇺",
+	"Africa":        "🌍",
+	"Asia":          "🌏",
+	"North America": "🌎",
+	"South America": "🌎",
+	"International": "🌐",
+}
+
+// leagueFlags maps built-in league IDs to the flag of their country.
+var leagueFlags = func() map[int]string {
+	flags := make(map[int]string)
+	for _, leagues := range data.AllSupportedLeagues {
+		for _, league := range leagues {
+			if flag, ok := countryFlags[league.Country]; ok {
+				flags[league.ID] = flag
+			}
+		}
+	}
+	return flags
+}()
+
+// ShowLeagueFlags marks the matches whose titles get the league flag prefix, see
+// MatchDisplay.LeagueFlag. It is off by default, as some terminals render emoji poorly.
+func ShowLeagueFlags(matches []MatchDisplay, show bool) {
+	for i := range matches {
+		matches[i].LeagueFlag = show
+	}
+}
+
+// LeagueFlag returns the flag emoji for a league, looked up by ID and then by country,
+// or a neutral glyph when neither is known. Every glyph is two cells wide.
+func LeagueFlag(league api.League) string {
+	if flag, ok := leagueFlags[league.ID]; ok {
+		return flag
+	}
+	if flag, ok := countryFlags[league.Country]; ok {
+		return flag
+	}
+	return neutralLeagueGlyph
+}
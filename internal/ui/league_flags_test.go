@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestMatchDisplayTitleLeagueFlag(t *testing.T) {
+	match := MatchDisplay{Match: api.Match{
+		League:   api.League{ID: 47, Name: "Premier League"},
+		HomeTeam: api.Team{ShortName: "Arsenal"},
+		AwayTeam: api.Team{ShortName: "Chelsea"},
+	}}
+	plain := match.Title()
+
+	matches := []MatchDisplay{match}
+	ShowLeagueFlags(matches, true)
+	flagged := matches[0].Title()
+	if !strings.HasPrefix(flagged, LeagueFlag(match.League)+" ") || !strings.HasSuffix(flagged, plain) {
+		t.Errorf("Title() = %q, want the league flag before %q", flagged, plain)
+	}
+	if got, want := lipgloss.Width(flagged), lipgloss.Width(plain)+3; got != want {
+		t.Errorf("Title() width = %d, want %d", got, want)
+	}
+
+	if got := LeagueFlag(api.League{ID: -1}); got != neutralLeagueGlyph {
+		t.Errorf("LeagueFlag(unknown) = %q, want the neutral glyph", got)
+	}
+	if got := LeagueFlag(api.League{ID: -1, Country: "Spain"}); got != countryFlags["Spain"] {
+		t.Errorf("LeagueFlag(unknown Spanish league) = %q, want the Spanish flag", got)
+	}
+
+	// Width calculations assume two cells per glyph
+	for country, flag := range countryFlags {
+		if w := lipgloss.Width(flag); w != 2 {
+			t.Errorf("flag for %s is %d cells wide, want 2", country, w)
+		}
+	}
+}
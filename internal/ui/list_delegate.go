@@ -21,7 +21,8 @@ var (
 // NewMatchListDelegate creates a custom list delegate for match items.
 // Height is set to 3 to accommodate title + 2-line description (with KO time).
 // Uses Neon Gradient styling: red title, cyan description on selection.
-func NewMatchListDelegate() list.DefaultDelegate {
+// Group headers (MatchGroupHeaderItem) are drawn as section titles.
+func NewMatchListDelegate() MatchListDelegate {
 	d := list.NewDefaultDelegate()
 
 	// Set height to 3 lines: title (1) + description with KO time (2)
@@ -64,6 +65,17 @@ func NewMatchListDelegate() list.DefaultDelegate {
 		Bold(true).
 		Underline(true)
 
+	return MatchListDelegate{DefaultDelegate: d}
+}
+
+// NewCompactMatchListDelegate creates a match list delegate that draws each match on a
+// single line (see MatchDisplay.CompactTitle) with no spacing, so more matches fit on screen.
+func NewCompactMatchListDelegate() MatchListDelegate {
+	d := NewMatchListDelegate()
+	d.ShowDescription = false
+	d.SetHeight(1)
+	d.SetSpacing(0)
+	d.compact = true
 	return d
 }
 
@@ -1,8 +1,12 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/textfold"
 	"github.com/charmbracelet/bubbles/list"
 )
 
@@ -44,9 +48,30 @@ func (m MatchListItem) Description() string {
 }
 
 // FilterValue returns the value to use for filtering.
-// Returns team names for searching (e.g., "Arsenal vs Chelsea").
+// Returns full and short team names for searching (e.g., "Manchester City Man City vs Chelsea").
 func (m MatchListItem) FilterValue() string {
-	return m.Title()
+	return teamFilterNames(m.Match.HomeTeam) + " vs " + teamFilterNames(m.Match.AwayTeam)
+}
+
+// teamFilterNames returns a team's name followed by its short name when that differs.
+func teamFilterNames(team api.Team) string {
+	if team.ShortName == "" || team.ShortName == team.Name {
+		return team.Name
+	}
+	return team.Name + " " + team.ShortName
+}
+
+// TeamFilter is a list.FilterFunc keeping matches whose team names contain the term,
+// ignoring case and accents (e.g., "villa" matches "Aston Villa", "atletico" matches "Atlético").
+// Matches keep their list order and no characters are highlighted.
+func TeamFilter(term string, targets []string) []list.Rank {
+	var ranks []list.Rank
+	for i, target := range targets {
+		if textfold.Contains(target, term) {
+			ranks = append(ranks, list.Rank{Index: i})
+		}
+	}
+	return ranks
 }
 
 // ToMatchListItems converts a slice of MatchDisplay to list items.
@@ -60,3 +85,35 @@ func ToMatchListItems(matches []MatchDisplay) []list.Item {
 	}
 	return items
 }
+
+// LoadMoreListItem is a sentinel list item shown after the last loaded match
+// when more matches are available in the backing set.
+type LoadMoreListItem struct {
+	Remaining int
+}
+
+// Title returns the loading indicator text.
+func (l LoadMoreListItem) Title() string {
+	return constants.LoadingMore
+}
+
+// Description returns how many matches are not yet loaded.
+func (l LoadMoreListItem) Description() string {
+	return fmt.Sprintf("%d more matches", l.Remaining)
+}
+
+// FilterValue returns an empty string so the sentinel never matches a filter.
+func (l LoadMoreListItem) FilterValue() string {
+	return ""
+}
+
+// ToMatchListItemsWindow converts the first count matches to list items,
+// appending a LoadMoreListItem when matches remain beyond the window.
+func ToMatchListItemsWindow(matches []MatchDisplay, count int) []list.Item {
+	count = min(max(count, 0), len(matches))
+	items := ToMatchListItems(matches[:count])
+	if remaining := len(matches) - count; remaining > 0 {
+		items = append(items, LoadMoreListItem{Remaining: remaining})
+	}
+	return items
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+func TestTeamFilter(t *testing.T) {
+	items := []list.Item{
+		MatchListItem{Match: api.Match{
+			HomeTeam: api.Team{Name: "Aston Villa", ShortName: "Villa"},
+			AwayTeam: api.Team{Name: "Chelsea"},
+		}},
+		MatchListItem{Match: api.Match{
+			HomeTeam: api.Team{Name: "Atlético Madrid", ShortName: "Atlético"},
+			AwayTeam: api.Team{Name: "Real Sociedad"},
+		}},
+		LoadMoreListItem{Remaining: 3},
+	}
+	targets := make([]string, len(items))
+	for i, item := range items {
+		targets[i] = item.FilterValue()
+	}
+
+	tests := []struct {
+		term string
+		want []int
+	}{
+		{"villa", []int{0}},
+		{"CHEL", []int{0}},
+		{"atletico", []int{1}},
+		{"sociedad", []int{1}},
+		{"barcelona", nil},
+	}
+	for _, tt := range tests {
+		var got []int
+		for _, rank := range TeamFilter(tt.term, targets) {
+			got = append(got, rank.Index)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("TeamFilter(%q) = %v, want %v", tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestCompactTitle(t *testing.T) {
+	home, away := 2, 1
+	minute := "78'"
+	kickoff := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		match api.Match
+		want  string
+	}{
+		{"live", api.Match{
+			HomeTeam: api.Team{Name: "Arsenal", ShortName: "ARS"}, AwayTeam: api.Team{Name: "Chelsea", ShortName: "CHE"},
+			HomeScore: &home, AwayScore: &away, LiveTime: &minute, Status: api.MatchStatusLive,
+			League: api.League{Name: "Premier League"},
+		}, "ARS 2-1 CHE · Premier League · 78'"},
+		{"upcoming", api.Match{
+			HomeTeam: api.Team{Name: "Everton"}, AwayTeam: api.Team{Name: "Fulham"},
+			Status: api.MatchStatusNotStarted, MatchTime: &kickoff,
+			League: api.League{Name: "Premier League"},
+		}, "Everton vs Fulham · Premier League · KO " + formatDisplayTime(kickoff, "15:04")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (MatchDisplay{Match: tt.match}).CompactTitle(); got != tt.want {
+				t.Errorf("CompactTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompactMatchListDelegate(t *testing.T) {
+	d := NewCompactMatchListDelegate()
+	if d.Height() != 1 || d.Spacing() != 0 {
+		t.Errorf("compact delegate height/spacing = %d/%d, want 1/0", d.Height(), d.Spacing())
+	}
+
+	minute := "12'"
+	items := ToMatchListItems([]MatchDisplay{{Match: api.Match{
+		HomeTeam: api.Team{Name: "Arsenal"}, AwayTeam: api.Team{Name: "Chelsea"}, LiveTime: &minute,
+	}}})
+	l := list.New(items, d, 60, 10)
+	var b strings.Builder
+	d.Render(&b, l, 0, items[0])
+	if out := b.String(); strings.Contains(out, "\n") || !strings.Contains(out, "Arsenal vs Chelsea · 12'") {
+		t.Errorf("compact row = %q, want one line with the compact title", out)
+	}
+}
+
+func TestDisplayScoreJustKickedOff(t *testing.T) {
+	details, _ := data.MockMatchDetails(2006) // Inter vs Milan, 1' with no score from FotMob yet
+	if details == nil || details.HomeScore != nil || details.Status != api.MatchStatusLive {
+		t.Fatalf("fixture 2006 should be live with no score, got %+v", details)
+	}
+	if home, away, ok := displayScore(details.Match); !ok || home != 0 || away != 0 {
+		t.Errorf("displayScore() = %d, %d, %v for a live match without a score, want 0, 0, true", home, away, ok)
+	}
+	if got := (MatchDisplay{Match: details.Match}).Description(); !strings.HasPrefix(got, "0 - 0 • Serie A • 1'") {
+		t.Errorf("Description() = %q, want it to start with the 0 - 0 score", got)
+	}
+
+	notStarted := details.Match
+	notStarted.Status = api.MatchStatusNotStarted
+	if _, _, ok := displayScore(notStarted); ok {
+		t.Error("displayScore() gave a score for a match that hasn't started")
+	}
+}
@@ -3,13 +3,14 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/0xjuanma/golazo/internal/ui/images"
 	"github.com/charmbracelet/bubbles/list"
-	"github.com/charmbracelet/bubbles/spinner"
-	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -57,13 +58,26 @@ func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomin
 	if len(upcomingMatches) > 0 {
 		maxUpcomingHeight := innerHeight / 2
 
-		upcomingTitle := design.RenderHeader(constants.PanelUpcomingMatches, contentWidth)
-
-		var upcomingLines []string
-		upcomingLines = append(upcomingLines, upcomingTitle)
+		// Matches past their kickoff but not flipped to live yet are listed apart from later today's
+		var awaiting, later []string
+		now := time.Now()
 		for _, match := range upcomingMatches {
 			matchLine := renderUpcomingMatchLine(match, contentWidth)
-			upcomingLines = append(upcomingLines, matchLine)
+			if AwaitingKickoff(match.Match, now) {
+				awaiting = append(awaiting, matchLine)
+			} else {
+				later = append(later, matchLine)
+			}
+		}
+
+		var upcomingLines []string
+		if len(awaiting) > 0 {
+			upcomingLines = append(upcomingLines, design.RenderHeader(constants.PanelAwaitingKickoff, contentWidth))
+			upcomingLines = append(upcomingLines, awaiting...)
+		}
+		if len(later) > 0 {
+			upcomingLines = append(upcomingLines, design.RenderHeader(constants.PanelUpcomingMatches, contentWidth))
+			upcomingLines = append(upcomingLines, later...)
 		}
 		upcomingSection = strings.Join(upcomingLines, "\n")
 
@@ -95,7 +109,7 @@ func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomin
 func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 	var timeStr string
 	if match.MatchTime != nil {
-		timeStr = match.MatchTime.Local().Format("15:04")
+		timeStr = formatDisplayTime(*match.MatchTime, "15:04")
 	} else {
 		timeStr = "--:--"
 	}
@@ -117,14 +131,43 @@ func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 		awayTeam = awayTeam[:maxTeamLen-1] + "…"
 	}
 
-	return fmt.Sprintf("  %s  %s vs %s",
+	line := fmt.Sprintf("  %s  %s vs %s",
 		neonDimStyle.Render(timeStr),
 		neonValueStyle.Render(homeTeam),
 		neonValueStyle.Render(awayTeam))
+
+	// Countdown to kickoff when there's room for it
+	if countdown := matchCountdown(match.Match); countdown != "" && lipgloss.Width(line)+2+lipgloss.Width(countdown) <= maxWidth {
+		line += "  " + neonDimStyle.Render(countdown)
+	}
+	return line
+}
+
+// StatsDayHint describes which days the stats view shows, and where to go when they're empty.
+type StatsDayHint struct {
+	Anchor    time.Time // Last day shown; zero means today
+	Searching bool      // Looking for the nearest earlier day with matches
+	Suggested time.Time // Nearest earlier day with matches; zero if none is known
+	LiveOnly  bool      // Only matches in progress are listed
+}
+
+// statsEmptyMessage returns the empty match list text, offering the suggested day if there is one.
+func statsEmptyMessage(hint StatsDayHint) string {
+	switch {
+	case hint.LiveOnly:
+		return constants.EmptyNoLiveMatches + "\n\n" + constants.StatsLiveOnlyOff
+	case hint.Searching:
+		return constants.EmptyNoFinishedMatches + "\n\n" + constants.StatsSearchingMatchDay
+	case !hint.Suggested.IsZero():
+		return constants.EmptyNoFinishedMatches + "\n\n" + fmt.Sprintf(constants.StatsSuggestedMatchDay, formatDisplayTime(hint.Suggested, "Mon 2 Jan"))
+	default:
+		return constants.EmptyNoFinishedMatches + "\n\nTry selecting a different date range (h/l keys)"
+	}
 }
 
 // RenderStatsListPanel renders the left panel for stats view.
-func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange int, rightPanelFocused bool) string {
+// fetchedDays is the stats fetch depth; the date range selector offers no more than that.
+func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange, fetchedDays int, rightPanelFocused bool, dayHint StatsDayHint) string {
 	var header string
 	if rightPanelFocused {
 		header = design.RenderHeaderDim(constants.PanelMatchList, width-6)
@@ -132,12 +175,16 @@ func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange
 		header = design.RenderHeader(constants.PanelMatchList, width-6)
 	}
 
-	dateSelector := renderDateRangeSelector(width-6, dateRange)
+	dateSelector := renderDateRangeSelector(width-6, dateRange, fetchedDays, dayHint.Anchor, dayHint.LiveOnly)
+	if !dayHint.Anchor.IsZero() {
+		anchorLine := fmt.Sprintf(constants.StatsAnchoredDay, formatDisplayTime(dayHint.Anchor, "Mon 2 Jan"))
+		dateSelector += "\n" + neonDimStyle.Width(width-6).Align(lipgloss.Center).Render(anchorLine)
+	}
 	emptyStyle := neonEmptyStyle.Width(width - 6)
 
 	var finishedListView string
 	if len(finishedList.Items()) == 0 {
-		finishedListView = emptyStyle.Render(constants.EmptyNoFinishedMatches + "\n\nTry selecting a different date range (h/l keys)")
+		finishedListView = emptyStyle.Render(statsEmptyMessage(dayHint))
 	} else {
 		finishedListView = finishedList.View()
 	}
@@ -165,31 +212,82 @@ func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange
 	return panel
 }
 
-func renderDateRangeSelector(width int, selected int) string {
-	options := []struct {
-		days  int
-		label string
-	}{
-		{1, "Today"},
-		{3, "3d"},
-		{5, "5d"},
+// StatsDateRanges returns the date ranges, in days, offered for a stats fetch depth:
+// today, 3 days and the whole fetched window.
+func StatsDateRanges(fetchedDays int) []int {
+	if fetchedDays <= 3 {
+		return []int{1, 3}
 	}
+	return []int{1, 3, fetchedDays}
+}
 
-	items := make([]string, 0, len(options))
-	for _, opt := range options {
-		if opt.days == selected {
-			items = append(items, neonDateSelectedStyle.Render(opt.label))
+// renderDateRangeSelector renders the date range options; the single day range is labeled
+// with the anchor date, or "Today" when the window ends today.
+func renderDateRangeSelector(width int, selected, fetchedDays int, anchor time.Time, liveOnly bool) string {
+	ranges := StatsDateRanges(fetchedDays)
+	items := make([]string, 0, len(ranges))
+	for _, days := range ranges {
+		label := fmt.Sprintf("%dd", days)
+		if days == 1 {
+			label = "Today"
+			if !anchor.IsZero() {
+				label = formatDisplayTime(anchor, "Mon 2 Jan")
+			}
+		}
+		if days == selected {
+			items = append(items, neonDateSelectedStyle.Render(label))
 		} else {
-			items = append(items, neonDateUnselectedStyle.Render(opt.label))
+			items = append(items, neonDateUnselectedStyle.Render(label))
 		}
 	}
 
+	if liveOnly {
+		items = append(items, neonLiveOnlyStyle.Render(constants.StatsLiveOnlyIndicator))
+	}
+
 	selector := strings.Join(items, "  ")
 	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Padding(0, 1).Render(selector)
 }
 
+// renderOfflineIndicator tells that the view shows cached data fetched at since.
+// The day is included unless it was fetched today.
+func renderOfflineIndicator(since time.Time) string {
+	layout := "15:04"
+	if formatDisplayTime(since, "2006-01-02") != formatDisplayTime(time.Now(), "2006-01-02") {
+		layout = "Mon 2 Jan 15:04"
+	}
+	indicator := fmt.Sprintf(constants.OfflineIndicator, formatDisplayTime(since, layout))
+	return lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(indicator)
+}
+
+// LiveViewConfig holds the state rendered by the live matches view.
+type LiveViewConfig struct {
+	List             list.Model
+	ListPanelPercent int // The list's share of the width, see PanelWidths
+	UpcomingMatches  []MatchDisplay
+	Details          *api.MatchDetails
+	ScoreHidden      bool // The details' result is hidden by spoiler mode
+	DetailsLoading   bool // Details of the selected match are being fetched
+	LiveUpdates      []string
+	UpdatesCollapsed bool
+	LiveClockSince   time.Time
+	PollingSpinner   *RandomCharSpinner
+	IsPolling        bool
+	Loading          bool
+	GoalLinks        GoalLinksMap
+	PlayerPhotos     *images.Cache
+	RandomSpinner    *RandomCharSpinner
+	ViewLoading      bool // Leagues are still being fetched, see LeaguesLoaded
+	LeaguesLoaded    int
+	TotalLeagues     int
+	WatchInterval    time.Duration // Watch mode rotation, zero when off
+	OfflineSince     time.Time     // When the cached matches shown were fetched, zero when online
+	BannerType       constants.StatusBannerType
+	ReplaysFound     int // Reported by StatusBannerReplaysFound
+}
+
 // RenderMultiPanelViewWithList renders the live matches view with list component.
-func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
+func RenderMultiPanelViewWithList(width, height int, cfg LiveViewConfig) string {
 	if width <= 0 {
 		width = 80
 	}
@@ -207,44 +305,39 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 		AlignVertical(lipgloss.Center)
 
 	var spinnerArea string
-	if viewLoading && randomSpinner != nil {
-		spinnerView := randomSpinner.View()
-		var progressText string
-		if totalLeagues > 0 && leaguesLoaded < totalLeagues {
-			progressText = fmt.Sprintf("  Scanning batch %d/%d...", leaguesLoaded+1, totalLeagues)
-		}
-		if spinnerView != "" {
-			spinnerArea = spinnerStyle.Render(spinnerView + progressText)
-		} else {
-			spinnerArea = spinnerStyle.Render("Loading..." + progressText)
-		}
+	if cfg.ViewLoading && cfg.RandomSpinner != nil {
+		spinnerArea = spinnerStyle.Render(renderFetchProgress(cfg.RandomSpinner, constants.LoadingBatchProgress, cfg.LeaguesLoaded, cfg.TotalLeagues))
+	} else if !cfg.OfflineSince.IsZero() {
+		spinnerArea = spinnerStyle.Render(renderOfflineIndicator(cfg.OfflineSince))
+	} else if cfg.WatchInterval > 0 {
+		// Watch mode indicator takes the spinner's place
+		indicator := fmt.Sprintf(constants.WatchModeIndicator, cfg.WatchInterval)
+		spinnerArea = spinnerStyle.Render(lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render(indicator))
 	} else {
 		spinnerArea = spinnerStyle.Render("")
 	}
 
-	leftWidth := max(width*35/100, 25)
-	rightWidth := width - leftWidth - 1
-	if rightWidth < 35 {
-		rightWidth = 35
-		leftWidth = width - rightWidth - 1
-	}
+	leftWidth, rightWidth := PanelWidths(width, cfg.ListPanelPercent)
 
 	panelHeight := availableHeight - 2
 
-	leftPanel := RenderLiveMatchesListPanel(leftWidth, panelHeight, listModel, upcomingMatches)
-	rightPanel := renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, liveUpdates, sp, loading, pollingSpinner, isPolling, goalLinks)
+	leftPanel := RenderLiveMatchesListPanel(leftWidth, panelHeight, cfg.List, cfg.UpcomingMatches)
+	rightPanel := renderLiveDetailsPanel(rightWidth, panelHeight, cfg)
 
 	separatorStyle := neonSeparatorStyle.Height(panelHeight)
 	separator := separatorStyle.Render("┃")
 
 	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
-	statusBanner := renderStatusBanner(bannerType, width)
+	statusBanner := renderReplaysStatusBanner(cfg.BannerType, cfg.ReplaysFound, width)
 
 	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
 }
 
-// RenderStatsViewWithList renders the stats view with list component.
-func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int) string {
+// statsSpinnerHeight is the height of the loading/indicator area above the stats view panels.
+const statsSpinnerHeight = 3
+
+// statsPanelLayout returns the stats view's list and details panel widths and their height.
+func statsPanelLayout(width, height, listPercent int) (leftWidth, rightWidth, panelHeight int) {
 	if width <= 0 {
 		width = 80
 	}
@@ -252,60 +345,96 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 		height = 24
 	}
 
-	spinnerHeight := 3
-	availableHeight := max(height-spinnerHeight, minPanelHeight)
+	leftWidth, rightWidth = PanelWidths(width, listPercent)
+	return leftWidth, rightWidth, max(height-statsSpinnerHeight, minPanelHeight) - 2
+}
+
+// PanelWidths splits the live and stats views' width between the match list, which gets
+// percent of it (DefaultListPanelPercent when zero), and the details panel, with a separator
+// column between them. Neither panel shrinks below its minimum width.
+func PanelWidths(width, percent int) (leftWidth, rightWidth int) {
+	if percent <= 0 {
+		percent = data.DefaultListPanelPercent
+	}
+	leftWidth = max(width*percent/100, 25)
+	rightWidth = width - leftWidth - 1
+	if rightWidth < 35 {
+		rightWidth = 35
+		leftWidth = width - rightWidth - 1
+	}
+	return leftWidth, rightWidth
+}
+
+// StatsViewConfig holds the state rendered by the stats view.
+type StatsViewConfig struct {
+	List              list.Model
+	ListPanelPercent  int // The list's share of the width, see PanelWidths
+	DateRange         int // Days shown, see RenderStatsListPanel
+	DayHint           StatsDayHint
+	Details           *api.MatchDetails
+	ScoreHidden       bool // The details' result is hidden by spoiler mode
+	DetailsLoading    bool // Details of the selected match are being fetched
+	GoalLinks         GoalLinksMap
+	PlayerPhotos      *images.Cache
+	RightPanelFocused bool
+	ScrollOffset      int // Lines of the focused details scrolled past, see StatsDetailsScrollSize
+	ShowTimeline      bool
+	ShowEvents        bool // The focused details show EventsList instead
+	EventsList        list.Model
+	EventsFilter      EventFilter
+	MinuteRange       MinuteRange
+	FillAway          bool // Stat bars filled with the away share, see MatchDetailsConfig
+	RandomSpinner     *RandomCharSpinner
+	ViewLoading       bool // Days are still being fetched, see DaysLoaded
+	DaysLoaded        int
+	TotalDays         int
+	OfflineSince      time.Time // When the cached matches shown were fetched, zero when online
+	BannerType        constants.StatusBannerType
+	ReplaysFound      int // Reported by StatusBannerReplaysFound
+}
+
+// RenderStatsViewWithList renders the stats view with list component.
+func RenderStatsViewWithList(width, height int, cfg StatsViewConfig) string {
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
 
 	spinnerStyle := lipgloss.NewStyle().
 		Width(width).
-		Height(spinnerHeight).
+		Height(statsSpinnerHeight).
 		Align(lipgloss.Center).
 		AlignVertical(lipgloss.Center)
 
 	var spinnerArea string
-	if viewLoading && randomSpinner != nil {
-		spinnerView := randomSpinner.View()
-		var progressText string
-		if totalDays > 0 && daysLoaded < totalDays {
-			progressText = fmt.Sprintf("  Loading day %d/%d...", daysLoaded+1, totalDays)
-		}
-		if spinnerView != "" {
-			spinnerArea = spinnerStyle.Render(spinnerView + progressText)
-		} else {
-			spinnerArea = spinnerStyle.Render("Loading..." + progressText)
-		}
+	if cfg.ViewLoading && cfg.RandomSpinner != nil {
+		spinnerArea = spinnerStyle.Render(renderFetchProgress(cfg.RandomSpinner, constants.LoadingDayProgress, cfg.DaysLoaded, cfg.TotalDays))
+	} else if !cfg.OfflineSince.IsZero() {
+		spinnerArea = spinnerStyle.Render(renderOfflineIndicator(cfg.OfflineSince))
 	} else {
 		spinnerArea = spinnerStyle.Render("")
 	}
 
-	leftWidth := max(width*35/100, 25)
-	rightWidth := width - leftWidth - 1
-	if rightWidth < 35 {
-		rightWidth = 35
-		leftWidth = width - rightWidth - 1
-	}
-
-	panelHeight := availableHeight - 2
+	leftWidth, rightWidth, panelHeight := statsPanelLayout(width, height, cfg.ListPanelPercent)
+	rightPanelFocused := cfg.RightPanelFocused
 
-	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange, rightPanelFocused)
-	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, rightPanelFocused)
+	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, cfg.List, cfg.DateRange, cfg.TotalDays, rightPanelFocused, cfg.DayHint)
+	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, cfg)
+	if cfg.Details == nil && cfg.DetailsLoading {
+		scrollableContent = renderStatsDetailsPlaceholder(rightWidth, panelHeight, constants.LoadingDetails)
+	}
 
 	var rightPanel string
 	scrollableLines := strings.Split(scrollableContent, "\n")
 	headerHeight := strings.Count(headerContent, "\n") + 1
-	availableHeight = max(panelHeight-headerHeight, minScrollableArea)
+	availableHeight := max(panelHeight-headerHeight, minScrollableArea)
 
 	visibleLines := scrollableLines
 	if rightPanelFocused && len(scrollableLines) > availableHeight {
-		start := scrollOffset
-		end := min(start+availableHeight, len(scrollableLines))
-		if start < len(scrollableLines) && start >= 0 {
-			visibleLines = scrollableLines[start:end]
-		} else if start >= len(scrollableLines) {
-			visibleLines = []string{}
-		}
-		for len(visibleLines) < availableHeight && len(visibleLines) < len(scrollableLines) {
-			visibleLines = append(visibleLines, "")
-		}
+		start := ClampScroll(cfg.ScrollOffset, len(scrollableLines), availableHeight)
+		visibleLines = scrollableLines[start : start+availableHeight]
 	} else {
 		if len(scrollableLines) > availableHeight {
 			visibleLines = scrollableLines[:availableHeight]
@@ -314,6 +443,11 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 
 	visibleContent := strings.Join(visibleLines, "\n")
 
+	// Events mode replaces the scrollable details with the filterable events list
+	if rightPanelFocused && cfg.ShowEvents && cfg.Details != nil {
+		visibleContent = renderEventsListContent(rightWidth-4, availableHeight, cfg.EventsList, cfg.EventsFilter, cfg.MinuteRange)
+	}
+
 	// Add context-aware help hint at bottom of panel content
 	var helpText string
 	if rightPanelFocused {
@@ -350,45 +484,51 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 	separator := separatorStyle.Render("┃")
 
 	panels := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
-	statusBanner := renderStatusBanner(bannerType, width)
+	statusBanner := renderReplaysStatusBanner(cfg.BannerType, cfg.ReplaysFound, width)
 
 	return lipgloss.JoinVertical(lipgloss.Left, spinnerArea, statusBanner, panels)
 }
 
 // renderStatsMatchDetailsPanel renders match details using unified rendering.
-func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, focused bool) (string, string) {
-	if details == nil {
-		emptyMessage := neonDimStyle.
-			Align(lipgloss.Center).
-			Width(width - 6).
-			PaddingTop(height / 4).
-			Render("Select a match to view details")
-
-		emptyPanel := neonPanelCyanStyle.
-			Width(width).
-			Height(height).
-			MaxHeight(height).
-			Render(emptyMessage)
-
-		return "", emptyPanel
+func renderStatsMatchDetailsPanel(width, height int, cfg StatsViewConfig) (string, string) {
+	if cfg.Details == nil {
+		return "", renderStatsDetailsPlaceholder(width, height, "Select a match to view details")
 	}
 
-	cfg := MatchDetailsConfig{
-		Width:          width,
-		Height:         height,
-		Details:        details,
-		GoalLinks:      goalLinks,
-		ShowStatistics: true,
-		ShowHighlights: true,
-		Focused:        focused,
-	}
+	return RenderMatchDetails(MatchDetailsConfig{
+		Width:            width,
+		Height:           height,
+		Details:          cfg.Details,
+		ScoreHidden:      cfg.ScoreHidden,
+		GoalLinks:        cfg.GoalLinks,
+		PlayerPhotos:     cfg.PlayerPhotos,
+		ShowStatistics:   true,
+		ShowHighlights:   true,
+		ShowTimeline:     cfg.ShowTimeline,
+		Minutes:          cfg.MinuteRange,
+		StatBarsFillAway: cfg.FillAway,
+		Focused:          cfg.RightPanelFocused,
+	})
+}
+
+// renderStatsDetailsPlaceholder renders the stats details panel holding only a message.
+func renderStatsDetailsPlaceholder(width, height int, message string) string {
+	emptyMessage := neonDimStyle.
+		Align(lipgloss.Center).
+		Width(width - 6).
+		PaddingTop(height / 4).
+		Render(message)
 
-	return RenderMatchDetails(cfg)
+	return neonPanelCyanStyle.
+		Width(width).
+		Height(height).
+		MaxHeight(height).
+		Render(emptyMessage)
 }
 
 // RenderMatchDetailsPanel is an exported version for debug scripts.
 func RenderMatchDetailsPanel(width, height int, details *api.MatchDetails) string {
-	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, false)
+	header, scrollable := renderStatsMatchDetailsPanel(width, height, StatsViewConfig{Details: details})
 	content := lipgloss.JoinVertical(lipgloss.Left, header, scrollable)
 	return neonPanelCyanStyle.
 		Width(width).
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// liveClockMaxDrift caps how many minutes the live clock runs ahead of the last fetched
+// minute; the next poll corrects it.
+const liveClockMaxDrift = 3
+
+// liveClockPeriodEnds are the minutes at which a period ends and stoppage time begins.
+var liveClockPeriodEnds = []int{45, 90, 105, 120}
+
+// liveMinutePattern matches FotMob live times such as "67'", "45+2'" or "90+4".
+var liveMinutePattern = regexp.MustCompile(`^(\d+)(?:\+(\d+))?'?$`)
+
+// EstimateLiveTime advances a fetched live time by the wall-clock minutes elapsed since it
+// was fetched, so the clock keeps moving between polls. Minutes past the end of a period
+// are shown as stoppage time ("45+2'"). Half-time and other non-minute values are returned
+// unchanged, and the estimate never drifts more than liveClockMaxDrift minutes ahead.
+func EstimateLiveTime(liveTime string, fetchedAt, now time.Time) string {
+	match := liveMinutePattern.FindStringSubmatch(strings.TrimSpace(liveTime))
+	if match == nil || fetchedAt.IsZero() {
+		return liveTime
+	}
+
+	elapsed := min(int(now.Sub(fetchedAt)/time.Minute), liveClockMaxDrift)
+	if elapsed <= 0 {
+		return liveTime
+	}
+
+	suffix := ""
+	if strings.HasSuffix(liveTime, "'") {
+		suffix = "'"
+	}
+
+	minute, _ := strconv.Atoi(match[1])
+
+	// Already in stoppage time: only the added minutes advance
+	if match[2] != "" {
+		added, _ := strconv.Atoi(match[2])
+		return fmt.Sprintf("%d+%d%s", minute, added+elapsed, suffix)
+	}
+
+	estimate := minute + elapsed
+	for _, end := range liveClockPeriodEnds {
+		if minute <= end && estimate > end {
+			return fmt.Sprintf("%d+%d%s", end, estimate-end, suffix)
+		}
+	}
+	return fmt.Sprintf("%d%s", estimate, suffix)
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateLiveTime(t *testing.T) {
+	fetchedAt := time.Date(2026, 2, 14, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		liveTime string
+		elapsed  time.Duration
+		want     string
+	}{
+		{"67'", 30 * time.Second, "67'"},
+		{"67'", 2*time.Minute + 10*time.Second, "69'"},
+		{"67'", 20 * time.Minute, "70'"}, // capped until the next poll
+		{"44'", 3 * time.Minute, "45+2'"},
+		{"45+2'", time.Minute, "45+3'"},
+		{"88", 2 * time.Minute, "90"},
+		{"HT", 5 * time.Minute, "HT"},
+		{"FT", 5 * time.Minute, "FT"},
+	}
+
+	for _, tt := range tests {
+		if got := EstimateLiveTime(tt.liveTime, fetchedAt, fetchedAt.Add(tt.elapsed)); got != tt.want {
+			t.Errorf("EstimateLiveTime(%q, +%v) = %q, want %q", tt.liveTime, tt.elapsed, got, tt.want)
+		}
+	}
+
+	if got := EstimateLiveTime("67'", time.Time{}, fetchedAt); got != "67'" {
+		t.Errorf("EstimateLiveTime() without a fetch time = %q, want it unchanged", got)
+	}
+}
@@ -510,7 +510,7 @@ func truncateVisibleRange(s string, start, end int) string {
 
 	// Reset ANSI at the end
 	if result.Len() > 0 {
-		result.WriteString("\x1b[0m")
+		result.WriteString(resetSequence())
 	}
 
 	return result.String()
@@ -551,7 +551,7 @@ func renderWithMask(s string, mask []bool) string {
 	}
 
 	// Reset ANSI at the end
-	result.WriteString("\x1b[0m")
+	result.WriteString(resetSequence())
 
 	return result.String()
 }
@@ -581,66 +581,3 @@ func (a *AnimatedLogo) Reset() {
 func (a *AnimatedLogo) GetAnimationType() AnimationType {
 	return a.animationType
 }
-
-// visibleLength returns the number of visible characters in a string,
-// excluding ANSI escape codes.
-func visibleLength(s string) int {
-	length := 0
-	inEscape := false
-
-	for _, r := range s {
-		if r == '\x1b' {
-			inEscape = true
-			continue
-		}
-		if inEscape {
-			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
-				inEscape = false
-			}
-			continue
-		}
-		length++
-	}
-
-	return length
-}
-
-// truncateToVisible truncates a string to n visible characters,
-// preserving ANSI escape codes.
-func truncateToVisible(s string, n int) string {
-	if n <= 0 {
-		return ""
-	}
-
-	var result strings.Builder
-	visibleCount := 0
-	inEscape := false
-
-	for _, r := range s {
-		if r == '\x1b' {
-			inEscape = true
-			result.WriteRune(r)
-			continue
-		}
-		if inEscape {
-			result.WriteRune(r)
-			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
-				inEscape = false
-			}
-			continue
-		}
-
-		if visibleCount >= n {
-			break
-		}
-		result.WriteRune(r)
-		visibleCount++
-	}
-
-	// Reset ANSI at the end to prevent color bleeding
-	if visibleCount > 0 {
-		result.WriteString("\x1b[0m")
-	}
-
-	return result.String()
-}
@@ -0,0 +1,22 @@
+package logo
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestAnimatedLogoMatchesStaticHeight guards against main menu layout shift:
+// every animation frame must be as tall as the static logo shown when animation is disabled.
+func TestAnimatedLogoMatchesStaticHeight(t *testing.T) {
+	staticHeight := strings.Count(Render("v1.0.0", false, DefaultOpts()), "\n") + 1
+
+	for _, animationType := range AllAnimationTypes() {
+		a := NewAnimatedLogoWithType("v1.0.0", false, DefaultOpts(), 700, 1, animationType)
+		for frame := 0; !a.IsComplete() && frame < 100; frame++ {
+			if got := strings.Count(a.View(), "\n") + 1; got != staticHeight {
+				t.Fatalf("%s frame %d height = %d, want %d", animationType, frame, got, staticHeight)
+			}
+			a.Tick()
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package logo
+
+import (
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/mattn/go-runewidth"
+)
+
+// ansiReset ends any color left open by a truncated line.
+const ansiReset = "\x1b[0m"
+
+// resetSequence returns ansiReset, or "" when rendering without color.
+func resetSequence() string {
+	if design.NoColor() {
+		return ""
+	}
+	return ansiReset
+}
+
+// isEscapeFinal reports whether r ends an ANSI escape sequence such as "\x1b[38;2;1;2;3m".
+func isEscapeFinal(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// visibleLength returns the display width of a string in terminal cells,
+// excluding ANSI escape codes.
+func visibleLength(s string) int {
+	length := 0
+	inEscape := false
+
+	for _, r := range s {
+		if r == '\x1b' {
+			inEscape = true
+			continue
+		}
+		if inEscape {
+			if isEscapeFinal(r) {
+				inEscape = false
+			}
+			continue
+		}
+		length += runewidth.RuneWidth(r)
+	}
+
+	return length
+}
+
+// truncateToVisible truncates a string to n visible cells, preserving ANSI escape codes.
+// Escape sequences are copied whole, never split, and a wide rune that would straddle
+// the cut is dropped. The result ends with a reset to prevent color bleeding.
+func truncateToVisible(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	var result strings.Builder
+	visibleCount := 0
+	inEscape := false
+
+	for _, r := range s {
+		if r == '\x1b' {
+			inEscape = true
+			result.WriteRune(r)
+			continue
+		}
+		if inEscape {
+			result.WriteRune(r)
+			if isEscapeFinal(r) {
+				inEscape = false
+			}
+			continue
+		}
+
+		width := runewidth.RuneWidth(r)
+		if visibleCount+width > n {
+			break
+		}
+		result.WriteRune(r)
+		visibleCount += width
+	}
+
+	// A sequence cut off by the end of s would swallow the reset; drop it
+	out := result.String()
+	if inEscape {
+		out = out[:strings.LastIndexByte(out, '\x1b')]
+	}
+	return out + resetSequence()
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/lucasb-eyer/go-colorful"
 )
 
 // letterform represents a letterform. It can be stretched horizontally
@@ -117,7 +116,7 @@ func Render(version string, compact bool, o Opts) string {
 		lines := strings.Split(logo, "\n")
 		for i, line := range lines {
 			if lipgloss.Width(line) > o.Width {
-				lines[i] = truncateAnsi(line, o.Width)
+				lines[i] = truncateToVisible(line, o.Width)
 			}
 		}
 		logo = strings.Join(lines, "\n")
@@ -133,11 +132,10 @@ func RenderCompact(width int) string {
 
 // applyLineGradient applies a gradient to a single line of text.
 func applyLineGradient(text string, startHex, endHex string) string {
-	startColor, err1 := colorful.Hex(startHex)
-	endColor, err2 := colorful.Hex(endHex)
-	if err1 != nil || err2 != nil {
+	if design.NoColor() {
 		return text
 	}
+	startColor, endColor := design.GradientColors(startHex, endHex)
 
 	runes := []rune(text)
 	if len(runes) == 0 {
@@ -188,19 +186,6 @@ func renderWord(spacing int, stretchIndex int, letterforms ...letterform) string
 	)
 }
 
-// truncateAnsi truncates a string with ANSI codes to a given width.
-func truncateAnsi(s string, width int) string {
-	if lipgloss.Width(s) <= width {
-		return s
-	}
-	// Simple truncation - not perfect but works for most cases
-	runes := []rune(s)
-	if len(runes) > width {
-		return string(runes[:width])
-	}
-	return s
-}
-
 // Letterform definitions using Unicode block characters
 // ▄ ▀ █ ▌ ▐
 
@@ -290,6 +275,13 @@ func stretchPart(s string, stretch bool, baseWidth, minStretch, maxStretch int)
 var randCache = make(map[int]int)
 var randSeed = 0
 
+// SetSeed seeds the stretched letter choice and forgets earlier choices, so renders after
+// the same seed are identical (e.g. for screenshots and tests).
+func SetSeed(seed int64) {
+	randSeed = int(seed & 0x7fffffff)
+	randCache = make(map[int]int)
+}
+
 func cachedRandN(n int) int {
 	if n <= 0 {
 		return 0
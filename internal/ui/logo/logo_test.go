@@ -0,0 +1,75 @@
+package logo
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// ansiSequence matches a complete SGR escape sequence.
+var ansiSequence = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+func TestRenderTruncatesWithoutSplittingEscapes(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(profile)
+
+	opts := DefaultOpts()
+	opts.Width = 10
+	rendered := Render("v1.0.0", false, opts)
+
+	if !strings.Contains(rendered, "\x1b[") {
+		t.Fatal("logo rendered without colors; the test needs escape codes to check")
+	}
+	for i, line := range strings.Split(rendered, "\n") {
+		if got := lipgloss.Width(line); got > opts.Width {
+			t.Errorf("line %d width = %d, want at most %d", i, got, opts.Width)
+		}
+		if stray := ansiSequence.ReplaceAllString(line, ""); strings.ContainsAny(stray, "\x1b") || strings.Contains(stray, "[38;") {
+			t.Errorf("line %d has a broken escape sequence: %q", i, line)
+		}
+		if visibleLength(line) > 0 && !strings.HasSuffix(line, ansiReset) {
+			t.Errorf("line %d does not end with a reset: %q", i, line)
+		}
+	}
+}
+
+func TestTruncateToVisible(t *testing.T) {
+	const red = "\x1b[38;2;255;0;0m"
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{"plain", "abcdef", 3, "abc" + ansiReset},
+		{"keeps escapes whole", red + "abcdef" + ansiReset, 2, red + "ab" + ansiReset},
+		{"wide rune at the cut", "a⚽b", 2, "a" + ansiReset},
+		{"wide rune fits", "a⚽b", 3, "a⚽" + ansiReset},
+		{"unterminated escape", "ab\x1b[38;2", 5, "ab" + ansiReset},
+		{"zero width", red + "abc", 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := truncateToVisible(tt.in, tt.n); got != tt.want {
+			t.Errorf("%s: truncateToVisible(%q, %d) = %q, want %q", tt.name, tt.in, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestSetSeedMakesRenderDeterministic(t *testing.T) {
+	SetSeed(42)
+	first := Render("v1.0.0", false, DefaultOpts())
+
+	// Another seed in between must not leak into the next render
+	SetSeed(7)
+	_ = Render("v1.0.0", false, DefaultOpts())
+
+	SetSeed(42)
+	if again := Render("v1.0.0", false, DefaultOpts()); again != first {
+		t.Errorf("Render() after SetSeed(42) differs:\n%s\nwant\n%s", again, first)
+	}
+}
@@ -2,12 +2,17 @@ package ui
 
 import (
 	"fmt"
+	"math"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/0xjuanma/golazo/internal/ui/images"
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -17,19 +22,32 @@ type MatchDetailsConfig struct {
 	Width, Height int
 	Details       *api.MatchDetails
 	GoalLinks     GoalLinksMap
+	PlayerPhotos  *images.Cache // Scorer thumbnails; nil leaves them out
+	ScoreHidden   bool          // Result hidden by spoiler mode: score, goals and cards left out
 
 	// View-specific features
-	ShowStatistics bool // Stats view only
-	ShowHighlights bool // Stats view only
+	ShowStatistics bool        // Stats view only
+	ShowHighlights bool        // Stats view only
+	ShowTimeline   bool        // Stats view only: merge goals/cards/subs into one timeline
+	Minutes        MinuteRange // Stats view only: limit goals/cards/subs to these minutes
+
+	// StatBarsFillAway fills the percentage stat bars from the right with the away share,
+	// instead of from the left with the home share
+	StatBarsFillAway bool
 
 	// Live view state
-	LiveUpdates    []string
-	PollingSpinner *RandomCharSpinner
-	IsPolling      bool
-	Loading        bool
+	LiveUpdates      []string
+	UpdatesCollapsed bool      // Only the updates' title and count, keeping the header in view on short terminals
+	LiveClockSince   time.Time // When Details.LiveTime was fetched; zero keeps the clock still
+	PollingSpinner   *RandomCharSpinner
+	IsPolling        bool
+	Loading          bool
 
 	// Stats view state
 	Focused bool
+
+	// Enlarged doubles the score, for the focused match view
+	Enlarged bool
 }
 
 // RenderMatchDetails renders match details content, returning header and scrollable content separately.
@@ -60,39 +78,73 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 	headerLines = append(headerLines, "")
 
 	// Status and league info
-	headerLines = append(headerLines, renderStatusLine(details, contentWidth))
+	headerLines = append(headerLines, renderStatusLine(details, cfg.LiveClockSince, contentWidth))
 	headerLines = append(headerLines, "")
 
-	// Teams display
-	teamsDisplay := fmt.Sprintf("%s  vs  %s",
-		neonTeamStyle.Render(homeTeam),
-		neonTeamStyle.Render(awayTeam))
+	// Teams display, marking the team through a decided two-legged tie
+	hidden := cfg.ScoreHidden
+	homeLabel, awayLabel := neonTeamStyle.Render(homeTeam), neonTeamStyle.Render(awayTeam)
+	if !hidden && details.Advanced != nil {
+		advances := lipgloss.NewStyle().Foreground(neonCyan).Render(constants.AdvancesMarker)
+		switch *details.Advanced {
+		case details.HomeTeam.ID:
+			homeLabel += " " + advances
+		case details.AwayTeam.ID:
+			awayLabel += " " + advances
+		}
+	}
+	teamsDisplay := fmt.Sprintf("%s  vs  %s", homeLabel, awayLabel)
 	headerLines = append(headerLines, lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center).Render(teamsDisplay))
 	headerLines = append(headerLines, "")
 
 	// Large score
-	if details.HomeScore != nil && details.AwayScore != nil {
-		headerLines = append(headerLines, renderLargeScore(*details.HomeScore, *details.AwayScore, contentWidth))
+	renderScore := renderLargeScore
+	if cfg.Enlarged {
+		renderScore = renderEnlargedScore
+	}
+	if hidden {
+		headerLines = append(headerLines, renderScore(-1, -1, contentWidth))
+	} else if homeScore, awayScore, ok := displayScore(details.Match); ok {
+		headerLines = append(headerLines, renderScore(homeScore, awayScore, contentWidth))
 	} else {
+		// Postponed/abandoned matches have no score - say so instead of "vs"
+		scoreText := "vs"
+		if label := statusLabel(details.Status); label != "" {
+			scoreText = label
+		}
 		vsText := lipgloss.NewStyle().
 			Foreground(neonDim).
 			Width(contentWidth).
 			Align(lipgloss.Center).
-			Render("vs")
+			Render(scoreText)
 		headerLines = append(headerLines, vsText)
 	}
-	headerLines = append(headerLines, "")
+	// Which side is on top right now, refreshed with each poll
+	if details.Status == api.MatchStatusLive && details.LiveMomentum != nil {
+		if bar := renderPressureBar(*details.LiveMomentum, contentWidth); bar != "" {
+			headerLines = append(headerLines, lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center).Render(bar))
+		}
+	}
+	pageLink := lipgloss.NewStyle().
+		Foreground(neonDim).
+		Width(contentWidth).
+		Align(lipgloss.Center).
+		Render(Hyperlink("View on FotMob ↗", api.MatchURL(details)))
+	headerLines = append(headerLines, pageLink, "")
 
 	// Match context (detailed info)
-	headerLines = append(headerLines, renderMatchContext(details, contentWidth)...)
+	headerLines = append(headerLines, renderMatchContext(details, hidden, contentWidth)...)
 
 	// Penalties (prominent section)
-	if details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
+	if !hidden && details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
 		headerLines = append(headerLines, renderPenaltiesSection(details, contentWidth)...)
 	}
 
 	// For live matches, show live updates instead of event details
 	if details.Status == api.MatchStatusLive || details.Status == api.MatchStatusNotStarted {
+		if hidden {
+			cfg.LiveUpdates = hideSpoilerUpdates(cfg.LiveUpdates)
+		}
 		liveSection := renderLiveUpdatesSection(cfg, contentWidth)
 		scrollableLines = append(scrollableLines, liveSection)
 	} else {
@@ -105,29 +157,43 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 			scrollableLines = append(scrollableLines, neonValueStyle.Render(highlightLink))
 		}
 
-		// Goals section (with gradient)
-		goalsSection := renderGoalsSection(cfg, contentWidth)
-		if goalsSection != "" {
-			scrollableLines = append(scrollableLines, goalsSection)
-		}
+		if hidden {
+			// Goals and cards would give the result away; substitutions don't
+			scrollableLines = append(scrollableLines, "", renderSpoilerNote(contentWidth))
+			if subsSection := renderSubstitutionsSection(cfg, contentWidth); subsSection != "" {
+				scrollableLines = append(scrollableLines, subsSection)
+			}
+		} else if cfg.ShowTimeline {
+			// Single chronological timeline replaces the per-type sections
+			timelineSection := renderTimelineSection(cfg, contentWidth)
+			if timelineSection != "" {
+				scrollableLines = append(scrollableLines, timelineSection)
+			}
+		} else {
+			// Goals section (with gradient)
+			goalsSection := renderGoalsSection(cfg, contentWidth)
+			if goalsSection != "" {
+				scrollableLines = append(scrollableLines, goalsSection)
+			}
 
-		// Cards section
-		cardsSection := renderCardsSection(cfg, contentWidth)
-		if cardsSection != "" {
-			scrollableLines = append(scrollableLines, cardsSection)
-		}
+			// Cards section
+			cardsSection := renderCardsSection(cfg, contentWidth)
+			if cardsSection != "" {
+				scrollableLines = append(scrollableLines, cardsSection)
+			}
 
-		// Substitutions section
-		subsSection := renderSubstitutionsSection(cfg, contentWidth)
-		if subsSection != "" {
-			scrollableLines = append(scrollableLines, subsSection)
+			// Substitutions section
+			subsSection := renderSubstitutionsSection(cfg, contentWidth)
+			if subsSection != "" {
+				scrollableLines = append(scrollableLines, subsSection)
+			}
 		}
+	}
 
-		// Statistics section (stats view only)
-		if cfg.ShowStatistics && len(details.Statistics) > 0 {
-			statsSection := renderStatisticsSection(cfg, contentWidth, homeTeam, awayTeam)
-			scrollableLines = append(scrollableLines, statsSection)
-		}
+	// Statistics section (stats view and focused match view)
+	if cfg.ShowStatistics && len(details.Statistics) > 0 {
+		statsSection := renderStatisticsSection(cfg, contentWidth, homeTeam, awayTeam)
+		scrollableLines = append(scrollableLines, statsSection)
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, headerLines...),
@@ -141,20 +207,27 @@ func renderPanelHeader(title string, focused bool, width int) string {
 	return design.RenderHeaderDim(title, width)
 }
 
-func renderStatusLine(details *api.MatchDetails, contentWidth int) string {
+// renderStatusLine renders the match status and league. For live matches the fetched
+// minute is advanced by the time since liveClockSince, see EstimateLiveTime.
+func renderStatusLine(details *api.MatchDetails, liveClockSince time.Time, contentWidth int) string {
 	infoStyle := lipgloss.NewStyle().Foreground(neonDim)
 	var statusText string
 	switch details.Status {
 	case api.MatchStatusLive:
 		liveTime := constants.StatusLive
 		if details.LiveTime != nil {
-			liveTime = *details.LiveTime
+			liveTime = EstimateLiveTime(*details.LiveTime, liveClockSince, time.Now())
 		}
 		statusText = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(liveTime)
 	case api.MatchStatusFinished:
 		statusText = lipgloss.NewStyle().Foreground(neonCyan).Render(constants.StatusFinished)
+	case api.MatchStatusPostponed, api.MatchStatusAbandoned:
+		statusText = lipgloss.NewStyle().Foreground(neonYellow).Render(statusLabel(details.Status))
 	default:
 		statusText = infoStyle.Render(constants.StatusNotStartedShort)
+		if countdown := matchCountdown(details.Match); countdown != "" {
+			statusText += infoStyle.Render(" • " + countdown)
+		}
 	}
 
 	leagueText := infoStyle.Italic(true).Render(details.League.Name)
@@ -164,27 +237,45 @@ func renderStatusLine(details *api.MatchDetails, contentWidth int) string {
 		Render(statusText + " • " + leagueText)
 }
 
-func renderMatchContext(details *api.MatchDetails, contentWidth int) []string {
+// statusLabel returns the display label for statuses that never produce a normal result.
+// Returns empty string for all other statuses.
+func statusLabel(status api.MatchStatus) string {
+	switch status {
+	case api.MatchStatusPostponed:
+		return constants.StatusPostponed
+	case api.MatchStatusAbandoned:
+		return constants.StatusAbandoned
+	default:
+		return ""
+	}
+}
+
+// renderMatchContext renders the league, round, venue and other match facts.
+// With the score hidden, the half-time and aggregate scores are left out too.
+func renderMatchContext(details *api.MatchDetails, hidden bool, contentWidth int) []string {
 	var lines []string
 
 	if details.League.Name != "" {
 		lines = append(lines, neonLabelStyle.Render("League:      ")+neonValueStyle.Render(details.League.Name))
 	}
+	if round := formatRound(details); round != "" {
+		lines = append(lines, renderContextLine("Round:       ", round, contentWidth)...)
+	}
 	if details.Venue != "" {
-		lines = append(lines, neonLabelStyle.Render("Venue:       ")+neonValueStyle.Render(truncateString(details.Venue, contentWidth-14)))
+		lines = append(lines, renderContextLine("Venue:       ", details.Venue, contentWidth)...)
 	}
 	if details.MatchTime != nil {
-		lines = append(lines, neonLabelStyle.Render("Date:        ")+neonValueStyle.Render(details.MatchTime.Format("02 Jan 2006, 15:04")+" UTC"))
+		lines = append(lines, neonLabelStyle.Render("Date:        ")+neonValueStyle.Render(formatDisplayTime(*details.MatchTime, "02 Jan 2006, 15:04 MST")))
 	}
 	if details.Referee != "" {
-		lines = append(lines, neonLabelStyle.Render("Referee:     ")+neonValueStyle.Render(details.Referee))
+		lines = append(lines, renderContextLine("Referee:     ", details.Referee, contentWidth)...)
 	}
 	if details.Attendance > 0 {
 		lines = append(lines, neonLabelStyle.Render("Attendance:  ")+neonValueStyle.Render(formatNumber(details.Attendance)))
 	}
 
 	// Half-time score
-	if details.HalfTimeScore != nil && details.HalfTimeScore.Home != nil && details.HalfTimeScore.Away != nil {
+	if !hidden && details.HalfTimeScore != nil && details.HalfTimeScore.Home != nil && details.HalfTimeScore.Away != nil {
 		htText := fmt.Sprintf("HT: %d - %d", *details.HalfTimeScore.Home, *details.HalfTimeScore.Away)
 		lines = append(lines, neonLabelStyle.Render("Half-time:   ")+neonValueStyle.Render(htText))
 	}
@@ -194,6 +285,63 @@ func renderMatchContext(details *api.MatchDetails, contentWidth int) []string {
 		lines = append(lines, neonLabelStyle.Render("Duration:    ")+neonValueStyle.Render("After Extra Time"))
 	}
 
+	// Aggregate score (second leg of a two-legged tie)
+	if !hidden && details.Aggregate != nil {
+		lines = append(lines, neonLabelStyle.Render("Aggregate:   ")+neonValueStyle.Render(truncateString(formatAggregate(details.Aggregate), contentWidth-14)))
+	}
+
+	return lines
+}
+
+// renderSpoilerNote renders the note standing in for the goals and cards of a hidden match.
+func renderSpoilerNote(contentWidth int) string {
+	return lipgloss.NewStyle().
+		Foreground(neonDim).
+		Width(contentWidth).
+		Align(lipgloss.Center).
+		Render(constants.SpoilerHiddenNote)
+}
+
+// formatRound returns the round of a match, e.g. "Matchday 12". A knockout second leg is
+// told apart by its aggregate score: "Quarter-final, 2nd leg".
+func formatRound(details *api.MatchDetails) string {
+	round := details.Round
+	if round == "" || details.Aggregate == nil || strings.Contains(strings.ToLower(round), "leg") {
+		return round
+	}
+	return round + ", 2nd leg"
+}
+
+// formatAggregate formats an aggregate score, e.g. "4 - 3 (agg) · 1st leg 1 - 2".
+// A tiebreak note (e.g. away goals) is appended when the aggregate is level.
+func formatAggregate(agg *api.AggregateScore) string {
+	text := fmt.Sprintf("%d - %d (agg)", agg.Home, agg.Away)
+	if agg.FirstLeg != nil {
+		text += fmt.Sprintf(" · 1st leg %d - %d", agg.FirstLeg.Home, agg.FirstLeg.Away)
+	}
+	if agg.Note != "" {
+		text += " · " + agg.Note
+	}
+	return text
+}
+
+// contextValueMaxLines is how many lines a long venue or referee name may wrap onto.
+const contextValueMaxLines = 2
+
+// renderContextLine renders a labeled match context value, wrapping long values onto
+// continuation lines aligned under the value (up to contextValueMaxLines, then truncated).
+func renderContextLine(label, value string, contentWidth int) []string {
+	labelWidth := lipgloss.Width(label)
+	valueLines := wrapString(value, contentWidth-labelWidth-1, contextValueMaxLines)
+
+	lines := make([]string, len(valueLines))
+	for i, line := range valueLines {
+		prefix := strings.Repeat(" ", labelWidth)
+		if i == 0 {
+			prefix = neonLabelStyle.Render(label)
+		}
+		lines[i] = prefix + neonValueStyle.Render(line)
+	}
 	return lines
 }
 
@@ -226,7 +374,7 @@ func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	details := cfg.Details
 	var goals []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "goal" {
+		if event.Type == "goal" && cfg.Minutes.Contains(event) {
 			goals = append(goals, event)
 		}
 	}
@@ -237,41 +385,71 @@ func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 
 	var lines []string
 	lines = append(lines, "")
-	lines = append(lines, neonHeaderStyle.Render("Goals"))
+	lines = append(lines, neonHeaderStyle.Render(cfg.Minutes.sectionTitle("Goals")))
 
 	for _, goal := range goals {
-		player := "Unknown"
-		if goal.Player != nil {
-			player = *goal.Player
-		}
 		isHome := goal.Team.ID == details.HomeTeam.ID
+		minuteStr := eventMinuteString(goal)
+		goalContent := buildGoalEventContent(cfg, goal, isHome, eventSideWidth(minuteStr, contentWidth))
+		lines = append(lines, renderCenterAlignedEvent(minuteStr, goalContent, isHome, contentWidth))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
 
-		playerDetails := neonValueStyle.Render(player)
-		replayIndicator := getReplayIndicator(details, cfg.GoalLinks, goal.Minute)
+// goalAssistMinWidth is the narrowest an assist name is shown; with less room it is left out.
+const goalAssistMinWidth = 4
 
-		// Use gradient for GOAL or OWN GOAL label
-		label := "GOAL"
-		if goal.OwnGoal != nil && *goal.OwnGoal {
-			label = "OWN GOAL"
-		}
-		styledGoal := design.ApplyGradientToText(label)
-		goalContent := buildEventContent(playerDetails, replayIndicator, "●", styledGoal, isHome)
+// buildGoalEventContent returns the styled content for a goal event (no minute),
+// fitted to maxWidth.
+func buildGoalEventContent(cfg MatchDetailsConfig, goal api.MatchEvent, isHome bool, maxWidth int) string {
+	player := "Unknown"
+	if goal.Player != nil {
+		player = *goal.Player
+	}
 
-		minuteStr := goal.DisplayMinute
-		if minuteStr == "" {
-			minuteStr = fmt.Sprintf("%d'", goal.Minute)
+	replayIndicator := getReplayIndicator(cfg.Details, cfg.GoalLinks, goal.Minute)
+	styledGoal := design.ApplyGradientToText("GOAL")
+
+	// Own goals sit on the credited team's side, named after the conceding player
+	goalType := goalTypeMarker(goal.IsOwnGoal(), goal.IsPenalty())
+	thumbnail := playerThumbnail(cfg.PlayerPhotos, goal.PlayerID)
+
+	// Fit the names into what the symbol, label and replay link leave over.
+	// The assist shrinks (and is then dropped) before the scorer is truncated.
+	available := maxWidth - lipgloss.Width(buildEventContent("", replayIndicator, "●", styledGoal, isHome))
+	available -= lipgloss.Width(goalType) + lipgloss.Width(thumbnail)
+	player = truncateString(player, available)
+	playerDetails := thumbnail + neonValueStyle.Render(player) + goalType
+
+	if goal.Assist != nil && *goal.Assist != "" {
+		const assistPrefix, assistSuffix = " (assist: ", ")"
+		room := available - lipgloss.Width(player) - len(assistPrefix) - len(assistSuffix)
+		if room >= goalAssistMinWidth {
+			playerDetails += neonDimStyle.Render(assistPrefix + truncateString(*goal.Assist, room) + assistSuffix)
 		}
-		lines = append(lines, renderCenterAlignedEvent(minuteStr, goalContent, isHome, contentWidth))
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return buildEventContent(playerDetails, replayIndicator, "●", styledGoal, isHome)
+}
+
+// goalTypeMarker returns the styled " (o.g.)" or " (pen)" marker shown after a scorer,
+// or "" for open-play goals.
+func goalTypeMarker(ownGoal, penalty bool) string {
+	switch {
+	case ownGoal:
+		return neonOwnGoalStyle.Render(" (o.g.)")
+	case penalty:
+		return neonPenaltyStyle.Render(" (pen)")
+	}
+	return ""
 }
 
 func renderCardsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	details := cfg.Details
 	var cardEvents []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "card" {
+		if event.Type == "card" && cfg.Minutes.Contains(event) {
 			cardEvents = append(cardEvents, event)
 		}
 	}
@@ -282,40 +460,43 @@ func renderCardsSection(cfg MatchDetailsConfig, contentWidth int) string {
 
 	var lines []string
 	lines = append(lines, "")
-	lines = append(lines, neonHeaderStyle.Render("Cards"))
+	lines = append(lines, neonHeaderStyle.Render(cfg.Minutes.sectionTitle("Cards")))
 
 	for _, card := range cardEvents {
-		player := "Unknown"
-		if card.Player != nil {
-			player = *card.Player
-		}
 		isHome := card.Team.ID == details.HomeTeam.ID
+		cardContent := buildCardEventContent(card, isHome)
+		lines = append(lines, renderCenterAlignedEvent(eventMinuteString(card), cardContent, isHome, contentWidth))
+	}
 
-		cardSymbol := CardSymbolYellow
-		cardStyle := neonYellowCardStyle
-		if card.EventType != nil && (*card.EventType == "red" || *card.EventType == "redcard" || *card.EventType == "secondyellow") {
-			cardSymbol = CardSymbolRed
-			cardStyle = neonRedCardStyle
-		}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
 
-		playerDetails := neonValueStyle.Render(player)
-		cardContent := buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render("CARD"), isHome)
+// buildCardEventContent returns the styled content for a card event (no minute).
+func buildCardEventContent(card api.MatchEvent, isHome bool) string {
+	player := "Unknown"
+	if card.Player != nil {
+		player = *card.Player
+	}
 
-		minuteStr := card.DisplayMinute
-		if minuteStr == "" {
-			minuteStr = fmt.Sprintf("%d'", card.Minute)
-		}
-		lines = append(lines, renderCenterAlignedEvent(minuteStr, cardContent, isHome, contentWidth))
+	cardSymbol, label := CardSymbolYellow, "CARD"
+	cardStyle := neonYellowCardStyle
+	if card.IsSecondYellow() {
+		cardSymbol, label = secondYellowSymbol(), "2ND YELLOW"
+		cardStyle = neonRedCardStyle
+	} else if card.IsRedCard() {
+		cardSymbol = CardSymbolRed
+		cardStyle = neonRedCardStyle
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	playerDetails := neonValueStyle.Render(player)
+	return buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render(label), isHome)
 }
 
 func renderSubstitutionsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	details := cfg.Details
 	var subs []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "substitution" {
+		if event.Type == "substitution" && cfg.Minutes.Contains(event) {
 			subs = append(subs, event)
 		}
 	}
@@ -326,30 +507,100 @@ func renderSubstitutionsSection(cfg MatchDetailsConfig, contentWidth int) string
 
 	var lines []string
 	lines = append(lines, "")
-	lines = append(lines, neonHeaderStyle.Render("Substitutions"))
+	lines = append(lines, neonHeaderStyle.Render(cfg.Minutes.sectionTitle("Substitutions")))
 
 	for _, sub := range subs {
-		playerOut := ""
-		if sub.Player != nil {
-			playerOut = *sub.Player
+		isHome := sub.Team.ID == details.HomeTeam.ID
+		subContent := buildSubstitutionEventContent(sub, isHome)
+		lines = append(lines, renderCenterAlignedEvent(eventMinuteString(sub), subContent, isHome, contentWidth))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// buildSubstitutionEventContent returns the styled content for a substitution event (no minute).
+// Player holds the player going off and Assist the player coming on.
+func buildSubstitutionEventContent(sub api.MatchEvent, isHome bool) string {
+	playerOut := ""
+	if sub.Player != nil {
+		playerOut = *sub.Player
+	}
+	playerIn := ""
+	if sub.Assist != nil {
+		playerIn = *sub.Assist
+	}
+	return buildSubstitutionContent(playerIn, playerOut, isHome)
+}
+
+// renderTimelineSection renders goals, cards, substitutions and VAR decisions as a single chronological timeline.
+// Minutes run down a center spine with home events on the left and away events on the right.
+// Events sharing a minute keep a stable order by event ID.
+func renderTimelineSection(cfg MatchDetailsConfig, contentWidth int) string {
+	details := cfg.Details
+	var events []api.MatchEvent
+	for _, event := range details.Events {
+		switch event.Type {
+		case "goal", "card", "substitution", "var":
+			if cfg.Minutes.Contains(event) {
+				events = append(events, event)
+			}
 		}
-		playerIn := ""
-		if sub.Assist != nil {
-			playerIn = *sub.Assist
+	}
+
+	if len(events) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if ki, kj := events[i].SortKey(), events[j].SortKey(); ki != kj {
+			return ki < kj
+		}
+		return events[i].ID < events[j].ID
+	})
+
+	spine := lipgloss.NewStyle().
+		Foreground(neonDarkDim).
+		Width(contentWidth).
+		Align(lipgloss.Center).
+		Render("│")
+
+	var lines []string
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render(cfg.Minutes.sectionTitle("Timeline")))
+
+	for i, event := range events {
+		isHome := event.Team.ID == details.HomeTeam.ID
+		minuteStr := eventMinuteString(event)
+
+		var content string
+		switch event.Type {
+		case "goal":
+			content = buildGoalEventContent(cfg, event, isHome, eventSideWidth(minuteStr, contentWidth))
+		case "card":
+			content = buildCardEventContent(event, isHome)
+		case "var":
+			content = buildVAREventContent(event.VARDecision(), isHome)
+		default:
+			content = buildSubstitutionEventContent(event, isHome)
 		}
-		isHome := sub.Team.ID == details.HomeTeam.ID
-		subContent := buildSubstitutionContent(playerIn, playerOut, isHome)
 
-		minuteStr := sub.DisplayMinute
-		if minuteStr == "" {
-			minuteStr = fmt.Sprintf("%d'", sub.Minute)
+		if i > 0 {
+			lines = append(lines, spine)
 		}
-		lines = append(lines, renderCenterAlignedEvent(minuteStr, subContent, isHome, contentWidth))
+		lines = append(lines, renderCenterAlignedEvent(minuteStr, content, isHome, contentWidth))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// eventMinuteString returns the display minute for an event, e.g. "45+2'".
+func eventMinuteString(event api.MatchEvent) string {
+	if event.DisplayMinute != "" {
+		return event.DisplayMinute
+	}
+	return fmt.Sprintf("%d'", event.Minute)
+}
+
 func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam, awayTeam string) string {
 	details := cfg.Details
 	var lines []string
@@ -360,15 +611,22 @@ func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam,
 		patterns   []string
 		label      string
 		isProgress bool
+		mode       statMode
 	}{
-		{[]string{"possession", "ball possession", "ballpossesion"}, "Possession", true},
-		{[]string{"total_shots", "total shots"}, "Total Shots", false},
-		{[]string{"shots_on_target", "on target", "shotsontarget"}, "Shots on Target", false},
-		{[]string{"accurate_passes", "accurate passes"}, "Accurate Passes", false},
-		{[]string{"fouls", "fouls committed"}, "Fouls", false},
+		{[]string{"possession", "ball possession", "ballpossesion"}, "Possession", true, statPercent},
+		{[]string{"expected_goals", "expected goals"}, "Expected Goals (xG)", true, statRaw},
+		{[]string{"big_chance", "big chances"}, "Big Chances", true, statRaw},
+		{[]string{"total_shots", "total shots"}, "Total Shots", false, statRaw},
+		{[]string{"shots_on_target", "on target", "shotsontarget"}, "Shots on Target", false, statRaw},
+		{[]string{"accurate_passes", "accurate passes"}, "Accurate Passes", false, statRaw},
+		{[]string{"duel_won", "duels won"}, "Duels Won", false, statPercent},
+		{[]string{"tackles_succeeded", "tackles won", "tackles"}, "Tackles", false, statRatio},
+		{[]string{"fouls", "fouls committed"}, "Fouls", false, statRaw},
 	}
 
 	centerStyle := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center)
+	legendAt := len(lines)
+	hasBars := false
 
 	for _, wanted := range wantedStats {
 		for _, stat := range details.Statistics {
@@ -386,29 +644,45 @@ func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam,
 			if matched {
 				lines = append(lines, "")
 				if wanted.isProgress {
-					statLine := renderStatProgressBar(wanted.label, stat.HomeValue, stat.AwayValue, contentWidth, homeTeam, awayTeam)
+					statLine := renderStatProgressBar(wanted.label, stat.HomeValue, stat.AwayValue, wanted.mode, cfg.StatBarsFillAway)
 					lines = append(lines, centerStyle.Render(statLine))
+					hasBars = true
 				} else {
-					statLine := renderStatComparison(wanted.label, stat.HomeValue, stat.AwayValue, contentWidth)
+					statLine := renderStatComparison(wanted.label, stat.HomeValue, stat.AwayValue, wanted.mode, contentWidth)
 					lines = append(lines, centerStyle.Render(statLine))
 				}
 				break
 			}
 		}
 	}
+	if hasBars {
+		lines = slices.Insert(lines, legendAt, "", centerStyle.Render(renderStatLegend(homeTeam, awayTeam)))
+	}
+
+	// Momentum over the match, when FotMob provides the series
+	if sparkline := renderMomentumSparkline(details.MomentumSeries, min(contentWidth-4, momentumMaxWidth)); sparkline != "" {
+		lines = append(lines, "")
+		lines = append(lines, centerStyle.Render(sparkline))
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderLiveUpdatesSection renders the live updates newest first, so the latest stay visible
+// at the top; the app caps how many are kept. Collapsed, only the title and count are shown.
 func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	var lines []string
 
+	var suffix string
+	if cfg.UpdatesCollapsed {
+		suffix = fmt.Sprintf(constants.PanelUpdatesCount, len(cfg.LiveUpdates))
+	}
+	titleWidth := cfg.Width - 6
 	var titleText string
 	if cfg.IsPolling && cfg.Loading && cfg.PollingSpinner != nil {
-		pollingView := cfg.PollingSpinner.View()
-		titleText = "Updating...  " + pollingView
+		titleText = pollingTitle(cfg.PollingSpinner, titleWidth, suffix)
 	} else {
-		titleText = constants.PanelUpdates
+		titleText = truncateString(constants.PanelUpdates+suffix, titleWidth)
 	}
 
 	updatesTitle := lipgloss.NewStyle().
@@ -418,10 +692,13 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 		BorderBottom(true).
 		BorderStyle(lipgloss.NormalBorder()).
 		BorderForeground(neonDarkDim).
-		Width(cfg.Width - 6).
+		Width(titleWidth).
 		Render(titleText)
 	lines = append(lines, updatesTitle)
 
+	if cfg.UpdatesCollapsed {
+		return updatesTitle
+	}
 	if len(cfg.LiveUpdates) == 0 && !cfg.Loading && !cfg.IsPolling {
 		emptyUpdates := lipgloss.NewStyle().
 			Foreground(neonDim).
@@ -438,30 +715,92 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// PollingSpinnerWidth is the width of the polling spinner in the updates title when it fits.
+const PollingSpinnerWidth = 10
+
+// minPollingSpinnerWidth is the narrowest the polling spinner is still recognisable at.
+const minPollingSpinnerWidth = 3
+
+// pollingTitle returns the updates title while a poll is in flight, fitting width so the
+// title never wraps: the spinner shrinks first, then the text is dropped to keep the spinner.
+func pollingTitle(spinner *RandomCharSpinner, width int, suffix string) string {
+	room := width - lipgloss.Width(suffix)
+	if spinnerWidth := min(PollingSpinnerWidth, room-lipgloss.Width(constants.UpdatingTitle)); spinnerWidth >= minPollingSpinnerWidth {
+		spinner.SetWidth(spinnerWidth)
+		return constants.UpdatingTitle + spinner.View() + suffix
+	}
+	if room < minPollingSpinnerWidth {
+		return truncateString(constants.PanelUpdates+suffix, width)
+	}
+	spinner.SetWidth(min(PollingSpinnerWidth, room))
+	return spinner.View() + suffix
+}
+
 // Statistics rendering functions
 
 const statBarWidth = 20
 
-func renderStatProgressBar(label, homeVal, awayVal string, maxWidth int, homeTeam, awayTeam string) string {
-	homePercent := parsePercent(homeVal)
-	awayPercent := parsePercent(awayVal)
+// statBarValueWidth is the width of the values either side of a stat bar ("100%", "1.84").
+const statBarValueWidth = 4
+
+// renderStatFill renders the bar of a percentage stat in the theme's gradient, filled
+// with the home share from the left or, with fillAway, the away share from the right.
+func renderStatFill(homePercent, awayPercent int, fillAway bool) string {
+	startHex, endHex := design.AdaptiveGradientColors()
+	startColor, endColor := design.GradientColors(startHex, endHex)
+	newBar := func(width int) progress.Model {
+		return progress.New(
+			progress.WithScaledGradient(startColor.Hex(), endColor.Hex()),
+			progress.WithWidth(width),
+			progress.WithoutPercentage(),
+		)
+	}
 
-	total := homePercent + awayPercent
-	if total > 0 && total != 100 {
-		homePercent = (homePercent * 100) / total
-		awayPercent = 100 - homePercent
+	if !fillAway {
+		return newBar(statBarWidth).ViewAs(float64(homePercent) / 100.0)
+	}
+	// progress only fills from the left: render the empty part, then a fully filled bar
+	filled := int(math.Round(float64(statBarWidth*awayPercent) / 100.0))
+	empty := newBar(statBarWidth - filled).ViewAs(0)
+	if filled == 0 {
+		return empty
 	}
+	return empty + newBar(filled).ViewAs(1)
+}
 
-	prog := progress.New(
-		progress.WithScaledGradient("#00FFFF", "#FF0055"),
-		progress.WithWidth(statBarWidth),
-		progress.WithoutPercentage(),
-	)
+// renderStatLegend names the team at each end of the stat bars' gradient, with a swatch
+// of its color: "■ Arsenal  vs  Chelsea ■".
+func renderStatLegend(homeTeam, awayTeam string) string {
+	startHex, endHex := design.AdaptiveGradientColors()
+	homeSwatch := lipgloss.NewStyle().Foreground(lipgloss.Color(startHex)).Render("■")
+	awaySwatch := lipgloss.NewStyle().Foreground(lipgloss.Color(endHex)).Render("■")
+	return homeSwatch + " " + neonValueStyle.Render(homeTeam) + neonDimStyle.Render("  vs  ") +
+		neonValueStyle.Render(awayTeam) + " " + awaySwatch
+}
 
-	progressView := prog.ViewAs(float64(homePercent) / 100.0)
+// renderStatProgressBar renders a stat as one bar split by each side's share, e.g.
+// possession or xG. In statPercent mode the values are shown as the shares; otherwise
+// as given. The team colors are explained once by the legend above the statistics.
+func renderStatProgressBar(label, homeVal, awayVal string, mode statMode, fillAway bool) string {
+	home, away := parseStatValue(homeVal), parseStatValue(awayVal)
+	var homePercent, awayPercent int
+	if total := home + away; total > 0 {
+		homePercent = int(math.Round(home * 100 / total))
+		awayPercent = 100 - homePercent
+	}
+	if mode == statPercent {
+		homeVal, awayVal = fmt.Sprintf("%d%%", homePercent), fmt.Sprintf("%d%%", awayPercent)
+	}
 
-	homeValStyled := neonValueStyle.Render(fmt.Sprintf("%3d%%", homePercent))
-	awayValStyled := neonDimStyle.Render(fmt.Sprintf("%3d%%", awayPercent))
+	progressView := renderStatFill(homePercent, awayPercent, fillAway)
+
+	// The filled side's value stands out
+	homeStyle, awayStyle := neonValueStyle, neonDimStyle
+	if fillAway {
+		homeStyle, awayStyle = neonDimStyle, neonValueStyle
+	}
+	homeValStyled := homeStyle.Render(padLeft(homeVal, statBarValueWidth))
+	awayValStyled := awayStyle.Render(padRight(awayVal, statBarValueWidth))
 
 	labelStyle := lipgloss.NewStyle().Foreground(neonDim)
 	labelLine := labelStyle.Render(label)
@@ -470,9 +809,131 @@ func renderStatProgressBar(label, homeVal, awayVal string, maxWidth int, homeTea
 	return labelLine + "\n" + barLine
 }
 
-func renderStatComparison(label, homeVal, awayVal string, maxWidth int) string {
-	homeNum := parseNumber(homeVal)
-	awayNum := parseNumber(awayVal)
+// momentumMaxWidth caps the sparkline width so it lines up with the stat bars.
+const momentumMaxWidth = 2*statBarWidth + 22
+
+// Block glyphs for the momentum sparkline, by fill level (eighths).
+var (
+	momentumUpBlocks   = []string{" ", "▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+	momentumDownBlocks = []string{" ", "▔", "▔", "▀", "▀", "▀", "█", "█", "█"}
+)
+
+// renderMomentumSparkline draws a momentum series (home share, 0-100) as a two-row sparkline
+// centered on 50%: bars rising above the center line favor home, bars hanging below favor away.
+// The series is averaged down to at most width columns. Returns "" for an empty series.
+func renderMomentumSparkline(series []float64, width int) string {
+	if len(series) == 0 || width <= 0 {
+		return ""
+	}
+
+	columns := min(len(series), width)
+	var home, away strings.Builder
+	for col := range columns {
+		// Average the slice of the series that falls into this column
+		start := col * len(series) / columns
+		end := max((col+1)*len(series)/columns, start+1)
+		var sum float64
+		for _, v := range series[start:end] {
+			sum += v
+		}
+		delta := sum/float64(end-start) - 50
+
+		// Scale the distance from 50% (0-50) to eighths of a cell
+		level := min(int(math.Round(math.Abs(delta)*8/50)), 8)
+		if delta >= 0 {
+			home.WriteString(momentumUpBlocks[level])
+			away.WriteString(" ")
+		} else {
+			home.WriteString(" ")
+			away.WriteString(momentumDownBlocks[level])
+		}
+	}
+
+	labelLine := lipgloss.NewStyle().Foreground(neonDim).Render("Momentum")
+	homeLine := lipgloss.NewStyle().Foreground(neonCyan).Render(home.String())
+	awayLine := lipgloss.NewStyle().Foreground(neonGray).Render(away.String())
+
+	return labelLine + "\n" + homeLine + "\n" + awayLine
+}
+
+// pressureBarHalf is the width of each side of the live pressure bar, in cells.
+const pressureBarHalf = 10
+
+// renderPressureBar draws which side is on top right now (momentum from -1, away, to 1, home)
+// as a single line: the bar fills from the center toward the home side on the left or the
+// away side on the right. Returns "" when the width leaves no room for it.
+func renderPressureBar(momentum float64, contentWidth int) string {
+	label := "Pressure "
+	half := min(pressureBarHalf, (contentWidth-lipgloss.Width(label)-1)/2)
+	if half < 3 {
+		return ""
+	}
+
+	level := int(math.Round(math.Abs(max(min(momentum, 1), -1)) * float64(half)))
+	trackStyle := lipgloss.NewStyle().Foreground(neonDarkDim)
+	homeSide := trackStyle.Render(strings.Repeat("░", half))
+	awaySide := homeSide
+	if momentum > 0 {
+		homeSide = trackStyle.Render(strings.Repeat("░", half-level)) +
+			lipgloss.NewStyle().Foreground(neonCyan).Render(strings.Repeat("█", level))
+	} else if momentum < 0 {
+		awaySide = lipgloss.NewStyle().Foreground(neonGray).Render(strings.Repeat("█", level)) +
+			trackStyle.Render(strings.Repeat("░", half-level))
+	}
+
+	center := lipgloss.NewStyle().Foreground(neonDim).Render("┃")
+	return lipgloss.NewStyle().Foreground(neonDim).Render(label) + homeSide + center + awaySide
+}
+
+// statMode says how a statistic's two values are compared.
+type statMode int
+
+const (
+	statRaw     statMode = iota // Values as given, bars sized by count
+	statPercent                 // Each side's share of the combined count, e.g. duels won
+	statRatio                   // Count with a success rate, e.g. "12 (60%)"; bars sized by the rate
+)
+
+// statComparisonValues returns the values shown for a statistic in mode and the numbers
+// its bars are sized by. Values a mode can't read are shown raw.
+func statComparisonValues(homeVal, awayVal string, mode statMode) (homeText, awayText string, homeNum, awayNum int) {
+	switch mode {
+	case statPercent:
+		if strings.HasSuffix(homeVal, "%") && strings.HasSuffix(awayVal, "%") {
+			// Already shares
+			return homeVal, awayVal, parsePercent(homeVal), parsePercent(awayVal)
+		}
+		home, away := parseNumber(homeVal), parseNumber(awayVal)
+		if total := home + away; total > 0 {
+			homeShare := (home*100 + total/2) / total
+			return fmt.Sprintf("%d%%", homeShare), fmt.Sprintf("%d%%", 100-homeShare), homeShare, 100 - homeShare
+		}
+	case statRatio:
+		homeRate, homeOK := parseRate(homeVal)
+		awayRate, awayOK := parseRate(awayVal)
+		if homeOK && awayOK {
+			return homeVal, awayVal, homeRate, awayRate
+		}
+	}
+	return homeVal, awayVal, parseNumber(homeVal), parseNumber(awayVal)
+}
+
+// parseRate returns the percentage in parentheses of a value like "12 (60%)".
+func parseRate(s string) (int, bool) {
+	_, rest, ok := strings.Cut(s, "(")
+	if !ok {
+		return 0, false
+	}
+	rate, _, ok := strings.Cut(rest, "%")
+	if !ok {
+		return 0, false
+	}
+	val, err := strconv.Atoi(strings.TrimSpace(rate))
+	return val, err == nil
+}
+
+func renderStatComparison(label, homeVal, awayVal string, mode statMode, maxWidth int) string {
+	homeVal, awayVal, homeNum, awayNum := statComparisonValues(homeVal, awayVal, mode)
 
 	homeStyle := neonValueStyle
 	awayStyle := neonValueStyle
@@ -501,10 +962,10 @@ func renderStatComparison(label, homeVal, awayVal string, maxWidth int) string {
 	labelStyle := lipgloss.NewStyle().Foreground(neonDim)
 	labelLine := labelStyle.Render(label)
 	barLine := fmt.Sprintf("%s %s %s %s",
-		homeStyle.Render(fmt.Sprintf("%10s", homeVal)),
+		homeStyle.Render(padLeft(homeVal, 10)),
 		homeBarStyled,
 		awayBarStyled,
-		awayStyle.Render(fmt.Sprintf("%-10s", awayVal)))
+		awayStyle.Render(padRight(awayVal, 10)))
 
 	return labelLine + "\n" + barLine
 }
@@ -536,14 +997,80 @@ func parseNumber(s string) int {
 	return val
 }
 
+// parseStatValue reads the number a stat value starts with: "58%", "1.84" or "12 (60%)".
+// Values it can't read are zero.
+func parseStatValue(s string) float64 {
+	s = strings.TrimSpace(s)
+	if idx := strings.IndexAny(s, " (%"); idx > 0 {
+		s = s[:idx]
+	}
+	val, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+// padLeft right-aligns s in width terminal cells. Unlike fmt's %10s, which counts bytes,
+// it measures display width, so names like "Ødegaard" line up with plain ASCII ones.
+func padLeft(s string, width int) string {
+	return strings.Repeat(" ", max(0, width-lipgloss.Width(s))) + s
+}
+
+// padRight left-aligns s in width terminal cells, measuring display width like padLeft.
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-lipgloss.Width(s)))
+}
+
+// truncateString shortens s to at most maxLen terminal cells, ending in "...".
+// Measures display width rather than bytes, so multibyte and wide runes are never split.
 func truncateString(s string, maxLen int) string {
 	if maxLen <= 3 {
 		return s
 	}
-	if len(s) <= maxLen {
+	if lipgloss.Width(s) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		w := lipgloss.Width(string(r))
+		if width+w > maxLen-3 {
+			break
+		}
+		b.WriteRune(r)
+		width += w
+	}
+	return b.String() + "..."
+}
+
+// wrapString word-wraps s to lines of at most width terminal cells.
+// Returns at most maxLines lines; the last one is truncated if text remains.
+// Words longer than a line are truncated rather than split.
+func wrapString(s string, width, maxLines int) []string {
+	if width <= 3 || maxLines <= 0 || lipgloss.Width(s) <= width {
+		return []string{truncateString(s, width)}
+	}
+
+	words := strings.Fields(s)
+	var lines []string
+	for len(words) > 0 {
+		// Last allowed line takes the remaining text
+		if len(lines) == maxLines-1 {
+			lines = append(lines, truncateString(strings.Join(words, " "), width))
+			break
+		}
+
+		line, n := words[0], 1
+		for n < len(words) && lipgloss.Width(line+" "+words[n]) <= width {
+			line += " " + words[n]
+			n++
+		}
+		lines = append(lines, truncateString(line, width))
+		words = words[n:]
+	}
+	return lines
 }
 
 func formatNumber(n int) string {
@@ -0,0 +1,351 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTruncateStringMultibyte(t *testing.T) {
+	tests := []struct {
+		in     string
+		maxLen int
+		want   string
+	}{
+		{"Estádio do Dragão", 30, "Estádio do Dragão"},
+		{"Estádio do Dragão", 17, "Estádio do Dragão"},
+		{"Estádio do Dragão", 10, "Estádio..."},
+		{"Estádio do Dragão", 7, "Está..."},
+		{"Allianz Arena", 3, "Allianz Arena"}, // Too narrow to truncate
+	}
+
+	for _, tt := range tests {
+		got := truncateString(tt.in, tt.maxLen)
+		if got != tt.want {
+			t.Errorf("truncateString(%q, %d) = %q, want %q", tt.in, tt.maxLen, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("truncateString(%q, %d) = %q is not valid UTF-8", tt.in, tt.maxLen, got)
+		}
+	}
+}
+
+func TestWrapString(t *testing.T) {
+	got := wrapString("Estádio Cícero Pompeu de Toledo Morumbi", 16, 2)
+	want := []string{"Estádio Cícero", "Pompeu de Tol..."}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("wrapString() = %q, want %q", got, want)
+	}
+
+	for _, line := range wrapString("Estádio José Alvalade São João do Estoril", 12, 3) {
+		if !utf8.ValidString(line) || lipgloss.Width(line) > 12 {
+			t.Errorf("wrapString() line %q is broken or wider than 12 cells", line)
+		}
+	}
+
+	if got := wrapString("Anfield", 20, 2); len(got) != 1 || got[0] != "Anfield" {
+		t.Errorf("wrapString(short) = %q, want [Anfield]", got)
+	}
+}
+
+func TestBuildGoalEventContentAssist(t *testing.T) {
+	scorer, assist := "Watkins", "McGinn"
+	goal := api.MatchEvent{Type: "goal", Minute: 56, Player: &scorer, Assist: &assist}
+	cfg := MatchDetailsConfig{Details: &api.MatchDetails{}}
+
+	wide := buildGoalEventContent(cfg, goal, true, 60)
+	if !strings.Contains(wide, "Watkins (assist: McGinn)") {
+		t.Errorf("wide goal line = %q, want scorer with assist", wide)
+	}
+
+	// Tight: the assist is truncated first...
+	tight := buildGoalEventContent(cfg, goal, true, 30)
+	if !strings.Contains(tight, "Watkins (assist: Mc...)") || lipgloss.Width(tight) > 30 {
+		t.Errorf("tight goal line = %q (width %d), want truncated assist within 30", tight, lipgloss.Width(tight))
+	}
+
+	// ...then dropped, keeping the scorer
+	narrow := buildGoalEventContent(cfg, goal, true, 20)
+	if strings.Contains(narrow, "assist") || !strings.Contains(narrow, "Watkins") {
+		t.Errorf("narrow goal line = %q, want scorer without assist", narrow)
+	}
+}
+
+func TestBuildGoalEventContentGoalType(t *testing.T) {
+	cfg := MatchDetailsConfig{Details: &api.MatchDetails{}}
+	scorer, defender := "Dybala", "Mancini"
+	ownGoal := true
+
+	penalty := api.MatchEvent{Type: "goal", Player: &scorer, GoalType: api.GoalTypePenalty}
+	if got := buildGoalEventContent(cfg, penalty, true, 60); !strings.Contains(got, "Dybala (pen)") {
+		t.Errorf("penalty goal line = %q, want scorer with (pen)", got)
+	}
+
+	// Own goals may only carry the legacy flag
+	og := api.MatchEvent{Type: "goal", Player: &defender, OwnGoal: &ownGoal}
+	if got := buildGoalEventContent(cfg, og, true, 60); !strings.Contains(got, "Mancini (o.g.)") {
+		t.Errorf("own goal line = %q, want conceding player with (o.g.)", got)
+	}
+
+	// The marker is kept when the scorer has to be truncated
+	narrow := buildGoalEventContent(cfg, penalty, true, 18)
+	if !strings.Contains(narrow, "(pen)") || lipgloss.Width(narrow) > 18 {
+		t.Errorf("narrow penalty line = %q (width %d), want (pen) within 18", narrow, lipgloss.Width(narrow))
+	}
+}
+
+func TestStatsPanelShowsRefereeAndAttendance(t *testing.T) {
+	home, away := 2, 1
+	details := &api.MatchDetails{
+		Match: api.Match{
+			ID:        1,
+			HomeTeam:  api.Team{Name: "Arsenal"},
+			AwayTeam:  api.Team{Name: "Chelsea"},
+			HomeScore: &home,
+			AwayScore: &away,
+			Status:    api.MatchStatusFinished,
+		},
+		Referee:    "Michael Oliver",
+		Attendance: 60272,
+	}
+
+	header, _ := renderStatsMatchDetailsPanel(80, 40, StatsViewConfig{Details: details})
+	for _, want := range []string{"Referee:", "Michael Oliver", "Attendance:", "60,272"} {
+		if !strings.Contains(header, want) {
+			t.Errorf("stats panel header is missing %q", want)
+		}
+	}
+
+	details.Referee, details.Attendance = "", 0
+	header, _ = renderStatsMatchDetailsPanel(80, 40, StatsViewConfig{Details: details})
+	for _, unwanted := range []string{"Referee:", "Attendance:"} {
+		if strings.Contains(header, unwanted) {
+			t.Errorf("stats panel header shows %q without data", unwanted)
+		}
+	}
+}
+
+func TestMatchContextRound(t *testing.T) {
+	details, err := data.MockFinishedMatchDetails(1012) // Napoli-Roma, a Round of 16 second leg
+	if err != nil || details == nil {
+		t.Fatalf("MockFinishedMatchDetails(1012) = %v, %v", details, err)
+	}
+	if got := strings.Join(renderMatchContext(details, false, 80), "\n"); !strings.Contains(got, "Round:") || !strings.Contains(got, "Round of 16, 2nd leg") {
+		t.Errorf("match context = %q, want the round with its leg", got)
+	}
+
+	tests := []struct {
+		round     string
+		aggregate *api.AggregateScore
+		want      string
+	}{
+		{"Matchday 12", nil, "Matchday 12"},
+		{"Quarter-final", &api.AggregateScore{Home: 2, Away: 1}, "Quarter-final, 2nd leg"},
+		{"Round of 16 - 1st Leg", nil, "Round of 16 - 1st Leg"},
+		{"", nil, ""},
+	}
+	for _, tt := range tests {
+		details := &api.MatchDetails{Match: api.Match{Round: tt.round}, Aggregate: tt.aggregate}
+		if got := formatRound(details); got != tt.want {
+			t.Errorf("formatRound(%q) = %q, want %q", tt.round, got, tt.want)
+		}
+	}
+	if got := strings.Join(renderMatchContext(&api.MatchDetails{}, false, 80), "\n"); strings.Contains(got, "Round:") {
+		t.Errorf("match context without a round = %q, want no Round line", got)
+	}
+}
+
+func TestStatComparisonValues(t *testing.T) {
+	tests := []struct {
+		name               string
+		home, away         string
+		mode               statMode
+		wantHomeText       string
+		wantAwayText       string
+		wantHome, wantAway int
+	}{
+		{"raw counts", "14", "8", statRaw, "14", "8", 14, 8},
+		{"duel shares from counts", "31", "25", statPercent, "55%", "45%", 55, 45},
+		{"shares as given", "58%", "42%", statPercent, "58%", "42%", 58, 42},
+		{"no duels yet", "0", "0", statPercent, "0", "0", 0, 0},
+		{"tackles by success rate", "12 (57%)", "9 (45%)", statRatio, "12 (57%)", "9 (45%)", 57, 45},
+		{"tackles without a rate", "12", "9", statRatio, "12", "9", 12, 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			homeText, awayText, home, away := statComparisonValues(tt.home, tt.away, tt.mode)
+			if homeText != tt.wantHomeText || awayText != tt.wantAwayText || home != tt.wantHome || away != tt.wantAway {
+				t.Errorf("statComparisonValues(%q, %q) = %q, %q, %d, %d; want %q, %q, %d, %d",
+					tt.home, tt.away, homeText, awayText, home, away, tt.wantHomeText, tt.wantAwayText, tt.wantHome, tt.wantAway)
+			}
+		})
+	}
+}
+
+func TestStatFillDirection(t *testing.T) {
+	if got := renderStatFill(70, 30, false); !strings.HasPrefix(got, strings.Repeat("█", 14)) {
+		t.Errorf("home fill = %q, want 14 filled cells on the left", got)
+	}
+
+	got := renderStatFill(70, 30, true)
+	if !strings.HasPrefix(got, strings.Repeat("░", 14)) || !strings.HasSuffix(got, strings.Repeat("█", 6)) {
+		t.Errorf("away fill = %q, want 6 filled cells on the right", got)
+	}
+	if line := renderStatProgressBar("Possession", "70", "30", statPercent, true); !strings.Contains(line, " 70% ") || !strings.Contains(line, " 30%") {
+		t.Errorf("stat bar = %q, want the percentages either side", line)
+	}
+}
+
+func TestStatProgressBarShares(t *testing.T) {
+	// Counts and decimals are split by share but shown as given, aligned around the bar
+	xg := renderStatProgressBar("Expected Goals (xG)", "1.5", "0.5", statRaw, false)
+	chances := renderStatProgressBar("Big Chances", "3", "1", statRaw, false)
+	if bar := strings.Split(xg, "\n")[1]; !strings.HasPrefix(bar, " 1.5 "+strings.Repeat("█", 15)) || !strings.HasSuffix(bar, " 0.5 ") {
+		t.Errorf("xG bar = %q, want 15 of 20 cells filled between the values", bar)
+	}
+	if a, b := lipgloss.Width(strings.Split(xg, "\n")[1]), lipgloss.Width(strings.Split(chances, "\n")[1]); a != b {
+		t.Errorf("bar widths = %d and %d, want them aligned", a, b)
+	}
+	if got := parseStatValue("12 (60%)"); got != 12 {
+		t.Errorf("parseStatValue(\"12 (60%%)\") = %v, want 12", got)
+	}
+}
+
+func TestStatisticsLegend(t *testing.T) {
+	details, _ := data.MockFinishedMatchDetails(1001)
+	section := renderStatisticsSection(MatchDetailsConfig{Details: details}, 80, "Man City", "Arsenal")
+	if strings.Count(section, "Man City") != 1 || !strings.Contains(section, "■ Man City  vs  Arsenal ■") {
+		t.Errorf("statistics = %q, want one legend naming both teams", section)
+	}
+	if !strings.Contains(section, "Big Chances") || !strings.Contains(section, "Expected Goals (xG)") {
+		t.Errorf("statistics = %q, want the xG and big chances bars", section)
+	}
+}
+
+func TestCenteredEventPadding(t *testing.T) {
+	for _, isHome := range []bool{true, false} {
+		line := renderCenterAlignedEvent("90+4'", "Ødegaard", isHome, 41)
+		before, after, ok := strings.Cut(line, "90+4'")
+		if !ok {
+			t.Fatalf("event line %q has no minute", line)
+		}
+		if lipgloss.Width(before) != lipgloss.Width(after) {
+			t.Errorf("home=%v: padding %d cells before the minute, %d after; want symmetric", isHome, lipgloss.Width(before), lipgloss.Width(after))
+		}
+	}
+
+	if got := padLeft("Ødegaard", 10); got != "  Ødegaard" {
+		t.Errorf("padLeft = %q, want two spaces of padding", got)
+	}
+	if got := padRight("Ødegaard", 10); got != "Ødegaard  " {
+		t.Errorf("padRight = %q, want two spaces of padding", got)
+	}
+	dialog := &FormationsDialog{}
+	for _, name := range []string{"Gündoğan", "Martin Ødegaard"} {
+		line := dialog.renderPlayerLine(api.PlayerInfo{Name: name, Number: 8, Position: "MF"}, 22, true)
+		if !utf8.ValidString(line) || lipgloss.Width(line) != lipgloss.Width(dialog.renderPlayerLine(api.PlayerInfo{Name: "Saka", Number: 7, Position: "FW"}, 22, true)) {
+			t.Errorf("formation line for %q = %q, want valid UTF-8 as wide as an ASCII name", name, line)
+		}
+	}
+	home := strings.Split(renderStatComparison("Player", "Ødegaard", "Saka", statRaw, 80), "\n")[1]
+	away := strings.Split(renderStatComparison("Player", "Saka", "Ødegaard", statRaw, 80), "\n")[1]
+	if lipgloss.Width(home) != lipgloss.Width(away) {
+		t.Errorf("stat rows are %d and %d cells wide, want equal", lipgloss.Width(home), lipgloss.Width(away))
+	}
+}
+
+func TestLiveUpdatesCollapsed(t *testing.T) {
+	cfg := MatchDetailsConfig{
+		Width:       60,
+		Details:     &api.MatchDetails{Match: api.Match{Status: api.MatchStatusLive}},
+		LiveUpdates: []string{"● 12' [GOAL] Saka (H)", "▪ 30' [CARD] Rice (H)"},
+	}
+
+	cfg.UpdatesCollapsed = true
+	got := renderLiveUpdatesSection(cfg, 54)
+	if !strings.Contains(got, "Updates (2 updates)") || strings.Contains(got, "Saka") {
+		t.Errorf("collapsed section = %q, want only the title with the count", got)
+	}
+
+	cfg.UpdatesCollapsed = false
+	if got := renderLiveUpdatesSection(cfg, 54); !strings.Contains(got, "Saka") || strings.Contains(got, "(2 updates)") {
+		t.Errorf("expanded section = %q, want the updates without the count", got)
+	}
+}
+
+func TestAdvancesMarker(t *testing.T) {
+	home, away := 1, 0
+	roma := 20
+	details := &api.MatchDetails{
+		Match: api.Match{
+			Status:    api.MatchStatusFinished,
+			HomeTeam:  api.Team{ID: 10, ShortName: "Napoli"},
+			AwayTeam:  api.Team{ID: 20, ShortName: "Roma"},
+			HomeScore: &home,
+			AwayScore: &away,
+		},
+		Aggregate: &api.AggregateScore{Home: 1, Away: 1},
+		Advanced:  &roma,
+	}
+
+	header, _ := RenderMatchDetails(MatchDetailsConfig{Width: 80, Height: 40, Details: details})
+	if !strings.Contains(header, "Roma "+constants.AdvancesMarker) || strings.Contains(header, "Napoli "+constants.AdvancesMarker) {
+		t.Errorf("header should mark Roma as through:\n%s", header)
+	}
+
+	details.Advanced = nil
+	if header, _ := RenderMatchDetails(MatchDetailsConfig{Width: 80, Height: 40, Details: details}); strings.Contains(header, constants.AdvancesMarker) {
+		t.Errorf("header should have no marker for an undecided tie:\n%s", header)
+	}
+}
+
+func TestPollingTitleFits(t *testing.T) {
+	cfg := MatchDetailsConfig{
+		Details:        &api.MatchDetails{Match: api.Match{Status: api.MatchStatusLive}},
+		IsPolling:      true,
+		Loading:        true,
+		PollingSpinner: NewRandomCharSpinner(),
+	}
+
+	for _, width := range []int{60, 26, 18, 12} {
+		cfg.Width = width
+		title := renderLiveUpdatesSection(cfg, width-6)
+		if lines := strings.Split(title, "\n"); len(lines) != 2 || lipgloss.Width(lines[0]) > width-6 {
+			t.Errorf("width %d: title = %q, want one line of at most %d cells above its border", width, title, width-6)
+		}
+	}
+
+	cfg.Width = 60
+	if title := renderLiveUpdatesSection(cfg, 54); !strings.Contains(title, constants.UpdatingTitle) {
+		t.Errorf("wide title = %q, want the updating text", title)
+	}
+	cfg.Width = 18
+	if title := renderLiveUpdatesSection(cfg, 12); strings.Contains(title, "Updating") {
+		t.Errorf("narrow title = %q, want only the spinner", title)
+	}
+}
+
+func TestRenderPressureBar(t *testing.T) {
+	tests := []struct {
+		momentum float64
+		want     string
+	}{
+		{0.5, "Pressure ░░░░░█████┃░░░░░░░░░░"},
+		{-1.4, "Pressure ░░░░░░░░░░┃██████████"},
+		{0, "Pressure ░░░░░░░░░░┃░░░░░░░░░░"},
+	}
+	for _, tt := range tests {
+		if got := renderPressureBar(tt.momentum, 60); got != tt.want {
+			t.Errorf("renderPressureBar(%v) = %q, want %q", tt.momentum, got, tt.want)
+		}
+	}
+
+	if got := renderPressureBar(0.5, 12); got != "" {
+		t.Errorf("renderPressureBar() in 12 columns = %q, want nothing", got)
+	}
+}
@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// otherRegion labels groups for leagues missing from data.AllSupportedLeagues.
+const otherRegion = "Other"
+
+// MatchGroupHeaderItem is a non-selectable list row heading the matches of one competition.
+type MatchGroupHeaderItem struct {
+	Region string
+	League string
+	Count  int
+}
+
+// Title returns the competition name.
+func (h MatchGroupHeaderItem) Title() string {
+	return h.League
+}
+
+// Description returns the region and match count.
+func (h MatchGroupHeaderItem) Description() string {
+	return fmt.Sprintf("%s · %d matches", h.Region, h.Count)
+}
+
+// FilterValue returns an empty string so headers never match a filter.
+func (h MatchGroupHeaderItem) FilterValue() string {
+	return ""
+}
+
+// leagueRanks orders the built-in leagues by region (as in data.GetAllRegions)
+// and then by their position in data.AllSupportedLeagues.
+var leagueRanks = func() map[int]int {
+	ranks := make(map[int]int)
+	for _, region := range data.GetAllRegions() {
+		for _, league := range data.AllSupportedLeagues[region] {
+			if _, seen := ranks[league.ID]; !seen {
+				ranks[league.ID] = len(ranks)
+			}
+		}
+	}
+	return ranks
+}()
+
+// leagueRegions maps built-in league IDs to their region.
+var leagueRegions = func() map[int]string {
+	regions := make(map[int]string)
+	for _, region := range data.GetAllRegions() {
+		for _, league := range data.AllSupportedLeagues[region] {
+			if _, seen := regions[league.ID]; !seen {
+				regions[league.ID] = region
+			}
+		}
+	}
+	return regions
+}()
+
+// ToGroupedMatchListItems converts matches to list items grouped by competition, each
+// group preceded by a MatchGroupHeaderItem. Groups follow the region and league order
+// of data.AllSupportedLeagues, with unknown leagues last in order of appearance;
+// matches keep their order within a group.
+func ToGroupedMatchListItems(matches []MatchDisplay) []list.Item {
+	type group struct {
+		header  MatchGroupHeaderItem
+		rank    int
+		matches []MatchDisplay
+	}
+
+	var groups []*group
+	byLeague := make(map[int]*group)
+	for _, match := range matches {
+		g, ok := byLeague[match.League.ID]
+		if !ok {
+			region, known := leagueRegions[match.League.ID]
+			rank := leagueRanks[match.League.ID]
+			if !known {
+				region = otherRegion
+				rank = len(leagueRanks) + len(groups)
+			}
+			g = &group{header: MatchGroupHeaderItem{Region: region, League: match.League.Name}, rank: rank}
+			byLeague[match.League.ID] = g
+			groups = append(groups, g)
+		}
+		g.matches = append(g.matches, match)
+	}
+
+	slices.SortStableFunc(groups, func(a, b *group) int {
+		return a.rank - b.rank
+	})
+
+	items := make([]list.Item, 0, len(matches)+len(groups))
+	for _, g := range groups {
+		g.header.Count = len(g.matches)
+		items = append(items, g.header)
+		items = append(items, ToMatchListItems(g.matches)...)
+	}
+	return items
+}
+
+// SkipGroupHeaders moves the selection off a group header after the cursor moved
+// from index previous, continuing in the direction of travel and turning back at
+// either end of the list. Does nothing when a match is selected.
+func SkipGroupHeaders(l *list.Model, previous int) {
+	if _, ok := l.SelectedItem().(MatchGroupHeaderItem); !ok {
+		return
+	}
+
+	items := l.VisibleItems()
+	start := l.Index()
+	step := 1
+	if start < previous {
+		step = -1
+	}
+	for _, dir := range []int{step, -step} {
+		for i := start + dir; i >= 0 && i < len(items); i += dir {
+			if _, header := items[i].(MatchGroupHeaderItem); !header {
+				l.Select(i)
+				return
+			}
+		}
+	}
+}
+
+// MatchListDelegate renders match list items, drawing group headers as plain
+// section titles that are never highlighted as selected.
+type MatchListDelegate struct {
+	list.DefaultDelegate
+	compact bool // One line per match, see NewCompactMatchListDelegate
+}
+
+// compactMatchItem shows a match list item as its one-line compact title.
+type compactMatchItem struct {
+	MatchListItem
+}
+
+// Title returns the one-line summary of the match.
+func (c compactMatchItem) Title() string {
+	return c.Display.CompactTitle()
+}
+
+// Render renders a match item with the default delegate, or a group header.
+func (d MatchListDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	header, ok := item.(MatchGroupHeaderItem)
+	if !ok {
+		if match, isMatch := item.(MatchListItem); isMatch && d.compact {
+			item = compactMatchItem{match}
+		}
+		d.DefaultDelegate.Render(w, m, index, item)
+		return
+	}
+
+	if d.compact {
+		leagueStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Padding(0, 1)
+		_, _ = io.WriteString(w, leagueStyle.Render(fmt.Sprintf("%s · %s (%d)", header.Region, header.League, header.Count)))
+		return
+	}
+
+	width := max(m.Width()-2, 0)
+	regionStyle := lipgloss.NewStyle().Foreground(neonDim).Padding(0, 1)
+	leagueStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Padding(0, 1)
+	ruleStyle := lipgloss.NewStyle().Foreground(neonDimGray).Padding(0, 1)
+
+	lines := []string{
+		regionStyle.Render(strings.ToUpper(header.Region)),
+		leagueStyle.Render(fmt.Sprintf("%s (%d)", header.League, header.Count)),
+		ruleStyle.Render(strings.Repeat("─", width)),
+	}
+	_, _ = io.WriteString(w, strings.Join(lines[:min(len(lines), d.Height())], "\n"))
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func groupTestMatch(id int, league api.League) MatchDisplay {
+	return MatchDisplay{Match: api.Match{ID: id, League: league}}
+}
+
+func TestToGroupedMatchListItems(t *testing.T) {
+	mls := api.League{ID: 130, Name: "MLS"}
+	premier := api.League{ID: 47, Name: "Premier League"}
+	unknown := api.League{ID: -1, Name: "Sunday League"}
+
+	items := ToGroupedMatchListItems([]MatchDisplay{
+		groupTestMatch(1, mls),
+		groupTestMatch(2, unknown),
+		groupTestMatch(3, premier),
+		groupTestMatch(4, mls),
+	})
+
+	want := []list.Item{
+		MatchGroupHeaderItem{Region: data.RegionEurope, League: "Premier League", Count: 1},
+		MatchListItem{Match: groupTestMatch(3, premier).Match, Display: groupTestMatch(3, premier)},
+		MatchGroupHeaderItem{Region: data.RegionAmerica, League: "MLS", Count: 2},
+		MatchListItem{Match: groupTestMatch(1, mls).Match, Display: groupTestMatch(1, mls)},
+		MatchListItem{Match: groupTestMatch(4, mls).Match, Display: groupTestMatch(4, mls)},
+		MatchGroupHeaderItem{Region: otherRegion, League: "Sunday League", Count: 1},
+		MatchListItem{Match: groupTestMatch(2, unknown).Match, Display: groupTestMatch(2, unknown)},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("got %d items, want %d", len(items), len(want))
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, items[i], want[i])
+		}
+	}
+}
+
+func TestSkipGroupHeaders(t *testing.T) {
+	premier := api.League{ID: 47, Name: "Premier League"}
+	laLiga := api.League{ID: 87, Name: "La Liga"}
+	items := ToGroupedMatchListItems([]MatchDisplay{
+		groupTestMatch(1, premier),
+		groupTestMatch(2, laLiga),
+	})
+	// header, match 1, header, match 2
+
+	l := list.New(items, NewMatchListDelegate(), 80, 40)
+	press := func(key tea.KeyType) {
+		previous := l.Index()
+		l, _ = l.Update(tea.KeyMsg{Type: key})
+		SkipGroupHeaders(&l, previous)
+	}
+
+	SkipGroupHeaders(&l, 0)
+	if got := l.Index(); got != 1 {
+		t.Fatalf("initial index = %d, want 1 (first match)", got)
+	}
+	press(tea.KeyDown)
+	if got := l.Index(); got != 3 {
+		t.Errorf("after down, index = %d, want 3 (past the second header)", got)
+	}
+	press(tea.KeyUp)
+	if got := l.Index(); got != 1 {
+		t.Errorf("after up, index = %d, want 1 (past the second header)", got)
+	}
+	press(tea.KeyUp)
+	if got := l.Index(); got != 1 {
+		t.Errorf("after up at the top, index = %d, want 1 (turned back off the first header)", got)
+	}
+}
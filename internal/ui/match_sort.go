@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+)
+
+// MatchSort is the order of the live and stats match lists.
+type MatchSort int
+
+const (
+	// SortByKickoff orders matches by kickoff time, earliest first.
+	SortByKickoff MatchSort = iota
+	// SortByLeague groups matches by league name, then orders by kickoff time.
+	SortByLeague
+	// SortByHomeTeam orders matches alphabetically by home team.
+	SortByHomeTeam
+
+	matchSortCount // number of sort orders, for cycling
+)
+
+// Next returns the sort order that follows s, wrapping around.
+func (s MatchSort) Next() MatchSort {
+	return (s + 1) % matchSortCount
+}
+
+// SortMatches sorts matches in place. The sort is stable, so matches that compare
+// equal keep their fetch order. Matches without a kickoff time sort last.
+func SortMatches(matches []MatchDisplay, order MatchSort) {
+	kickoffLess := func(a, b MatchDisplay) bool {
+		if a.MatchTime == nil || b.MatchTime == nil {
+			return a.MatchTime != nil && b.MatchTime == nil
+		}
+		return a.MatchTime.Before(*b.MatchTime)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch order {
+		case SortByLeague:
+			if la, lb := strings.ToLower(a.League.Name), strings.ToLower(b.League.Name); la != lb {
+				return la < lb
+			}
+			return kickoffLess(a, b)
+		case SortByHomeTeam:
+			return strings.ToLower(teamDisplayName(a.HomeTeam)) < strings.ToLower(teamDisplayName(b.HomeTeam))
+		default:
+			return kickoffLess(a, b)
+		}
+	})
+}
+
+// MarkFavorites marks the matches involving one of the teams, by team ID.
+func MarkFavorites(matches []MatchDisplay, teams map[int]bool) {
+	for i := range matches {
+		matches[i].Favorite = teams[matches[i].HomeTeam.ID] || teams[matches[i].AwayTeam.ID]
+	}
+}
+
+// FavoritesFirst moves the matches marked by MarkFavorites to the top, keeping the
+// order of the favorite and other matches otherwise, so it composes with SortMatches.
+func FavoritesFirst(matches []MatchDisplay) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Favorite && !matches[j].Favorite
+	})
+}
+
+// PinMatches marks the matches in pinned and moves them to the top, keeping the
+// order of the pinned and unpinned matches otherwise.
+func PinMatches(matches []MatchDisplay, pinned map[int]bool) {
+	for i := range matches {
+		matches[i].Pinned = pinned[matches[i].ID]
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Pinned && !matches[j].Pinned
+	})
+}
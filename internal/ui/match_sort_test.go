@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestSortMatches(t *testing.T) {
+	kickoff := func(hour int) *time.Time {
+		at := time.Date(2026, 2, 14, hour, 0, 0, 0, time.UTC)
+		return &at
+	}
+	match := func(id int, league, home string, at *time.Time) MatchDisplay {
+		return MatchDisplay{Match: api.Match{
+			ID:        id,
+			League:    api.League{Name: league},
+			HomeTeam:  api.Team{ShortName: home},
+			MatchTime: at,
+		}}
+	}
+
+	fetched := []MatchDisplay{
+		match(1, "Serie A", "Napoli", kickoff(20)),
+		match(2, "Premier League", "Arsenal", kickoff(15)),
+		match(3, "LaLiga", "Barcelona", nil),
+		match(4, "Premier League", "Chelsea", kickoff(12)),
+		match(5, "LaLiga", "Atletico", kickoff(15)),
+	}
+
+	tests := []struct {
+		order MatchSort
+		want  []int
+	}{
+		{SortByKickoff, []int{4, 2, 5, 1, 3}},
+		{SortByLeague, []int{5, 3, 4, 2, 1}},
+		{SortByHomeTeam, []int{2, 5, 3, 4, 1}},
+	}
+
+	for _, tt := range tests {
+		matches := append([]MatchDisplay(nil), fetched...)
+		SortMatches(matches, tt.order)
+		got := make([]int, len(matches))
+		for i, m := range matches {
+			got[i] = m.ID
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SortMatches(%d) IDs = %v, want %v", tt.order, got, tt.want)
+		}
+	}
+
+	if got := SortByHomeTeam.Next(); got != SortByKickoff {
+		t.Errorf("SortByHomeTeam.Next() = %d, want SortByKickoff", got)
+	}
+}
+
+func TestFavoritesFirst(t *testing.T) {
+	match := func(id, homeID, awayID int, league string) MatchDisplay {
+		return MatchDisplay{Match: api.Match{
+			ID:       id,
+			League:   api.League{Name: league},
+			HomeTeam: api.Team{ID: homeID, ShortName: "Home"},
+			AwayTeam: api.Team{ID: awayID, ShortName: "Away"},
+		}}
+	}
+	matches := []MatchDisplay{
+		match(1, 10, 11, "Serie A"),
+		match(2, 20, 9825, "Premier League"), // Favorite away
+		match(3, 30, 31, "LaLiga"),
+		match(4, 8633, 41, "LaLiga"), // Favorite at home
+	}
+
+	MarkFavorites(matches, map[int]bool{9825: true, 8633: true})
+	SortMatches(matches, SortByLeague)
+	FavoritesFirst(matches)
+
+	var got []int
+	for _, m := range matches {
+		got = append(got, m.ID)
+	}
+	// Favorites keep the league order among themselves, and so do the rest
+	if want := []int{4, 2, 3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("IDs = %v, want %v", got, want)
+	}
+	if title := matches[0].Title(); title != FavoriteGlyph+" Home vs Away" {
+		t.Errorf("Title() = %q, want the favorite marker", title)
+	}
+	if title := matches[2].Title(); title != "Home vs Away" {
+		t.Errorf("Title() = %q, want no marker", title)
+	}
+}
@@ -10,9 +10,21 @@ import (
 // MatchDisplay wraps a match with display information for rendering.
 type MatchDisplay struct {
 	api.Match
+	Pinned      bool // Pinned to the top of the list for the session, see PinMatches
+	Favorite    bool // Involves a favorite team, see MarkFavorites
+	ScoreHidden bool // Result hidden by spoiler mode, see HideScores
+	LeagueFlag  bool // Title prefixed with the league's flag, see ShowLeagueFlags
 }
 
-// Title returns a formatted title for the match.
+// PinGlyph prefixes the titles of pinned matches.
+const PinGlyph = "⚑"
+
+// FavoriteGlyph prefixes the titles of matches involving a favorite team.
+const FavoriteGlyph = "★"
+
+// Title returns a formatted title for the match, prefixed with the league flag
+// when marked by ShowLeagueFlags, with FavoriteGlyph for a favorite team's match
+// and with PinGlyph when pinned.
 func (m MatchDisplay) Title() string {
 	home := m.HomeTeam.ShortName
 	if home == "" {
@@ -22,17 +34,100 @@ func (m MatchDisplay) Title() string {
 	if away == "" {
 		away = m.AwayTeam.Name
 	}
-	return home + " vs " + away
+	title := home + " vs " + away
+	if m.LeagueFlag {
+		title = LeagueFlag(m.League) + " " + title
+	}
+	if m.Favorite {
+		title = FavoriteGlyph + " " + title
+	}
+	if m.Pinned {
+		title = PinGlyph + " " + title
+	}
+	return title
+}
+
+// shortTeamName returns a team's short name, or its full name if it has none.
+func shortTeamName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
+
+// displayScore returns the score to show for a match. A live match without a score has
+// just kicked off, so it shows 0 - 0; other matches without one (not started, postponed) show none.
+func displayScore(match api.Match) (home, away int, ok bool) {
+	if match.HomeScore != nil && match.AwayScore != nil {
+		return *match.HomeScore, *match.AwayScore, true
+	}
+	if match.Status == api.MatchStatusLive {
+		if match.HomeScore != nil {
+			home = *match.HomeScore
+		}
+		if match.AwayScore != nil {
+			away = *match.AwayScore
+		}
+		return home, away, true
+	}
+	return 0, 0, false
+}
+
+// CompactTitle returns the match on a single line for compact match lists,
+// e.g. "ARS 2-1 CHE · Premier League · 78'". Matches without a score show "vs"
+// and, before kickoff, the KO time.
+func (m MatchDisplay) CompactTitle() string {
+	home := shortTeamName(m.HomeTeam)
+	away := shortTeamName(m.AwayTeam)
+
+	score := "vs"
+	if m.ScoreHidden {
+		score = hiddenCompactScore
+	} else if homeScore, awayScore, ok := displayScore(m.Match); ok {
+		score = fmt.Sprintf("%d-%d", homeScore, awayScore)
+	}
+	parts := []string{home + " " + score + " " + away}
+	if m.LeagueFlag {
+		parts[0] = LeagueFlag(m.League) + " " + parts[0]
+	}
+	if m.Favorite {
+		parts[0] = FavoriteGlyph + " " + parts[0]
+	}
+	if m.Pinned {
+		parts[0] = PinGlyph + " " + parts[0]
+	}
+
+	if label := statusLabel(m.Status); label != "" {
+		parts = append(parts, label)
+	}
+	if m.League.Name != "" {
+		parts = append(parts, m.League.Name)
+	}
+	if m.LiveTime != nil {
+		parts = append(parts, *m.LiveTime)
+	} else if m.Status == api.MatchStatusNotStarted && m.MatchTime != nil {
+		parts = append(parts, "KO "+formatDisplayTime(*m.MatchTime, "15:04"))
+	}
+
+	return strings.Join(parts, " · ")
 }
 
 // Description returns a formatted description for the match.
-// Shows score, league, live time on first line; KO time on second line.
+// Shows score, league, live time on first line; KO time (and countdown, before kickoff)
+// on second line.
 func (m MatchDisplay) Description() string {
 	var parts []string
 
-	// Add score if available
-	if m.HomeScore != nil && m.AwayScore != nil {
-		parts = append(parts, fmt.Sprintf("%d - %d", *m.HomeScore, *m.AwayScore))
+	// Add score if available (masked in spoiler mode)
+	if m.ScoreHidden {
+		parts = append(parts, HiddenScore)
+	} else if homeScore, awayScore, ok := displayScore(m.Match); ok {
+		parts = append(parts, fmt.Sprintf("%d - %d", homeScore, awayScore))
+	}
+
+	// Flag matches that won't produce a normal result
+	if label := statusLabel(m.Status); label != "" {
+		parts = append(parts, label)
 	}
 
 	// Add league name
@@ -49,7 +144,11 @@ func (m MatchDisplay) Description() string {
 
 	// Add start time (kick-off time) on second line
 	if m.MatchTime != nil {
-		return line1 + "\nKO " + m.MatchTime.Local().Format("15:04")
+		kickoff := "\nKO " + formatDisplayTime(*m.MatchTime, "15:04 MST")
+		if countdown := matchCountdown(m.Match); countdown != "" {
+			kickoff += " • " + countdown
+		}
+		return line1 + kickoff
 	}
 
 	return line1
@@ -2,11 +2,11 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/ui/design"
-	"github.com/0xjuanma/golazo/internal/ui/logo"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -29,8 +29,52 @@ var (
 			Foreground(dimColor).
 			Align(lipgloss.Center).
 			Padding(0, 0)
+
+	menuGlanceStyle = lipgloss.NewStyle().
+			Foreground(dimColor).
+			Align(lipgloss.Center).
+			Height(1)
 )
 
+// TodaySummary holds today's match counts shown beneath the main menu.
+type TodaySummary struct {
+	Live     int
+	Finished int
+	Upcoming int
+}
+
+// String formats the summary, e.g. "3 live • 12 finished • 5 upcoming today".
+// Zero counts are left out.
+func (s TodaySummary) String() string {
+	var parts []string
+	if s.Live > 0 {
+		parts = append(parts, fmt.Sprintf("%d live", s.Live))
+	}
+	if s.Finished > 0 {
+		parts = append(parts, fmt.Sprintf("%d finished", s.Finished))
+	}
+	if s.Upcoming > 0 {
+		parts = append(parts, fmt.Sprintf("%d upcoming", s.Upcoming))
+	}
+	if len(parts) == 0 {
+		return constants.EmptyNoMatchesToday
+	}
+	return strings.Join(parts, " • ") + " today"
+}
+
+// mainMenuItems are the main menu entries, in the order of the selected index.
+var mainMenuItems = []string{
+	constants.MenuStats,
+	constants.MenuLiveMatches,
+	constants.MenuStandings,
+	constants.MenuSettings,
+}
+
+// MainMenuItemCount returns how many entries the main menu has.
+func MainMenuItemCount() int {
+	return len(mainMenuItems)
+}
+
 // RenderMainMenu renders the main menu view with navigation options.
 // width and height specify the terminal dimensions.
 // selected indicates which menu item is currently selected (0-indexed).
@@ -38,16 +82,11 @@ var (
 // randomSpinner is the random character spinner for main view.
 // loading indicates if the spinner should be shown.
 // bannerType determines what status banner (if any) to display at the top.
-// animatedLogo is the animated logo instance for the main view.
-func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner *RandomCharSpinner, loading bool, bannerType constants.StatusBannerType, animatedLogo *logo.AnimatedLogo) string {
-	menuItems := []string{
-		constants.MenuStats,
-		constants.MenuLiveMatches,
-		constants.MenuSettings,
-	}
-
-	items := make([]string, 0, len(menuItems))
-	for i, item := range menuItems {
+// logoContent is the logo to show: the current animation frame or the static logo.
+// summary is today's match counts (nil shows nothing); summaryLoading shows sp in its place.
+func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner *RandomCharSpinner, loading bool, bannerType constants.StatusBannerType, logoContent string, summary *TodaySummary, summaryLoading bool) string {
+	items := make([]string, 0, len(mainMenuItems))
+	for i, item := range mainMenuItems {
 		if i == selected {
 			items = append(items, menuItemSelectedStyle.Render(item))
 		} else {
@@ -57,9 +96,6 @@ func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner
 
 	menuContent := strings.Join(items, "\n")
 
-	// Get logo content from animated logo (handles animation state internally)
-	logoContent := animatedLogo.View()
-
 	// Place logo in centered container
 	title := lipgloss.NewStyle().
 		Width(logoWidth).
@@ -67,6 +103,16 @@ func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner
 		Render(logoContent)
 	help := menuHelpStyle.Render(constants.HelpMainMenu)
 
+	// Today at a glance - always reserve the line so the menu doesn't move when it loads
+	var glance string
+	switch {
+	case summary != nil:
+		glance = summary.String()
+	case summaryLoading:
+		glance = sp.View()
+	}
+	glanceContent := menuGlanceStyle.Render(glance)
+
 	// Spinner with fixed spacing - always reserve space to prevent movement
 	// Use multiple spinner instances for a longer, more prominent animation
 	spinnerStyle := lipgloss.NewStyle().
@@ -96,7 +142,8 @@ func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner
 		spinnerContent,
 		"\n",
 		menuContent,
-		"\n",
+		"",
+		glanceContent,
 		help,
 	)
 
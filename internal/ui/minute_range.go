@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// minuteRangeStep is how far one key press moves a bound of a MinuteRange.
+const minuteRangeStep = 15
+
+// minuteRangeEnd is the last minute a lower bound can start from (extra time).
+const minuteRangeEnd = 120
+
+// MinuteRange limits the listed events to the minutes From to To, both inclusive.
+// A zero bound leaves that end open; the zero value shows every event.
+// Stoppage time counts as the minute it was added to, so 90+3 falls in 76-90
+// and 45+2 stays in the first half.
+type MinuteRange struct {
+	From int
+	To   int
+}
+
+// IsZero reports whether the range shows every event.
+func (r MinuteRange) IsZero() bool {
+	return r.From == 0 && r.To == 0
+}
+
+// Contains reports whether the event happened within the range.
+func (r MinuteRange) Contains(event api.MatchEvent) bool {
+	minute := eventMinute(event)
+	if r.From > 0 && minute < r.From {
+		return false
+	}
+	return r.To == 0 || minute <= r.To
+}
+
+// StepFrom moves the lower bound on a quarter of an hour (1, 16, 31, ... 106),
+// wrapping back to open. An upper bound it passes is cleared.
+func (r MinuteRange) StepFrom() MinuteRange {
+	switch {
+	case r.From == 0:
+		r.From = 1 + minuteRangeStep
+	case r.From+minuteRangeStep > minuteRangeEnd:
+		r.From = 0
+	default:
+		r.From += minuteRangeStep
+	}
+	if r.To > 0 && r.From > r.To {
+		r.To = 0
+	}
+	return r
+}
+
+// StepTo moves the upper bound back a quarter of an hour (90, 75, ... 15),
+// wrapping back to open. A lower bound it passes is cleared.
+func (r MinuteRange) StepTo() MinuteRange {
+	switch {
+	case r.To == 0:
+		r.To = 90
+	case r.To-minuteRangeStep < minuteRangeStep:
+		r.To = 0
+	default:
+		r.To -= minuteRangeStep
+	}
+	if r.To > 0 && r.From > r.To {
+		r.From = 0
+	}
+	return r
+}
+
+// Label returns the range for section headers, e.g. "46'-90'", or "" for the full match.
+func (r MinuteRange) Label() string {
+	switch {
+	case r.IsZero():
+		return ""
+	case r.To == 0:
+		return fmt.Sprintf("%d'+", r.From)
+	}
+	return fmt.Sprintf("%d'-%d'", max(r.From, 1), r.To)
+}
+
+// sectionTitle appends the active range to a section title, e.g. "Goals · 46'-90'".
+func (r MinuteRange) sectionTitle(title string) string {
+	if label := r.Label(); label != "" {
+		return title + " · " + label
+	}
+	return title
+}
+
+// eventMinute returns the minute an event counts as when comparing it to a range.
+// DisplayMinute ("90+3'") is parsed to its base minute (90); Minute is used when it can't be.
+func eventMinute(event api.MatchEvent) int {
+	display := strings.TrimSuffix(strings.TrimSpace(event.DisplayMinute), "'")
+	base, _, _ := strings.Cut(display, "+")
+	if minute, err := strconv.Atoi(strings.TrimSpace(base)); err == nil {
+		return minute
+	}
+	return event.Minute
+}
+
+// filterMinutes returns the events within the range, preserving order.
+func filterMinutes(events []api.MatchEvent, r MinuteRange) []api.MatchEvent {
+	if r.IsZero() {
+		return events
+	}
+	var filtered []api.MatchEvent
+	for _, event := range events {
+		if r.Contains(event) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
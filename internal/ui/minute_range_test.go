@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestMinuteRangeContains(t *testing.T) {
+	firstHalfStoppage := api.MatchEvent{Minute: 45, DisplayMinute: "45+2'"}
+	lateWinner := api.MatchEvent{Minute: 90, DisplayMinute: "90+3'"}
+	noDisplay := api.MatchEvent{Minute: 60}
+
+	secondHalf := MinuteRange{From: 46, To: 90}
+	if secondHalf.Contains(firstHalfStoppage) {
+		t.Error("46'-90' contains a goal in first half stoppage time")
+	}
+	if !secondHalf.Contains(lateWinner) || !secondHalf.Contains(noDisplay) {
+		t.Error("46'-90' misses a second half event")
+	}
+	if !(MinuteRange{}).Contains(firstHalfStoppage) {
+		t.Error("the zero range hides an event")
+	}
+	if got := eventMinute(api.MatchEvent{Minute: 90, DisplayMinute: "90 + 3"}); got != 90 {
+		t.Errorf("eventMinute(90 + 3) = %d, want 90", got)
+	}
+}
+
+func TestMinuteRangeSteps(t *testing.T) {
+	var r MinuteRange
+	var froms []int
+	for range 9 {
+		r = r.StepFrom()
+		froms = append(froms, r.From)
+	}
+	want := []int{16, 31, 46, 61, 76, 91, 106, 0, 16}
+	for i := range want {
+		if froms[i] != want[i] {
+			t.Fatalf("StepFrom sequence = %v, want %v", froms, want)
+		}
+	}
+
+	r = MinuteRange{From: 46}.StepTo()
+	if r != (MinuteRange{From: 46, To: 90}) || r.Label() != "46'-90'" {
+		t.Errorf("StepTo from 46' = %+v (%q), want 46'-90'", r, r.Label())
+	}
+	r = r.StepTo().StepTo().StepTo()
+	if r != (MinuteRange{To: 45}) {
+		t.Errorf("stepping the end below the start = %+v, want the start cleared", r)
+	}
+}
+
+func TestGoalsSectionMinuteRange(t *testing.T) {
+	details := &api.MatchDetails{
+		Match: api.Match{HomeTeam: api.Team{ID: 1}, AwayTeam: api.Team{ID: 2}},
+		Events: []api.MatchEvent{
+			{Minute: 20, Type: "goal", Team: api.Team{ID: 1}},
+		},
+	}
+	cfg := MatchDetailsConfig{Details: details, Minutes: MinuteRange{From: 46}}
+	if got := renderGoalsSection(cfg, 60); got != "" {
+		t.Errorf("goals section with no goal after 46' = %q, want none", got)
+	}
+}
@@ -12,6 +12,9 @@ import (
 const (
 	CardSymbolYellow = "▪" // Small square for yellow cards
 	CardSymbolRed    = "■" // Filled square for red cards
+
+	// CardSymbolSecondYellow pairs the yellow and red symbols for a sending-off by second booking.
+	CardSymbolSecondYellow = CardSymbolYellow + CardSymbolRed
 )
 
 var (
@@ -36,6 +39,10 @@ var (
 	neonYellowCardStyle = lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
 	neonRedCardStyle    = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
 
+	// Goal type markers - "(pen)" and "(o.g.)" after the scorer
+	neonPenaltyStyle = lipgloss.NewStyle().Foreground(neonYellow)
+	neonOwnGoalStyle = lipgloss.NewStyle().Foreground(neonRed)
+
 	// Neon panel style - thick red border
 	neonPanelStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
@@ -89,6 +96,12 @@ var (
 	neonDateUnselectedStyle = lipgloss.NewStyle().
 				Foreground(neonDim).
 				Padding(0, 1)
+
+	// Active filter indicators: live only next to the date selector, minute range next to the event filter
+	neonLiveOnlyStyle = lipgloss.NewStyle().
+				Foreground(neonCyan).
+				Bold(true).
+				Padding(0, 1)
 )
 
 // FilterInputStyles returns cursor and prompt styles for list filter input.
@@ -109,3 +122,8 @@ func FilterInputStyles() (cursorStyle, promptStyle lipgloss.Style) {
 func AdaptiveGradientColors() (startHex, endHex string) {
 	return design.AdaptiveGradientColors()
 }
+
+// secondYellowSymbol renders CardSymbolSecondYellow with each half in its card color.
+func secondYellowSymbol() string {
+	return neonYellowCardStyle.Render(CardSymbolYellow) + neonRedCardStyle.Render(CardSymbolRed)
+}
@@ -7,8 +7,8 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/ui/design"
-	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -40,13 +40,18 @@ func buildEventContent(playerDetails string, replayIndicator string, symbol stri
 	return result + " " + playerDetails
 }
 
+// eventSideWidth returns the width available to event content on either side of the centered minute.
+func eventSideWidth(minuteStr string, width int) int {
+	timeWidth := lipgloss.Width(minuteStr) + 2
+	return (width - timeWidth) / 2
+}
+
 // renderCenterAlignedEvent renders an event with time centered and content expanding outward.
 func renderCenterAlignedEvent(minuteStr string, eventContent string, isHomeTeam bool, width int) string {
 	timeStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
 	styledTime := timeStyle.Render(minuteStr)
 
-	timeWidth := len(minuteStr) + 2
-	sideWidth := (width - timeWidth) / 2
+	sideWidth := eventSideWidth(minuteStr, width)
 
 	if isHomeTeam {
 		leftContent := lipgloss.NewStyle().
@@ -72,66 +77,51 @@ func renderCenterAlignedEvent(minuteStr string, eventContent string, isHomeTeam
 	return leftContent + " " + styledTime + " " + rightContent
 }
 
-// renderMatchDetailsPanelWithPolling renders the right panel with polling spinner support.
-func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
-	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks)
-}
-
-// renderMatchDetailsPanelFull renders the right panel with match details using unified rendering.
-func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+// renderLiveDetailsPanel renders the live view's right panel with the selected match's details.
+func renderLiveDetailsPanel(width, height int, cfg LiveViewConfig) string {
 	detailsPanelStyle := lipgloss.NewStyle().Padding(0, 1)
+	title := design.RenderHeader(constants.PanelMinuteByMinute, width-6)
 
-	if details == nil {
+	if cfg.Details == nil {
+		message := constants.EmptySelectMatch
+		if cfg.DetailsLoading {
+			message = constants.LoadingDetails
+		}
 		emptyMessage := lipgloss.NewStyle().
 			Foreground(neonDim).
 			Align(lipgloss.Center).
 			Width(width - 6).
 			PaddingTop(1).
-			Render(constants.EmptySelectMatch)
-
-		content := emptyMessage
-		if showTitle {
-			title := design.RenderHeader(constants.PanelMinuteByMinute, width-6)
-			content = lipgloss.JoinVertical(lipgloss.Left, title, emptyMessage)
-		}
+			Render(message)
 
 		return detailsPanelStyle.
 			Width(width).
 			Height(height).
 			MaxHeight(height).
-			Render(content)
+			Render(lipgloss.JoinVertical(lipgloss.Left, title, emptyMessage))
 	}
 
 	// Use unified rendering
-	cfg := MatchDetailsConfig{
-		Width:          width,
-		Height:         height,
-		Details:        details,
-		GoalLinks:      goalLinks,
-		ShowStatistics: false,
-		ShowHighlights: false,
-		LiveUpdates:    liveUpdates,
-		PollingSpinner: pollingSpinner,
-		IsPolling:      isPolling,
-		Loading:        loading,
-		Focused:        false,
-	}
-
-	headerContent, scrollableContent := RenderMatchDetails(cfg)
-
-	var panelContent string
-	if showTitle {
-		title := design.RenderHeader(constants.PanelMinuteByMinute, width-6)
-		panelContent = lipgloss.JoinVertical(lipgloss.Left, title, headerContent, scrollableContent)
-	} else {
-		panelContent = lipgloss.JoinVertical(lipgloss.Left, headerContent, scrollableContent)
-	}
+	headerContent, scrollableContent := RenderMatchDetails(MatchDetailsConfig{
+		Width:            width,
+		Height:           height,
+		Details:          cfg.Details,
+		ScoreHidden:      cfg.ScoreHidden,
+		GoalLinks:        cfg.GoalLinks,
+		PlayerPhotos:     cfg.PlayerPhotos,
+		LiveUpdates:      cfg.LiveUpdates,
+		UpdatesCollapsed: cfg.UpdatesCollapsed,
+		LiveClockSince:   cfg.LiveClockSince,
+		PollingSpinner:   cfg.PollingSpinner,
+		IsPolling:        cfg.IsPolling,
+		Loading:          cfg.Loading,
+	})
 
 	return detailsPanelStyle.
 		Width(width).
 		Height(height).
 		MaxHeight(height).
-		Render(panelContent)
+		Render(lipgloss.JoinVertical(lipgloss.Left, title, headerContent, scrollableContent))
 }
 
 // extractTeamMarker extracts the [H] or [A] marker from the end of an update string.
@@ -170,6 +160,12 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 	if len(update) == 0 {
 		return update
 	}
+	if commentary, ok := strings.CutPrefix(update, fotmob.EventPrefixCommentary+" "); ok {
+		return renderCommentaryLine(commentary, contentWidth)
+	}
+	if strings.HasPrefix(update, fotmob.EventPrefixStage+" ") { // Stage marker (half time, full time)
+		return lipgloss.NewStyle().Foreground(neonDim).Width(contentWidth).Align(lipgloss.Center).Render(update)
+	}
 
 	cleanUpdate, isHome := extractTeamMarker(update)
 	minute, contentWithoutMinute := extractMinuteFromUpdate(cleanUpdate)
@@ -185,15 +181,16 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 	var styledContent string
 	switch symbol {
 	case "●": // Goal - gradient
-		// Check for own goal marker in the update string
-		label := "GOAL"
-		if strings.Contains(contentWithoutMinute, "[OWN GOAL]") {
-			label = "OWN GOAL"
+		// Check for own goal and penalty markers in the update string
+		marker := "[GOAL]"
+		ownGoal := strings.Contains(contentWithoutMinute, "[OWN GOAL]")
+		if ownGoal {
+			marker = "[OWN GOAL]"
 		}
-		marker := fmt.Sprintf("[%s]", label)
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, marker)
-		styledType := design.ApplyGradientToText(label)
-		styledPlayer := whiteStyle.Render(playerDetails)
+		playerDetails, penalty := strings.CutSuffix(playerDetails, " (pen)")
+		styledType := design.ApplyGradientToText("GOAL")
+		styledPlayer := whiteStyle.Render(playerDetails) + goalTypeMarker(ownGoal, penalty)
 
 		replayIndicator := ""
 		if details != nil && goalLinks != nil {
@@ -208,12 +205,20 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 		cardStyle := lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
 		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
-	case "■": // Red card
+	case "■": // Red card, straight or by a second yellow
 		cardStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+		if strings.Contains(contentWithoutMinute, "[2ND YELLOW]") {
+			playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[2ND YELLOW]")
+			styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", secondYellowSymbol(), cardStyle.Render("2ND YELLOW"), isHome)
+			break
+		}
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
 		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
 	case "↔": // Substitution
 		styledContent = renderSubstitutionWithColorsNoMinute(contentWithoutMinute, isHome)
+	case "◆": // VAR decision
+		decision, _ := extractPlayerAndType(contentWithoutMinute, "[VAR]")
+		styledContent = buildVAREventContent(decision, isHome)
 	case "·": // Other
 		dimStyle := lipgloss.NewStyle().Foreground(neonDim)
 		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "")
@@ -225,6 +230,31 @@ func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchD
 	return renderCenterAlignedEvent(minute, styledContent, isHome, contentWidth)
 }
 
+// buildVAREventContent returns the styled content for a VAR decision (no minute),
+// e.g. "VAR ◆ goal disallowed (offside)".
+// Used by both the live view (from the update string) and the timeline.
+func buildVAREventContent(decision string, isHome bool) string {
+	varStyle := lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
+	text := lipgloss.NewStyle().Foreground(neonWhite).Render(decision)
+	return buildEventContent(text, "", "◆", varStyle.Render("VAR"), isHome)
+}
+
+// commentaryMinuteWidth fits minutes like "90+10'" so commentary text lines up.
+const commentaryMinuteWidth = 6
+
+// renderCommentaryLine renders a commentary line ("TIME' text") left-aligned, with the text
+// wrapped under itself beside the minute column.
+func renderCommentaryLine(line string, contentWidth int) string {
+	minute, text, _ := strings.Cut(line, "' ")
+	if minute != "" {
+		minute += "'"
+	}
+
+	timeStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true).Width(commentaryMinuteWidth)
+	textStyle := lipgloss.NewStyle().Foreground(neonWhite).Width(max(contentWidth-commentaryMinuteWidth-1, 10))
+	return lipgloss.JoinHorizontal(lipgloss.Top, timeStyle.Render(minute), " ", textStyle.Render(text))
+}
+
 // extractPlayerAndType extracts player details and type label from event content.
 func extractPlayerAndType(content string, typeMarker string) (string, string) {
 	if typeMarker == "" {
@@ -278,7 +308,51 @@ func renderSubstitutionWithColorsNoMinute(update string, isHome bool) string {
 }
 
 // renderLargeScore renders the score in a large, prominent format using block digits.
+// A negative score renders as a dot, masking it in spoiler mode.
 func renderLargeScore(homeScore, awayScore int, width int) string {
+	return renderScoreBlock(largeScoreLines(homeScore, awayScore), width)
+}
+
+// renderEnlargedScore renders the block digit score at twice its size, for the focused match view.
+func renderEnlargedScore(homeScore, awayScore int, width int) string {
+	var lines []string
+	for _, line := range largeScoreLines(homeScore, awayScore) {
+		// Each half block cell becomes two full rows of two columns
+		var top, bottom strings.Builder
+		for _, r := range line {
+			upper, lower := " ", " "
+			switch r {
+			case '█':
+				upper, lower = "█", "█"
+			case '▀':
+				upper = "█"
+			case '▄':
+				lower = "█"
+			}
+			top.WriteString(upper + upper)
+			bottom.WriteString(lower + lower)
+		}
+		lines = append(lines, top.String(), bottom.String())
+	}
+	return renderScoreBlock(lines, width)
+}
+
+// renderScoreBlock styles the score lines and centers them in width.
+func renderScoreBlock(lines []string, width int) string {
+	scoreStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+	styled := make([]string, len(lines))
+	for i, line := range lines {
+		styled[i] = scoreStyle.Render(line)
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Render(strings.Join(styled, "\n"))
+}
+
+// largeScoreLines returns the three unstyled lines of the block digit score.
+func largeScoreLines(homeScore, awayScore int) []string {
 	digits := map[int][]string{
 		0: {"█▀█", "█ █", "▀▀▀"},
 		1: {" █ ", " █ ", " ▀ "},
@@ -293,8 +367,12 @@ func renderLargeScore(homeScore, awayScore int, width int) string {
 	}
 
 	dash := []string{"   ", "▀▀▀", "   "}
+	masked := []string{"   ", "▄█▄", " ▀ "}
 
 	getDigitPatterns := func(score int) [][]string {
+		if score < 0 {
+			return [][]string{masked}
+		}
 		if score < 10 {
 			return [][]string{digits[score]}
 		}
@@ -311,8 +389,6 @@ func renderLargeScore(homeScore, awayScore int, width int) string {
 	awayPatterns := getDigitPatterns(awayScore)
 
 	var lines []string
-	scoreStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
-
 	for i := range 3 {
 		var homeLine strings.Builder
 		for j, p := range homePatterns {
@@ -330,14 +406,7 @@ func renderLargeScore(homeScore, awayScore int, width int) string {
 			awayLine.WriteString(p[i])
 		}
 
-		line := homeLine.String() + "  " + dash[i] + "  " + awayLine.String()
-		lines = append(lines, scoreStyle.Render(line))
+		lines = append(lines, homeLine.String()+"  "+dash[i]+"  "+awayLine.String())
 	}
-
-	scoreBlock := strings.Join(lines, "\n")
-
-	return lipgloss.NewStyle().
-		Width(width).
-		Align(lipgloss.Center).
-		Render(scoreBlock)
+	return lines
 }
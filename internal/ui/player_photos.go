@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/ui/images"
+)
+
+// playerPhotoCols is the width of a scorer thumbnail in cells.
+const playerPhotoCols = 2
+
+// playerThumbnail returns the photo of a player from photos followed by a space, or ""
+// when thumbnails are off (a nil cache) or the photo hasn't been downloaded.
+func playerThumbnail(photos *images.Cache, playerID int) string {
+	if playerID == 0 || !photos.Enabled() {
+		return ""
+	}
+	thumbnail := photos.Thumbnail(fotmob.PlayerImageURL(playerID), playerPhotoCols)
+	if thumbnail == "" {
+		return ""
+	}
+	return thumbnail + " "
+}
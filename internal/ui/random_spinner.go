@@ -5,9 +5,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/0xjuanma/golazo/internal/ui/design"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/lucasb-eyer/go-colorful"
 )
 
 // SpinnerTickInterval is the unified tick rate for all spinners (70ms ≈ 14 fps).
@@ -91,10 +91,13 @@ func (r *RandomCharSpinner) View() string {
 		}
 	}
 
+	if design.NoColor() {
+		return string(r.display)
+	}
+
 	// Get adaptive gradient colors based on terminal background
 	startHex, endHex := AdaptiveGradientColors()
-	startColor, _ := colorful.Hex(startHex)
-	endColor, _ := colorful.Hex(endHex)
+	startColor, endColor := design.GradientColors(startHex, endHex)
 
 	// Apply gradient to each character
 	var result strings.Builder
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tickerSeparator goes between the results in the results ticker, and after the last one
+// so the text loops back to the first.
+const tickerSeparator = "   •   "
+
+// ResultsTickerText joins finished matches into the results ticker's looping text,
+// e.g. "ARS 2-1 CHE   •   RMA 0-0 BAR   •   ". Spoiler mode hides the scores.
+func ResultsTickerText(matches []api.Match, spoilers Spoilers) string {
+	var b strings.Builder
+	for _, match := range matches {
+		score := "vs"
+		if spoilers.ScoreHidden(match) {
+			score = hiddenCompactScore
+		} else if homeScore, awayScore, ok := displayScore(match); ok {
+			score = fmt.Sprintf("%d-%d", homeScore, awayScore)
+		}
+		b.WriteString(shortTeamName(match.HomeTeam) + " " + score + " " + shortTeamName(match.AwayTeam))
+		b.WriteString(tickerSeparator)
+	}
+	return b.String()
+}
+
+// ResultsTickerScrolls reports whether the ticker text is too wide for the row and scrolls.
+func ResultsTickerScrolls(width int, text string) bool {
+	return len([]rune(strings.TrimSuffix(text, tickerSeparator))) > tickerTextWidth(width)
+}
+
+// RenderResultsTicker renders the one-line results ticker at the bottom of the live view.
+// Text wider than the row scrolls left by offset characters, wrapping around to the first
+// result; text that fits stays put.
+func RenderResultsTicker(width int, text string, offset int) string {
+	if width <= 0 {
+		width = 80
+	}
+	label := lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(constants.ResultsTickerLabel)
+	room := tickerTextWidth(width)
+
+	runes := []rune(text)
+	visible := strings.TrimSuffix(text, tickerSeparator)
+	if ResultsTickerScrolls(width, text) {
+		window := make([]rune, room)
+		for i := range window {
+			window[i] = runes[(offset+i)%len(runes)]
+		}
+		visible = string(window)
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxWidth(width).
+		Render(label + " " + lipgloss.NewStyle().Foreground(neonWhite).Render(visible))
+}
+
+// tickerTextWidth is the room left to the results after the ticker's label.
+func tickerTextWidth(width int) int {
+	return max(width-lipgloss.Width(constants.ResultsTickerLabel)-1, 1)
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestResultsTicker(t *testing.T) {
+	two, one, zero := 2, 1, 0
+	matches := []api.Match{
+		{ID: 1, Status: api.MatchStatusFinished, HomeTeam: api.Team{ShortName: "ARS"}, AwayTeam: api.Team{ShortName: "CHE"}, HomeScore: &two, AwayScore: &one},
+		{ID: 2, Status: api.MatchStatusFinished, HomeTeam: api.Team{Name: "Real Madrid"}, AwayTeam: api.Team{ShortName: "BAR"}, HomeScore: &zero, AwayScore: &zero},
+	}
+	text := ResultsTickerText(matches, Spoilers{})
+	if want := "ARS 2-1 CHE" + tickerSeparator + "Real Madrid 0-0 BAR" + tickerSeparator; text != want {
+		t.Fatalf("ResultsTickerText() = %q, want %q", text, want)
+	}
+
+	// Wide enough: the results stay put, without the trailing separator
+	wide := RenderResultsTicker(80, text, 5)
+	if !strings.Contains(wide, "ARS 2-1 CHE"+tickerSeparator+"Real Madrid 0-0 BAR") || ResultsTickerScrolls(80, text) {
+		t.Errorf("ticker fitting the row should not scroll, got %q", wide)
+	}
+
+	// Too narrow: a window of the text scrolls by the offset and wraps around to the start
+	width := 20
+	if !ResultsTickerScrolls(width, text) {
+		t.Fatalf("ticker text of %d characters should scroll in %d columns", len(text), width)
+	}
+	room := tickerTextWidth(width)
+	runes := []rune(text)
+	for _, offset := range []int{0, 3, len(runes) - 4, len(runes) + 3} {
+		got := RenderResultsTicker(width, text, offset)
+		if w := lipgloss.Width(got); w != width {
+			t.Errorf("offset %d: ticker is %d columns wide, want %d", offset, w, width)
+		}
+		var want []rune
+		for i := range room {
+			want = append(want, runes[(offset+i)%len(runes)])
+		}
+		if !strings.Contains(got, string(want)) {
+			t.Errorf("offset %d: ticker %q should show %q", offset, got, string(want))
+		}
+	}
+
+	spoilers := Spoilers{On: true, Revealed: map[int]bool{2: true}}
+	if got := ResultsTickerText(matches, spoilers); !strings.Contains(got, "ARS •-• CHE") || !strings.Contains(got, "Real Madrid 0-0 BAR") {
+		t.Errorf("ResultsTickerText() in spoiler mode = %q, want only the unrevealed score hidden", got)
+	}
+}
@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ScorerEntry is one player's goals across the finished matches loaded in the stats view.
+type ScorerEntry struct {
+	Player   string
+	Team     string
+	Goals    int      // Goals excluding own goals
+	OwnGoals int      // Own goals, counted separately
+	Matches  []string // Opponents scored against, e.g. "vs Arsenal", in match order
+}
+
+// scorerKey groups goals by player and team, so namesakes at different clubs stay apart.
+type scorerKey struct {
+	player string
+	teamID int
+}
+
+// AggregateScorers collects the goal scorers from match details.
+// Entries are sorted by goals (descending), then own goals, then player name.
+// Own goals are attributed to the scorer's own team, i.e. the side that conceded.
+func AggregateScorers(details []*api.MatchDetails) []ScorerEntry {
+	index := make(map[scorerKey]int)
+	lastMatch := make(map[scorerKey]int) // Match ID of the scorer's latest goal, for listing each match once
+	var entries []ScorerEntry
+
+	for _, match := range details {
+		if match == nil {
+			continue
+		}
+		for _, event := range match.Events {
+			if event.Type != "goal" || event.Player == nil || *event.Player == "" {
+				continue
+			}
+
+			ownGoal := event.IsOwnGoal()
+
+			// The event team is the side credited with the goal
+			team, opponent := match.HomeTeam, match.AwayTeam
+			if event.Team.ID == match.AwayTeam.ID {
+				team, opponent = opponent, team
+			}
+			if ownGoal {
+				team, opponent = opponent, team
+			}
+
+			key := scorerKey{player: *event.Player, teamID: team.ID}
+			i, ok := index[key]
+			if !ok {
+				i = len(entries)
+				index[key] = i
+				entries = append(entries, ScorerEntry{Player: *event.Player, Team: teamDisplayName(team)})
+			}
+
+			entry := &entries[i]
+			if ownGoal {
+				entry.OwnGoals++
+			} else {
+				entry.Goals++
+			}
+
+			if lastMatch[key] != match.ID {
+				lastMatch[key] = match.ID
+				entry.Matches = append(entry.Matches, "vs "+teamDisplayName(opponent))
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Goals != entries[j].Goals {
+			return entries[i].Goals > entries[j].Goals
+		}
+		if entries[i].OwnGoals != entries[j].OwnGoals {
+			return entries[i].OwnGoals < entries[j].OwnGoals
+		}
+		return entries[i].Player < entries[j].Player
+	})
+
+	return entries
+}
+
+// teamDisplayName prefers the short team name.
+func teamDisplayName(team api.Team) string {
+	if team.ShortName != "" {
+		return team.ShortName
+	}
+	return team.Name
+}
+
+// ScorersVisibleRows returns how many scorer rows fit for a terminal height.
+func ScorersVisibleRows(height int) int {
+	return max(standingsBodyHeight(height)-3, 1) // progress line + header + separator
+}
+
+// Column widths for the scorers table
+const (
+	scorersColRank  = 4
+	scorersColGoals = 5
+	scorersColOG    = 5
+)
+
+// RenderScorersView renders the top scorers across the finished matches of the stats view.
+// loaded and total are the number of match details fetched so far; loading shows the spinner
+// and progress while the remaining details arrive. scroll is the first visible row.
+func RenderScorersView(width, height int, scorers []ScorerEntry, scroll, loaded, total int, loading bool, randomSpinner *RandomCharSpinner, bannerType constants.StatusBannerType) string {
+	boxWidth := min(max(width-4, 40), standingsViewWidth)
+
+	statusBanner := renderStatusBanner(bannerType, boxWidth)
+	if statusBanner != "" {
+		statusBanner += "\n"
+	}
+
+	title := design.RenderHeader(constants.PanelTopScorers, boxWidth)
+
+	// Progress line - always reserved so the table doesn't jump when loading finishes
+	var progress string
+	if loading && total > 0 {
+		progress = fmt.Sprintf(constants.LoadingMatchDetailsProgress, loaded, total)
+		if randomSpinner != nil {
+			progress = randomSpinner.View() + "  " + progress
+		}
+	}
+	progressLine := neonDimStyle.Width(boxWidth).Align(lipgloss.Center).Render(progress)
+
+	var body string
+	if len(scorers) == 0 {
+		message := constants.EmptyNoScorers
+		if loading {
+			message = constants.LoadingFetching
+		}
+		body = neonDimStyle.Width(boxWidth).Align(lipgloss.Center).Render(message)
+	} else {
+		rows := make([]string, len(scorers))
+		for i, entry := range scorers {
+			rows[i] = renderScorerRow(i+1, entry, boxWidth)
+		}
+
+		visibleRows := ScorersVisibleRows(height)
+		start := min(max(scroll, 0), max(len(rows)-visibleRows, 0))
+		end := min(start+visibleRows, len(rows))
+
+		lines := []string{
+			renderScorersHeaderRow(boxWidth),
+			dialogSeparatorStyle.Render(strings.Repeat("─", boxWidth)),
+		}
+		body = strings.Join(append(lines, rows[start:end]...), "\n")
+	}
+
+	help := neonDimStyle.Width(boxWidth).Align(lipgloss.Center).Render(constants.HelpScorersView)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, statusBanner, title, progressLine, body, "", help)
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
+}
+
+// scorersNameWidths splits the space left after the fixed columns between player, team and matches.
+func scorersNameWidths(width int) (player, team, matches int) {
+	free := max(width-scorersColRank-scorersColGoals-scorersColOG-6, 30)
+	player = free * 35 / 100
+	team = free * 20 / 100
+	return player, team, free - player - team
+}
+
+// renderScorersHeaderRow renders the scorers table header.
+func renderScorersHeaderRow(width int) string {
+	playerWidth, teamWidth, matchesWidth := scorersNameWidths(width)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		dialogHeaderStyle.Width(scorersColRank).Align(lipgloss.Right).Render("#"),
+		"  ",
+		dialogHeaderStyle.Width(playerWidth).Render("Player"),
+		dialogHeaderStyle.Width(teamWidth).Render("Team"),
+		dialogHeaderStyle.Width(scorersColGoals).Align(lipgloss.Right).Render("G"),
+		dialogHeaderStyle.Width(scorersColOG).Align(lipgloss.Right).Render("OG"),
+		"  ",
+		dialogHeaderStyle.Width(matchesWidth).Render("Matches"),
+	)
+}
+
+// renderScorerRow renders a single scorer row.
+func renderScorerRow(rank int, entry ScorerEntry, width int) string {
+	playerWidth, teamWidth, matchesWidth := scorersNameWidths(width)
+
+	ownGoals := ""
+	if entry.OwnGoals > 0 {
+		ownGoals = fmt.Sprintf("%d", entry.OwnGoals)
+	}
+
+	row := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(scorersColRank).Align(lipgloss.Right).Render(fmt.Sprintf("%d", rank)),
+		"  ",
+		lipgloss.NewStyle().Width(playerWidth).Render(truncateString(entry.Player, playerWidth-1)),
+		lipgloss.NewStyle().Width(teamWidth).Render(truncateString(entry.Team, teamWidth-1)),
+		lipgloss.NewStyle().Width(scorersColGoals).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Goals)),
+		lipgloss.NewStyle().Width(scorersColOG).Align(lipgloss.Right).Render(ownGoals),
+		"  ",
+		lipgloss.NewStyle().Width(matchesWidth).Render(truncateString(strings.Join(entry.Matches, ", "), matchesWidth)),
+	)
+
+	return dialogValueStyle.Render(row)
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestAggregateScorers(t *testing.T) {
+	arsenal := api.Team{ID: 1, ShortName: "Arsenal"}
+	chelsea := api.Team{ID: 2, ShortName: "Chelsea"}
+	spurs := api.Team{ID: 3, ShortName: "Spurs"}
+
+	goal := func(team api.Team, player string, ownGoal bool) api.MatchEvent {
+		event := api.MatchEvent{Type: "goal", Team: team, Player: &player}
+		if ownGoal {
+			event.OwnGoal = &ownGoal
+		}
+		return event
+	}
+
+	details := []*api.MatchDetails{
+		{
+			Match: api.Match{ID: 10, HomeTeam: arsenal, AwayTeam: chelsea},
+			Events: []api.MatchEvent{
+				goal(arsenal, "Saka", false),
+				goal(chelsea, "Palmer", false),
+				goal(arsenal, "Saka", false),
+				goal(arsenal, "Colwill", true), // Chelsea defender, credited to Arsenal
+			},
+		},
+		{
+			Match: api.Match{ID: 11, HomeTeam: spurs, AwayTeam: arsenal},
+			Events: []api.MatchEvent{
+				goal(arsenal, "Saka", false),
+				goal(spurs, "Maddison", false),
+			},
+		},
+		nil,
+	}
+
+	got := AggregateScorers(details)
+	want := []ScorerEntry{
+		{Player: "Saka", Team: "Arsenal", Goals: 3, Matches: []string{"vs Chelsea", "vs Spurs"}},
+		{Player: "Maddison", Team: "Spurs", Goals: 1, Matches: []string{"vs Arsenal"}},
+		{Player: "Palmer", Team: "Chelsea", Goals: 1, Matches: []string{"vs Arsenal"}},
+		{Player: "Colwill", Team: "Chelsea", OwnGoals: 1, Matches: []string{"vs Arsenal"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AggregateScorers() =\n%+v\nwant\n%+v", got, want)
+	}
+}
@@ -0,0 +1,63 @@
+package ui
+
+import "strings"
+
+// ScrollAction is a scrolling or paging key applied to a scrollable panel.
+type ScrollAction int
+
+const (
+	ScrollLineUp ScrollAction = iota
+	ScrollLineDown
+	ScrollHalfPageUp
+	ScrollHalfPageDown
+	ScrollPageUp
+	ScrollPageDown
+	ScrollTop
+	ScrollBottom
+)
+
+// Scroll returns offset moved by action through contentLines lines shown pageLines at a time.
+// The result is clamped with ClampScroll, whatever the content length.
+func Scroll(offset int, action ScrollAction, contentLines, pageLines int) int {
+	half := max(pageLines/2, 1)
+	page := max(pageLines, 1)
+
+	switch action {
+	case ScrollLineUp:
+		offset--
+	case ScrollLineDown:
+		offset++
+	case ScrollHalfPageUp:
+		offset -= half
+	case ScrollHalfPageDown:
+		offset += half
+	case ScrollPageUp:
+		offset -= page
+	case ScrollPageDown:
+		offset += page
+	case ScrollTop:
+		offset = 0
+	case ScrollBottom:
+		offset = contentLines
+	}
+	return ClampScroll(offset, contentLines, pageLines)
+}
+
+// ClampScroll keeps offset within the content: the page never starts before the first line
+// nor runs past the last. Content that fits on one page always starts at 0.
+func ClampScroll(offset, contentLines, pageLines int) int {
+	return max(0, min(offset, contentLines-pageLines))
+}
+
+// StatsDetailsScrollSize returns how many lines the stats view's details scroll through and
+// how many of them fit on screen, laid out as RenderStatsViewWithList does for the same size.
+func StatsDetailsScrollSize(width, height int, cfg StatsViewConfig) (contentLines, pageLines int) {
+	if cfg.Details == nil {
+		return 0, 0
+	}
+	_, rightWidth, panelHeight := statsPanelLayout(width, height, cfg.ListPanelPercent)
+	cfg.RightPanelFocused = true
+	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, cfg)
+	headerHeight := strings.Count(headerContent, "\n") + 1
+	return strings.Count(scrollableContent, "\n") + 1, max(panelHeight-headerHeight, minScrollableArea)
+}
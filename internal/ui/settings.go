@@ -22,6 +22,7 @@ type SettingsState struct {
 	Regions       []string          // Available regions
 	CurrentRegion int               // Index of current region
 	HasChanges    bool              // Whether there are unsaved changes
+	AnimateLogo   bool              // Whether the main menu logo animates
 }
 
 // NewSettingsState creates a new settings state with current saved preferences.
@@ -82,9 +83,16 @@ func NewSettingsState() *SettingsState {
 		AllLeagues:    allLeagueInfos,
 		Regions:       regions,
 		CurrentRegion: currentRegion,
+		AnimateLogo:   settings.LogoAnimationEnabled(),
 	}
 }
 
+// ToggleAnimateLogo toggles the main menu logo animation.
+func (s *SettingsState) ToggleAnimateLogo() {
+	s.AnimateLogo = !s.AnimateLogo
+	s.HasChanges = true
+}
+
 // Toggle toggles the selection state of the currently highlighted league.
 func (s *SettingsState) Toggle() {
 	if item, ok := s.List.SelectedItem().(LeagueListItem); ok {
@@ -144,9 +152,11 @@ func (s *SettingsState) Save() error {
 		}
 	}
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
-	}
+	// Preserve settings not edited in this view
+	settings, _ := data.LoadSettings()
+	settings.SelectedLeagues = selectedIDs
+	animateLogo := s.AnimateLogo
+	settings.AnimateLogo = &animateLogo
 
 	err := data.SaveSettings(settings)
 	if err == nil {
@@ -248,6 +258,11 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 	} else {
 		infoText = fmt.Sprintf("%d of %d selected", selectedCount, len(state.AllLeagues))
 	}
+	logoAnimation := "off"
+	if state.AnimateLogo {
+		logoAnimation = "on"
+	}
+	infoText += "  ·  Logo animation: " + logoAnimation
 	infoStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
 	info := infoStyle.Render(infoText)
 
@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+// HiddenScore replaces the score of a match whose result is hidden by spoiler mode.
+const HiddenScore = "• - •"
+
+// hiddenCompactScore is HiddenScore in the single-line match lists.
+const hiddenCompactScore = "•-•"
+
+// Spoilers is the spoiler mode state: while On, scores, goals and cards stay hidden
+// until a match is revealed.
+type Spoilers struct {
+	On       bool
+	Revealed map[int]bool // IDs of the matches the user chose to reveal
+}
+
+// ScoreHidden reports whether spoiler mode hides the result of a match.
+// Matches without a score (not started, postponed) have nothing to hide.
+func (s Spoilers) ScoreHidden(match api.Match) bool {
+	if !s.On || s.Revealed[match.ID] {
+		return false
+	}
+	_, _, ok := displayScore(match)
+	return ok
+}
+
+// HideScores marks the matches whose result spoiler mode hides, see MatchDisplay.ScoreHidden.
+func HideScores(matches []MatchDisplay, spoilers Spoilers) {
+	for i := range matches {
+		matches[i].ScoreHidden = spoilers.ScoreHidden(matches[i].Match)
+	}
+}
+
+// VisibleEvents returns the events of a match that spoiler mode lets through:
+// all of them, or only the substitutions while its score is hidden.
+func VisibleEvents(details *api.MatchDetails, scoreHidden bool) []api.MatchEvent {
+	if !scoreHidden {
+		return details.Events
+	}
+	var events []api.MatchEvent
+	for _, event := range details.Events {
+		if event.Type == "substitution" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// hideSpoilerUpdates drops the live updates giving the result away:
+// goals, cards, VAR decisions, the full-time marker with the final score, and the
+// commentary, which narrates the goals.
+func hideSpoilerUpdates(updates []string) []string {
+	var kept []string
+	for _, update := range updates {
+		prefix, _, _ := strings.Cut(update, " ")
+		switch {
+		case prefix == fotmob.EventPrefixGoal, prefix == fotmob.EventPrefixYellowCard, prefix == fotmob.EventPrefixRedCard,
+			prefix == fotmob.EventPrefixVAR, prefix == fotmob.EventPrefixCommentary:
+			continue
+		case strings.HasPrefix(update, "— Full Time"):
+			continue
+		}
+		kept = append(kept, update)
+	}
+	return kept
+}
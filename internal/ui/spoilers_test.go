@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestSpoilerMode(t *testing.T) {
+	home, away := 2, 1
+	finished := MatchDisplay{Match: api.Match{
+		ID:        7,
+		Status:    api.MatchStatusFinished,
+		HomeTeam:  api.Team{ShortName: "Arsenal"},
+		AwayTeam:  api.Team{ShortName: "Chelsea"},
+		HomeScore: &home,
+		AwayScore: &away,
+	}}
+	upcoming := MatchDisplay{Match: api.Match{ID: 8, Status: api.MatchStatusNotStarted}}
+
+	spoilers := Spoilers{On: true, Revealed: map[int]bool{}}
+	matches := []MatchDisplay{finished, upcoming}
+	HideScores(matches, spoilers)
+	finished = matches[0]
+
+	if !matches[0].ScoreHidden || matches[1].ScoreHidden {
+		t.Errorf("ScoreHidden = %v/%v, want only the finished match hidden",
+			matches[0].ScoreHidden, matches[1].ScoreHidden)
+	}
+	if got := finished.Description(); !strings.HasPrefix(got, HiddenScore) || strings.Contains(got, "2 - 1") {
+		t.Errorf("Description() = %q, want the score masked", got)
+	}
+	if got := finished.CompactTitle(); !strings.Contains(got, "Arsenal •-• Chelsea") {
+		t.Errorf("CompactTitle() = %q, want the score masked", got)
+	}
+
+	updates := []string{
+		"— Full Time 2-1 —",
+		"● 80' [GOAL] Saka (H)",
+		"◆ 78' [VAR] goal disallowed (offside) [H]",
+		"↔ 70' [SUB] {OUT}Havertz {IN}Jesus (H)",
+		"▪ 30' [CARD] Rice (H)",
+		"— Half Time —",
+	}
+	want := []string{"↔ 70' [SUB] {OUT}Havertz {IN}Jesus (H)", "— Half Time —"}
+	if got := hideSpoilerUpdates(updates); !slices.Equal(got, want) {
+		t.Errorf("hideSpoilerUpdates() = %q, want %q", got, want)
+	}
+
+	spoilers.Revealed[finished.ID] = true
+	HideScores(matches, spoilers)
+	if got := matches[0].Description(); !strings.HasPrefix(got, "2 - 1") {
+		t.Errorf("Description() after reveal = %q, want the score", got)
+	}
+}
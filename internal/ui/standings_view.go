@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// standingsViewWidth is the maximum width of the full-screen league table.
+const standingsViewWidth = 90
+
+// StandingsViewState holds the state for the standalone league table view.
+// The view starts on a league picker; selecting a league shows its full table.
+type StandingsViewState struct {
+	List        list.Model        // League picker
+	Leagues     []data.LeagueInfo // Tracked leagues, in picker order
+	Current     int               // Index into Leagues of the shown table, -1 while picking
+	ScrollIndex int               // First visible table row
+	Loading     bool              // Whether the current league's table is being fetched
+}
+
+// NewStandingsViewState creates the league table view state for the tracked leagues.
+// Tracked leagues are the active leagues (user selection or defaults) in settings order.
+func NewStandingsViewState() *StandingsViewState {
+	tracked, _ := data.LoadTrackedLeagues()
+	activeIDs := data.ActiveLeagueIDs()
+
+	var leagues []data.LeagueInfo
+	for _, region := range data.GetAllRegions() {
+		for _, league := range tracked[region] {
+			if slices.Contains(activeIDs, league.ID) {
+				leagues = append(leagues, league)
+			}
+		}
+	}
+
+	items := make([]list.Item, len(leagues))
+	for i, league := range leagues {
+		items[i] = LeagueListItem{League: league}
+	}
+
+	delegate := NewMatchListDelegate()
+	delegate.SetHeight(2)
+
+	l := list.New(items, delegate, 0, 0)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(true)
+	l.SetFilteringEnabled(true)
+	l.SetShowFilter(true)
+	l.Filter = list.DefaultFilter
+	l.SetShowHelp(false) // We use our own help text
+
+	filterCursorStyle, filterPromptStyle := FilterInputStyles()
+	l.Styles.FilterCursor = filterCursorStyle
+	l.FilterInput.PromptStyle = filterPromptStyle
+	l.FilterInput.Cursor.Style = filterCursorStyle
+
+	return &StandingsViewState{
+		List:    l,
+		Leagues: leagues,
+		Current: -1,
+	}
+}
+
+// ShowingTable reports whether a league table (rather than the picker) is shown.
+func (s *StandingsViewState) ShowingTable() bool {
+	return s.Current >= 0 && s.Current < len(s.Leagues)
+}
+
+// CurrentLeague returns the league whose table is shown.
+func (s *StandingsViewState) CurrentLeague() (data.LeagueInfo, bool) {
+	if !s.ShowingTable() {
+		return data.LeagueInfo{}, false
+	}
+	return s.Leagues[s.Current], true
+}
+
+// SelectHighlighted switches to the table of the league highlighted in the picker.
+func (s *StandingsViewState) SelectHighlighted() bool {
+	item, ok := s.List.SelectedItem().(LeagueListItem)
+	if !ok {
+		return false
+	}
+	for i, league := range s.Leagues {
+		if league.ID == item.League.ID {
+			s.Current = i
+			s.ScrollIndex = 0
+			return true
+		}
+	}
+	return false
+}
+
+// CycleLeague moves to the next (delta > 0) or previous (delta < 0) tracked league, wrapping around.
+func (s *StandingsViewState) CycleLeague(delta int) {
+	if len(s.Leagues) == 0 {
+		return
+	}
+	s.Current = (s.Current + delta + len(s.Leagues)) % len(s.Leagues)
+	s.ScrollIndex = 0
+}
+
+// ShowPicker returns to the league picker.
+func (s *StandingsViewState) ShowPicker() {
+	s.Current = -1
+	s.ScrollIndex = 0
+	s.Loading = false
+}
+
+// Scroll moves the table by delta rows, keeping the last row at the bottom of the screen.
+// rows is the table length and height the terminal height.
+func (s *StandingsViewState) Scroll(delta, rows, height int) {
+	maxOffset := max(rows-StandingsVisibleRows(height), 0)
+	s.ScrollIndex = min(max(s.ScrollIndex+delta, 0), maxOffset)
+}
+
+// StandingsVisibleRows returns how many team rows fit in the table for a terminal height.
+func StandingsVisibleRows(height int) int {
+	return max(standingsBodyHeight(height)-2, 1) // header + separator
+}
+
+// standingsBodyHeight returns the height available below the title for a terminal height.
+func standingsBodyHeight(height int) int {
+	const (
+		titleHeight  = 3 // Title + margin
+		helpHeight   = 2 // Help text
+		extraPadding = 4 // Additional vertical spacing
+	)
+	return max(height-titleHeight-helpHeight-extraPadding, 5)
+}
+
+// RenderStandingsView renders the standalone league table view.
+// Shows the league picker, or the selected league's full table from tables (keyed by league ID).
+func RenderStandingsView(width, height int, state *StandingsViewState, tables map[int][]api.LeagueTableEntry, randomSpinner *RandomCharSpinner, bannerType constants.StatusBannerType) string {
+	if state == nil {
+		return ""
+	}
+
+	boxWidth := min(max(width-4, 40), standingsViewWidth)
+
+	statusBanner := renderStatusBanner(bannerType, boxWidth)
+	if statusBanner != "" {
+		statusBanner += "\n"
+	}
+
+	bodyHeight := standingsBodyHeight(height)
+
+	var title, body, helpText string
+	league, showingTable := state.CurrentLeague()
+	if showingTable {
+		title = design.RenderHeader(league.Name, boxWidth)
+		body = renderStandingsViewTable(state, tables[league.ID], randomSpinner, boxWidth, height)
+		helpText = constants.HelpStandingsView
+	} else {
+		title = design.RenderHeader(constants.PanelLeagueTables, boxWidth)
+		if len(state.Leagues) == 0 {
+			body = neonEmptyStyle.Width(boxWidth).Render(constants.EmptyNoLeagues)
+		} else {
+			state.List.SetSize(boxWidth, bodyHeight)
+			body = lipgloss.NewStyle().Width(boxWidth).Render(state.List.View())
+		}
+		helpText = constants.HelpStandingsPicker
+	}
+
+	help := neonDimStyle.Width(boxWidth).Align(lipgloss.Center).Render(helpText)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, statusBanner, title, "", body, "", help)
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
+}
+
+// renderStandingsViewTable renders the visible window of a league table.
+func renderStandingsViewTable(state *StandingsViewState, standings []api.LeagueTableEntry, randomSpinner *RandomCharSpinner, width, termHeight int) string {
+	if len(standings) == 0 {
+		message := "No standings data available"
+		if state.Loading {
+			message = constants.LoadingFetching
+			if randomSpinner != nil {
+				message = randomSpinner.View() + "  " + message
+			}
+		}
+		return neonDimStyle.Width(width).Align(lipgloss.Center).Render(message)
+	}
+
+	league, _ := state.CurrentLeague()
+	lines := renderStandingsRows(standings, width, league.Zones)
+	header, rows := lines[:2], lines[2:]
+
+	visibleRows := StandingsVisibleRows(termHeight)
+	start := min(state.ScrollIndex, max(len(rows)-visibleRows, 0))
+	end := min(start+visibleRows, len(rows))
+
+	return strings.Join(append(header, rows[start:end]...), "\n")
+}
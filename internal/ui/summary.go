@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// MatchSummary builds a plain-text summary of a match for sharing.
+// Format: "Home 2-1 Away — League\nGoals: 18' Isak, 78' Gordon".
+func MatchSummary(details *api.MatchDetails) string {
+	if details == nil {
+		return ""
+	}
+
+	score := "vs"
+	if details.HomeScore != nil && details.AwayScore != nil {
+		score = fmt.Sprintf("%d-%d", *details.HomeScore, *details.AwayScore)
+	}
+
+	line := fmt.Sprintf("%s %s %s", details.HomeTeam.Name, score, details.AwayTeam.Name)
+	if details.League.Name != "" {
+		line += " — " + details.League.Name
+	}
+	if label := statusLabel(details.Status); label != "" {
+		line += " (" + label + ")"
+	}
+
+	var goals []string
+	for _, event := range details.Events {
+		if event.Type != "goal" {
+			continue
+		}
+		player := "Unknown"
+		if event.Player != nil {
+			player = *event.Player
+		}
+		goal := eventMinuteString(event) + " " + player
+		if event.IsOwnGoal() {
+			goal += " (OG)"
+		} else if event.IsPenalty() {
+			goal += " (pen)"
+		}
+		goals = append(goals, goal)
+	}
+
+	if len(goals) == 0 {
+		return line
+	}
+	return line + "\nGoals: " + strings.Join(goals, ", ")
+}
@@ -0,0 +1,21 @@
+package ui
+
+import "time"
+
+// displayLocation is the time zone used for every displayed kickoff time.
+var displayLocation = time.Local
+
+// SetDisplayLocation sets the time zone used for displayed kickoff times.
+// A nil location resets it to the system local zone.
+func SetDisplayLocation(loc *time.Location) {
+	if loc == nil {
+		loc = time.Local
+	}
+	displayLocation = loc
+}
+
+// formatDisplayTime formats t in the configured display time zone.
+// Include "MST" in layout to show the zone abbreviation.
+func formatDisplayTime(t time.Time, layout string) string {
+	return t.In(displayLocation).Format(layout)
+}
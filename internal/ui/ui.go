@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"fmt"
+
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/lipgloss"
@@ -14,6 +16,18 @@ func Truncate(text string, width int) string {
 	return text[:width-3] + "..."
 }
 
+// replaysFoundMessage words the result of a manual replay search.
+func replaysFoundMessage(n int) string {
+	switch n {
+	case 0:
+		return "No new replay links found"
+	case 1:
+		return "Found 1 new replay link"
+	default:
+		return fmt.Sprintf("Found %d new replay links", n)
+	}
+}
+
 // renderStatusBanner renders a status banner based on the specified type.
 // Returns an empty string if no banner should be displayed.
 // The banner is styled with cyan color, bold text, and center alignment.
@@ -23,17 +37,69 @@ func renderStatusBanner(bannerType constants.StatusBannerType, width int) string
 
 	switch bannerType {
 	case constants.StatusBannerDebug:
-		message = "[DEBUG MODE] Logs: ~/.golazo/golazo_debug.log"
+		message = "[DEBUG MODE] Logs: golazo.log in the cache directory"
 	case constants.StatusBannerNewVersion:
 		message = "New Version Available! Run 'golazo --update'"
 	case constants.StatusBannerDev:
 		message = "[DEV BUILD] This is a development version"
+	case constants.StatusBannerCopied:
+		message = "Match summary copied!"
+	case constants.StatusBannerNoClipboard:
+		message = "No clipboard available (install xclip, xsel or wl-copy)"
+	case constants.StatusBannerCopyFailed:
+		message = "Copy failed (details in the debug log)"
+	case constants.StatusBannerOpenedPage:
+		message = "Opened the match on FotMob"
+	case constants.StatusBannerNoBrowser:
+		message = "Couldn't open a browser for the match page"
+	case constants.StatusBannerNoLiveMatches:
+		message = "No live matches in the list"
+	case constants.StatusBannerSortKickoff:
+		message = "Sorted by kickoff time"
+	case constants.StatusBannerSortLeague:
+		message = "Sorted by league, then kickoff time"
+	case constants.StatusBannerSortHomeTeam:
+		message = "Sorted by home team"
+	case constants.StatusBannerCommentaryOn:
+		message = "Showing full commentary"
+	case constants.StatusBannerCommentaryOff:
+		message = "Showing match events only"
+	case constants.StatusBannerReplaysLimited:
+		message = "Replay lookups temporarily limited by Reddit"
+	case constants.StatusBannerSpoilersOn:
+		message = "Spoiler mode on: scores hidden (v: reveal)"
+	case constants.StatusBannerSpoilersOff:
+		message = "Spoiler mode off: scores shown"
+	case constants.StatusBannerFavoritesOn:
+		message = "Favorite teams' matches first"
+	case constants.StatusBannerFavoritesOff:
+		message = "Favorite teams' matches in the usual order"
+	case constants.StatusBannerNoFavorites:
+		message = "No favorite teams: list their IDs under favorite_teams in settings.yaml"
+	case constants.StatusBannerSearchingReplays:
+		message = "Searching Reddit for goal replays…"
+	case constants.StatusBannerNoTeamStats:
+		message = "Couldn't load the team's season, try again later"
 	case constants.StatusBannerNone:
 		fallthrough
 	default:
 		return "" // No banner for None or unknown types
 	}
 
+	return styleStatusBanner(bannerType, message, width)
+}
+
+// renderReplaysStatusBanner renders the status banner of the views a manual replay search
+// runs from, where StatusBannerReplaysFound reports replaysFound new links.
+func renderReplaysStatusBanner(bannerType constants.StatusBannerType, replaysFound, width int) string {
+	if bannerType == constants.StatusBannerReplaysFound {
+		return styleStatusBanner(bannerType, replaysFoundMessage(replaysFound), width)
+	}
+	return renderStatusBanner(bannerType, width)
+}
+
+// styleStatusBanner styles a status banner's message and centers it in width.
+func styleStatusBanner(bannerType constants.StatusBannerType, message string, width int) string {
 	var styledMessage string
 
 	if bannerType == constants.StatusBannerNewVersion {
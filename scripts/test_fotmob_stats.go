@@ -25,7 +25,7 @@ func main() {
 	defer cancel()
 
 	startTime := time.Now()
-	statsData, err := client.StatsData(ctx)
+	statsData, err := client.StatsData(ctx, fotmob.StatsDataDays)
 	elapsed := time.Since(startTime)
 
 	if err != nil {